@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -694,11 +695,318 @@ func (x *QueryPolicyPathByRoleKeyReply) GetApis() []*ApiBase {
 	return nil
 }
 
+type CheckPermissionRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// roleKey 与 userId 二选一，都传时以 roleKey 为准
+	RoleKey       string `protobuf:"bytes,1,opt,name=roleKey,proto3" json:"roleKey,omitempty"`
+	UserId        int64  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	Path          string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Method        string `protobuf:"bytes,4,opt,name=method,proto3" json:"method,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionRequest) Reset() {
+	*x = CheckPermissionRequest{}
+	mi := &file_api_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionRequest) ProtoMessage() {}
+
+func (x *CheckPermissionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionRequest.ProtoReflect.Descriptor instead.
+func (*CheckPermissionRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CheckPermissionRequest) GetRoleKey() string {
+	if x != nil {
+		return x.RoleKey
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CheckPermissionRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CheckPermissionRequest) GetMethod() string {
+	if x != nil {
+		return x.Method
+	}
+	return ""
+}
+
+type CheckPermissionReply struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Allowed bool                   `protobuf:"varint,1,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	RoleKey string                 `protobuf:"bytes,2,opt,name=roleKey,proto3" json:"roleKey,omitempty"`
+	// 命中的策略，未命中时为空
+	Matched *ApiBase `protobuf:"bytes,3,opt,name=matched,proto3" json:"matched,omitempty"`
+	// 继承后的有效策略
+	Policies []*ApiBase `protobuf:"bytes,4,rep,name=policies,proto3" json:"policies,omitempty"`
+	// 继承的角色
+	Roles         []string `protobuf:"bytes,5,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckPermissionReply) Reset() {
+	*x = CheckPermissionReply{}
+	mi := &file_api_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckPermissionReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckPermissionReply) ProtoMessage() {}
+
+func (x *CheckPermissionReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckPermissionReply.ProtoReflect.Descriptor instead.
+func (*CheckPermissionReply) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CheckPermissionReply) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *CheckPermissionReply) GetRoleKey() string {
+	if x != nil {
+		return x.RoleKey
+	}
+	return ""
+}
+
+func (x *CheckPermissionReply) GetMatched() *ApiBase {
+	if x != nil {
+		return x.Matched
+	}
+	return nil
+}
+
+func (x *CheckPermissionReply) GetPolicies() []*ApiBase {
+	if x != nil {
+		return x.Policies
+	}
+	return nil
+}
+
+func (x *CheckPermissionReply) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+type SyncApiRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 只返回差异，不写入数据库
+	DryRun        bool `protobuf:"varint,1,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncApiRequest) Reset() {
+	*x = SyncApiRequest{}
+	mi := &file_api_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncApiRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncApiRequest) ProtoMessage() {}
+
+func (x *SyncApiRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncApiRequest.ProtoReflect.Descriptor instead.
+func (*SyncApiRequest) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SyncApiRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type SyncApiReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Created       []*ApiBase             `protobuf:"bytes,1,rep,name=created,proto3" json:"created,omitempty"`
+	Updated       []*ApiBase             `protobuf:"bytes,2,rep,name=updated,proto3" json:"updated,omitempty"`
+	Stale         []*StaleApi            `protobuf:"bytes,3,rep,name=stale,proto3" json:"stale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SyncApiReply) Reset() {
+	*x = SyncApiReply{}
+	mi := &file_api_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SyncApiReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SyncApiReply) ProtoMessage() {}
+
+func (x *SyncApiReply) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SyncApiReply.ProtoReflect.Descriptor instead.
+func (*SyncApiReply) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *SyncApiReply) GetCreated() []*ApiBase {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+func (x *SyncApiReply) GetUpdated() []*ApiBase {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+func (x *SyncApiReply) GetStale() []*StaleApi {
+	if x != nil {
+		return x.Stale
+	}
+	return nil
+}
+
+// 已不存在的api
+type StaleApi struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Api   *ApiData               `protobuf:"bytes,1,opt,name=api,proto3" json:"api,omitempty"`
+	// 仍引用该api的角色
+	RoleKeys      []string `protobuf:"bytes,2,rep,name=roleKeys,proto3" json:"roleKeys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StaleApi) Reset() {
+	*x = StaleApi{}
+	mi := &file_api_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StaleApi) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StaleApi) ProtoMessage() {}
+
+func (x *StaleApi) ProtoReflect() protoreflect.Message {
+	mi := &file_api_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StaleApi.ProtoReflect.Descriptor instead.
+func (*StaleApi) Descriptor() ([]byte, []int) {
+	return file_api_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *StaleApi) GetApi() *ApiData {
+	if x != nil {
+		return x.Api
+	}
+	return nil
+}
+
+func (x *StaleApi) GetRoleKeys() []string {
+	if x != nil {
+		return x.RoleKeys
+	}
+	return nil
+}
+
 var File_api_proto protoreflect.FileDescriptor
 
 const file_api_proto_rawDesc = "" +
 	"\n" +
-	"\tapi.proto\x12\fapi.admin.v1\x1a\x1cgoogle/api/annotations.proto\x1a\n" +
+	"\tapi.proto\x12\fapi.admin.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\n" +
 	"base.proto\" \n" +
 	"\x0eFindApiRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"7\n" +
@@ -734,13 +1042,35 @@ const file_api_proto_rawDesc = "" +
 	"\x1fQueryPolicyPathByRoleKeyRequest\x12\x18\n" +
 	"\aroleKey\x18\x01 \x01(\tR\aroleKey\"J\n" +
 	"\x1dQueryPolicyPathByRoleKeyReply\x12)\n" +
-	"\x04apis\x18\x01 \x03(\v2\x15.api.admin.v1.ApiBaseR\x04apis2\xed\x05\n" +
+	"\x04apis\x18\x01 \x03(\v2\x15.api.admin.v1.ApiBaseR\x04apis\"\x88\x01\n" +
+	"\x16CheckPermissionRequest\x12\x18\n" +
+	"\aroleKey\x18\x01 \x01(\tR\aroleKey\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\x12\x1b\n" +
+	"\x04path\x18\x03 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x04path\x12\x1f\n" +
+	"\x06method\x18\x04 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\x06method\"\xc4\x01\n" +
+	"\x14CheckPermissionReply\x12\x18\n" +
+	"\aallowed\x18\x01 \x01(\bR\aallowed\x12\x18\n" +
+	"\aroleKey\x18\x02 \x01(\tR\aroleKey\x12/\n" +
+	"\amatched\x18\x03 \x01(\v2\x15.api.admin.v1.ApiBaseR\amatched\x121\n" +
+	"\bpolicies\x18\x04 \x03(\v2\x15.api.admin.v1.ApiBaseR\bpolicies\x12\x14\n" +
+	"\x05roles\x18\x05 \x03(\tR\x05roles\"(\n" +
+	"\x0eSyncApiRequest\x12\x16\n" +
+	"\x06dryRun\x18\x01 \x01(\bR\x06dryRun\"\x9e\x01\n" +
+	"\fSyncApiReply\x12/\n" +
+	"\acreated\x18\x01 \x03(\v2\x15.api.admin.v1.ApiBaseR\acreated\x12/\n" +
+	"\aupdated\x18\x02 \x03(\v2\x15.api.admin.v1.ApiBaseR\aupdated\x12,\n" +
+	"\x05stale\x18\x03 \x03(\v2\x16.api.admin.v1.StaleApiR\x05stale\"O\n" +
+	"\bStaleApi\x12'\n" +
+	"\x03api\x18\x01 \x01(\v2\x15.api.admin.v1.ApiDataR\x03api\x12\x1a\n" +
+	"\broleKeys\x18\x02 \x03(\tR\broleKeys2\xd2\a\n" +
 	"\x03Api\x12]\n" +
 	"\aListApi\x12\x1c.api.admin.v1.ListApiRequest\x1a\x1a.api.admin.v1.ListApiReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/system/api/list\x12Y\n" +
 	"\x06AllApi\x12\x1b.api.admin.v1.AllApiRequest\x1a\x19.api.admin.v1.AllApiReply\"\x17\x82\xd3\xe4\x93\x02\x11\x12\x0f/system/api/all\x12a\n" +
 	"\tCreateApi\x12\x1e.api.admin.v1.CreateApiRequest\x1a\x1c.api.admin.v1.CreateApiReply\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\"\v/system/api\x12a\n" +
 	"\tUpdateApi\x12\x1e.api.admin.v1.UpdateApiRequest\x1a\x1c.api.admin.v1.UpdateApiReply\"\x16\x82\xd3\xe4\x93\x02\x10:\x01*\x1a\v/system/api\x12\xa1\x01\n" +
-	"\x18QueryPolicyPathByRoleKey\x12-.api.admin.v1.QueryPolicyPathByRoleKeyRequest\x1a+.api.admin.v1.QueryPolicyPathByRoleKeyReply\")\x82\xd3\xe4\x93\x02#\x12!/system/api/getPolicyPathByRoleId\x12]\n" +
+	"\x18QueryPolicyPathByRoleKey\x12-.api.admin.v1.QueryPolicyPathByRoleKeyRequest\x1a+.api.admin.v1.QueryPolicyPathByRoleKeyReply\")\x82\xd3\xe4\x93\x02#\x12!/system/api/getPolicyPathByRoleId\x12`\n" +
+	"\aSyncApi\x12\x1c.api.admin.v1.SyncApiRequest\x1a\x1a.api.admin.v1.SyncApiReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\"\x10/system/api/sync\x12\x80\x01\n" +
+	"\x0fCheckPermission\x12$.api.admin.v1.CheckPermissionRequest\x1a\".api.admin.v1.CheckPermissionReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/system/api/checkPermission\x12]\n" +
 	"\aFindApi\x12\x1c.api.admin.v1.FindApiRequest\x1a\x1a.api.admin.v1.FindApiReply\"\x18\x82\xd3\xe4\x93\x02\x12\x12\x10/system/api/{id}\x12c\n" +
 	"\tDeleteApi\x12\x1e.api.admin.v1.DeleteApiRequest\x1a\x1c.api.admin.v1.DeleteApiReply\"\x18\x82\xd3\xe4\x93\x02\x12*\x10/system/api/{id}B6Z4github.com/swordkee/kratos-vue-admin/api/admin/v1;v1b\x06proto3"
 
@@ -756,7 +1086,7 @@ func file_api_proto_rawDescGZIP() []byte {
 	return file_api_proto_rawDescData
 }
 
-var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_api_proto_msgTypes = make([]protoimpl.MessageInfo, 19)
 var file_api_proto_goTypes = []any{
 	(*FindApiRequest)(nil),                  // 0: api.admin.v1.FindApiRequest
 	(*FindApiReply)(nil),                    // 1: api.admin.v1.FindApiReply
@@ -772,33 +1102,48 @@ var file_api_proto_goTypes = []any{
 	(*DeleteApiReply)(nil),                  // 11: api.admin.v1.DeleteApiReply
 	(*QueryPolicyPathByRoleKeyRequest)(nil), // 12: api.admin.v1.QueryPolicyPathByRoleKeyRequest
 	(*QueryPolicyPathByRoleKeyReply)(nil),   // 13: api.admin.v1.QueryPolicyPathByRoleKeyReply
-	(*ApiData)(nil),                         // 14: api.admin.v1.ApiData
-	(*ApiBase)(nil),                         // 15: api.admin.v1.ApiBase
+	(*CheckPermissionRequest)(nil),          // 14: api.admin.v1.CheckPermissionRequest
+	(*CheckPermissionReply)(nil),            // 15: api.admin.v1.CheckPermissionReply
+	(*SyncApiRequest)(nil),                  // 16: api.admin.v1.SyncApiRequest
+	(*SyncApiReply)(nil),                    // 17: api.admin.v1.SyncApiReply
+	(*StaleApi)(nil),                        // 18: api.admin.v1.StaleApi
+	(*ApiData)(nil),                         // 19: api.admin.v1.ApiData
+	(*ApiBase)(nil),                         // 20: api.admin.v1.ApiBase
 }
 var file_api_proto_depIdxs = []int32{
-	14, // 0: api.admin.v1.FindApiReply.api:type_name -> api.admin.v1.ApiData
-	14, // 1: api.admin.v1.ListApiReply.data:type_name -> api.admin.v1.ApiData
-	14, // 2: api.admin.v1.AllApiReply.data:type_name -> api.admin.v1.ApiData
-	15, // 3: api.admin.v1.QueryPolicyPathByRoleKeyReply.apis:type_name -> api.admin.v1.ApiBase
-	2,  // 4: api.admin.v1.Api.ListApi:input_type -> api.admin.v1.ListApiRequest
-	4,  // 5: api.admin.v1.Api.AllApi:input_type -> api.admin.v1.AllApiRequest
-	6,  // 6: api.admin.v1.Api.CreateApi:input_type -> api.admin.v1.CreateApiRequest
-	8,  // 7: api.admin.v1.Api.UpdateApi:input_type -> api.admin.v1.UpdateApiRequest
-	12, // 8: api.admin.v1.Api.QueryPolicyPathByRoleKey:input_type -> api.admin.v1.QueryPolicyPathByRoleKeyRequest
-	0,  // 9: api.admin.v1.Api.FindApi:input_type -> api.admin.v1.FindApiRequest
-	10, // 10: api.admin.v1.Api.DeleteApi:input_type -> api.admin.v1.DeleteApiRequest
-	3,  // 11: api.admin.v1.Api.ListApi:output_type -> api.admin.v1.ListApiReply
-	5,  // 12: api.admin.v1.Api.AllApi:output_type -> api.admin.v1.AllApiReply
-	7,  // 13: api.admin.v1.Api.CreateApi:output_type -> api.admin.v1.CreateApiReply
-	9,  // 14: api.admin.v1.Api.UpdateApi:output_type -> api.admin.v1.UpdateApiReply
-	13, // 15: api.admin.v1.Api.QueryPolicyPathByRoleKey:output_type -> api.admin.v1.QueryPolicyPathByRoleKeyReply
-	1,  // 16: api.admin.v1.Api.FindApi:output_type -> api.admin.v1.FindApiReply
-	11, // 17: api.admin.v1.Api.DeleteApi:output_type -> api.admin.v1.DeleteApiReply
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	19, // 0: api.admin.v1.FindApiReply.api:type_name -> api.admin.v1.ApiData
+	19, // 1: api.admin.v1.ListApiReply.data:type_name -> api.admin.v1.ApiData
+	19, // 2: api.admin.v1.AllApiReply.data:type_name -> api.admin.v1.ApiData
+	20, // 3: api.admin.v1.QueryPolicyPathByRoleKeyReply.apis:type_name -> api.admin.v1.ApiBase
+	20, // 4: api.admin.v1.CheckPermissionReply.matched:type_name -> api.admin.v1.ApiBase
+	20, // 5: api.admin.v1.CheckPermissionReply.policies:type_name -> api.admin.v1.ApiBase
+	20, // 6: api.admin.v1.SyncApiReply.created:type_name -> api.admin.v1.ApiBase
+	20, // 7: api.admin.v1.SyncApiReply.updated:type_name -> api.admin.v1.ApiBase
+	18, // 8: api.admin.v1.SyncApiReply.stale:type_name -> api.admin.v1.StaleApi
+	19, // 9: api.admin.v1.StaleApi.api:type_name -> api.admin.v1.ApiData
+	2,  // 10: api.admin.v1.Api.ListApi:input_type -> api.admin.v1.ListApiRequest
+	4,  // 11: api.admin.v1.Api.AllApi:input_type -> api.admin.v1.AllApiRequest
+	6,  // 12: api.admin.v1.Api.CreateApi:input_type -> api.admin.v1.CreateApiRequest
+	8,  // 13: api.admin.v1.Api.UpdateApi:input_type -> api.admin.v1.UpdateApiRequest
+	12, // 14: api.admin.v1.Api.QueryPolicyPathByRoleKey:input_type -> api.admin.v1.QueryPolicyPathByRoleKeyRequest
+	16, // 15: api.admin.v1.Api.SyncApi:input_type -> api.admin.v1.SyncApiRequest
+	14, // 16: api.admin.v1.Api.CheckPermission:input_type -> api.admin.v1.CheckPermissionRequest
+	0,  // 17: api.admin.v1.Api.FindApi:input_type -> api.admin.v1.FindApiRequest
+	10, // 18: api.admin.v1.Api.DeleteApi:input_type -> api.admin.v1.DeleteApiRequest
+	3,  // 19: api.admin.v1.Api.ListApi:output_type -> api.admin.v1.ListApiReply
+	5,  // 20: api.admin.v1.Api.AllApi:output_type -> api.admin.v1.AllApiReply
+	7,  // 21: api.admin.v1.Api.CreateApi:output_type -> api.admin.v1.CreateApiReply
+	9,  // 22: api.admin.v1.Api.UpdateApi:output_type -> api.admin.v1.UpdateApiReply
+	13, // 23: api.admin.v1.Api.QueryPolicyPathByRoleKey:output_type -> api.admin.v1.QueryPolicyPathByRoleKeyReply
+	17, // 24: api.admin.v1.Api.SyncApi:output_type -> api.admin.v1.SyncApiReply
+	15, // 25: api.admin.v1.Api.CheckPermission:output_type -> api.admin.v1.CheckPermissionReply
+	1,  // 26: api.admin.v1.Api.FindApi:output_type -> api.admin.v1.FindApiReply
+	11, // 27: api.admin.v1.Api.DeleteApi:output_type -> api.admin.v1.DeleteApiReply
+	19, // [19:28] is the sub-list for method output_type
+	10, // [10:19] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_api_proto_init() }
@@ -813,7 +1158,7 @@ func file_api_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_proto_rawDesc), len(file_api_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   19,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1602,3 +1602,733 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = QueryPolicyPathByRoleKeyReplyValidationError{}
+
+// Validate checks the field values on CheckPermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CheckPermissionRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckPermissionRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckPermissionRequestMultiError, or nil if none found.
+func (m *CheckPermissionRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckPermissionRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoleKey
+
+	// no validation rules for UserId
+
+	if utf8.RuneCountInString(m.GetPath()) < 1 {
+		err := CheckPermissionRequestValidationError{
+			field:  "Path",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetMethod()) < 1 {
+		err := CheckPermissionRequestValidationError{
+			field:  "Method",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CheckPermissionRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckPermissionRequestMultiError is an error wrapping multiple validation
+// errors returned by CheckPermissionRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CheckPermissionRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckPermissionRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckPermissionRequestMultiError) AllErrors() []error { return m }
+
+// CheckPermissionRequestValidationError is the validation error returned by
+// CheckPermissionRequest.Validate if the designated constraints aren't met.
+type CheckPermissionRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckPermissionRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckPermissionRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckPermissionRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckPermissionRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckPermissionRequestValidationError) ErrorName() string {
+	return "CheckPermissionRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckPermissionRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckPermissionRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckPermissionRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckPermissionRequestValidationError{}
+
+// Validate checks the field values on CheckPermissionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CheckPermissionReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CheckPermissionReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CheckPermissionReplyMultiError, or nil if none found.
+func (m *CheckPermissionReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CheckPermissionReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Allowed
+
+	// no validation rules for RoleKey
+
+	if all {
+		switch v := interface{}(m.GetMatched()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CheckPermissionReplyValidationError{
+					field:  "Matched",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CheckPermissionReplyValidationError{
+					field:  "Matched",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetMatched()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CheckPermissionReplyValidationError{
+				field:  "Matched",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPolicies() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CheckPermissionReplyValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CheckPermissionReplyValidationError{
+						field:  fmt.Sprintf("Policies[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CheckPermissionReplyValidationError{
+					field:  fmt.Sprintf("Policies[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CheckPermissionReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CheckPermissionReplyMultiError is an error wrapping multiple validation
+// errors returned by CheckPermissionReply.ValidateAll() if the designated
+// constraints aren't met.
+type CheckPermissionReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CheckPermissionReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CheckPermissionReplyMultiError) AllErrors() []error { return m }
+
+// CheckPermissionReplyValidationError is the validation error returned by
+// CheckPermissionReply.Validate if the designated constraints aren't met.
+type CheckPermissionReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CheckPermissionReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CheckPermissionReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CheckPermissionReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CheckPermissionReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CheckPermissionReplyValidationError) ErrorName() string {
+	return "CheckPermissionReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CheckPermissionReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCheckPermissionReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CheckPermissionReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CheckPermissionReplyValidationError{}
+
+// Validate checks the field values on SyncApiRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SyncApiRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncApiRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SyncApiRequestMultiError,
+// or nil if none found.
+func (m *SyncApiRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncApiRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return SyncApiRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncApiRequestMultiError is an error wrapping multiple validation errors
+// returned by SyncApiRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SyncApiRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncApiRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncApiRequestMultiError) AllErrors() []error { return m }
+
+// SyncApiRequestValidationError is the validation error returned by
+// SyncApiRequest.Validate if the designated constraints aren't met.
+type SyncApiRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncApiRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncApiRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncApiRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncApiRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncApiRequestValidationError) ErrorName() string { return "SyncApiRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SyncApiRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncApiRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncApiRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncApiRequestValidationError{}
+
+// Validate checks the field values on SyncApiReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SyncApiReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SyncApiReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SyncApiReplyMultiError, or
+// nil if none found.
+func (m *SyncApiReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SyncApiReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetCreated() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncApiReplyValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncApiReplyValidationError{
+						field:  fmt.Sprintf("Created[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncApiReplyValidationError{
+					field:  fmt.Sprintf("Created[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetUpdated() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncApiReplyValidationError{
+						field:  fmt.Sprintf("Updated[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncApiReplyValidationError{
+						field:  fmt.Sprintf("Updated[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncApiReplyValidationError{
+					field:  fmt.Sprintf("Updated[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStale() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SyncApiReplyValidationError{
+						field:  fmt.Sprintf("Stale[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SyncApiReplyValidationError{
+						field:  fmt.Sprintf("Stale[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SyncApiReplyValidationError{
+					field:  fmt.Sprintf("Stale[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return SyncApiReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// SyncApiReplyMultiError is an error wrapping multiple validation errors
+// returned by SyncApiReply.ValidateAll() if the designated constraints aren't met.
+type SyncApiReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SyncApiReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SyncApiReplyMultiError) AllErrors() []error { return m }
+
+// SyncApiReplyValidationError is the validation error returned by
+// SyncApiReply.Validate if the designated constraints aren't met.
+type SyncApiReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SyncApiReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SyncApiReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SyncApiReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SyncApiReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SyncApiReplyValidationError) ErrorName() string { return "SyncApiReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SyncApiReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSyncApiReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SyncApiReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SyncApiReplyValidationError{}
+
+// Validate checks the field values on StaleApi with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StaleApi) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StaleApi with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StaleApiMultiError, or nil
+// if none found.
+func (m *StaleApi) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StaleApi) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetApi()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, StaleApiValidationError{
+					field:  "Api",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, StaleApiValidationError{
+					field:  "Api",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetApi()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return StaleApiValidationError{
+				field:  "Api",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return StaleApiMultiError(errors)
+	}
+
+	return nil
+}
+
+// StaleApiMultiError is an error wrapping multiple validation errors returned
+// by StaleApi.ValidateAll() if the designated constraints aren't met.
+type StaleApiMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StaleApiMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StaleApiMultiError) AllErrors() []error { return m }
+
+// StaleApiValidationError is the validation error returned by
+// StaleApi.Validate if the designated constraints aren't met.
+type StaleApiValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StaleApiValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StaleApiValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StaleApiValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StaleApiValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StaleApiValidationError) ErrorName() string { return "StaleApiValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StaleApiValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStaleApi.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StaleApiValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StaleApiValidationError{}
@@ -24,6 +24,8 @@ const (
 	Api_CreateApi_FullMethodName                = "/api.admin.v1.Api/CreateApi"
 	Api_UpdateApi_FullMethodName                = "/api.admin.v1.Api/UpdateApi"
 	Api_QueryPolicyPathByRoleKey_FullMethodName = "/api.admin.v1.Api/QueryPolicyPathByRoleKey"
+	Api_SyncApi_FullMethodName                  = "/api.admin.v1.Api/SyncApi"
+	Api_CheckPermission_FullMethodName          = "/api.admin.v1.Api/CheckPermission"
 	Api_FindApi_FullMethodName                  = "/api.admin.v1.Api/FindApi"
 	Api_DeleteApi_FullMethodName                = "/api.admin.v1.Api/DeleteApi"
 )
@@ -44,6 +46,10 @@ type ApiClient interface {
 	UpdateApi(ctx context.Context, in *UpdateApiRequest, opts ...grpc.CallOption) (*UpdateApiReply, error)
 	// 获取角色拥有的api权限
 	QueryPolicyPathByRoleKey(ctx context.Context, in *QueryPolicyPathByRoleKeyRequest, opts ...grpc.CallOption) (*QueryPolicyPathByRoleKeyReply, error)
+	// 根据已注册的路由同步api，并标记已失效的api
+	SyncApi(ctx context.Context, in *SyncApiRequest, opts ...grpc.CallOption) (*SyncApiReply, error)
+	// 权限校验，返回是否放行及命中的策略
+	CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionReply, error)
 	// 获取api
 	FindApi(ctx context.Context, in *FindApiRequest, opts ...grpc.CallOption) (*FindApiReply, error)
 	// 删除api
@@ -108,6 +114,26 @@ func (c *apiClient) QueryPolicyPathByRoleKey(ctx context.Context, in *QueryPolic
 	return out, nil
 }
 
+func (c *apiClient) SyncApi(ctx context.Context, in *SyncApiRequest, opts ...grpc.CallOption) (*SyncApiReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SyncApiReply)
+	err := c.cc.Invoke(ctx, Api_SyncApi_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *apiClient) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...grpc.CallOption) (*CheckPermissionReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CheckPermissionReply)
+	err := c.cc.Invoke(ctx, Api_CheckPermission_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *apiClient) FindApi(ctx context.Context, in *FindApiRequest, opts ...grpc.CallOption) (*FindApiReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FindApiReply)
@@ -144,6 +170,10 @@ type ApiServer interface {
 	UpdateApi(context.Context, *UpdateApiRequest) (*UpdateApiReply, error)
 	// 获取角色拥有的api权限
 	QueryPolicyPathByRoleKey(context.Context, *QueryPolicyPathByRoleKeyRequest) (*QueryPolicyPathByRoleKeyReply, error)
+	// 根据已注册的路由同步api，并标记已失效的api
+	SyncApi(context.Context, *SyncApiRequest) (*SyncApiReply, error)
+	// 权限校验，返回是否放行及命中的策略
+	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionReply, error)
 	// 获取api
 	FindApi(context.Context, *FindApiRequest) (*FindApiReply, error)
 	// 删除api
@@ -173,6 +203,12 @@ func (UnimplementedApiServer) UpdateApi(context.Context, *UpdateApiRequest) (*Up
 func (UnimplementedApiServer) QueryPolicyPathByRoleKey(context.Context, *QueryPolicyPathByRoleKeyRequest) (*QueryPolicyPathByRoleKeyReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method QueryPolicyPathByRoleKey not implemented")
 }
+func (UnimplementedApiServer) SyncApi(context.Context, *SyncApiRequest) (*SyncApiReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method SyncApi not implemented")
+}
+func (UnimplementedApiServer) CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CheckPermission not implemented")
+}
 func (UnimplementedApiServer) FindApi(context.Context, *FindApiRequest) (*FindApiReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method FindApi not implemented")
 }
@@ -290,6 +326,42 @@ func _Api_QueryPolicyPathByRoleKey_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Api_SyncApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncApiRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).SyncApi(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Api_SyncApi_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).SyncApi(ctx, req.(*SyncApiRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Api_CheckPermission_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CheckPermissionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApiServer).CheckPermission(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Api_CheckPermission_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApiServer).CheckPermission(ctx, req.(*CheckPermissionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Api_FindApi_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FindApiRequest)
 	if err := dec(in); err != nil {
@@ -353,6 +425,14 @@ var Api_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "QueryPolicyPathByRoleKey",
 			Handler:    _Api_QueryPolicyPathByRoleKey_Handler,
 		},
+		{
+			MethodName: "SyncApi",
+			Handler:    _Api_SyncApi_Handler,
+		},
+		{
+			MethodName: "CheckPermission",
+			Handler:    _Api_CheckPermission_Handler,
+		},
 		{
 			MethodName: "FindApi",
 			Handler:    _Api_FindApi_Handler,
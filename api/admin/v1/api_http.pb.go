@@ -20,16 +20,20 @@ var _ = binding.EncodeURL
 const _ = http.SupportPackageIsVersion1
 
 const OperationApiAllApi = "/api.admin.v1.Api/AllApi"
+const OperationApiCheckPermission = "/api.admin.v1.Api/CheckPermission"
 const OperationApiCreateApi = "/api.admin.v1.Api/CreateApi"
 const OperationApiDeleteApi = "/api.admin.v1.Api/DeleteApi"
 const OperationApiFindApi = "/api.admin.v1.Api/FindApi"
 const OperationApiListApi = "/api.admin.v1.Api/ListApi"
 const OperationApiQueryPolicyPathByRoleKey = "/api.admin.v1.Api/QueryPolicyPathByRoleKey"
+const OperationApiSyncApi = "/api.admin.v1.Api/SyncApi"
 const OperationApiUpdateApi = "/api.admin.v1.Api/UpdateApi"
 
 type ApiHTTPServer interface {
 	// AllApi 获取所有api
 	AllApi(context.Context, *AllApiRequest) (*AllApiReply, error)
+	// CheckPermission 权限校验，返回是否放行及命中的策略
+	CheckPermission(context.Context, *CheckPermissionRequest) (*CheckPermissionReply, error)
 	// CreateApi 创建api
 	CreateApi(context.Context, *CreateApiRequest) (*CreateApiReply, error)
 	// DeleteApi 删除api
@@ -40,6 +44,8 @@ type ApiHTTPServer interface {
 	ListApi(context.Context, *ListApiRequest) (*ListApiReply, error)
 	// QueryPolicyPathByRoleKey 获取角色拥有的api权限
 	QueryPolicyPathByRoleKey(context.Context, *QueryPolicyPathByRoleKeyRequest) (*QueryPolicyPathByRoleKeyReply, error)
+	// SyncApi 根据已注册的路由同步api，并标记已失效的api
+	SyncApi(context.Context, *SyncApiRequest) (*SyncApiReply, error)
 	// UpdateApi 更新api
 	UpdateApi(context.Context, *UpdateApiRequest) (*UpdateApiReply, error)
 }
@@ -51,6 +57,8 @@ func RegisterApiHTTPServer(s *http.Server, srv ApiHTTPServer) {
 	r.POST("/system/api", _Api_CreateApi0_HTTP_Handler(srv))
 	r.PUT("/system/api", _Api_UpdateApi0_HTTP_Handler(srv))
 	r.GET("/system/api/getPolicyPathByRoleId", _Api_QueryPolicyPathByRoleKey0_HTTP_Handler(srv))
+	r.POST("/system/api/sync", _Api_SyncApi0_HTTP_Handler(srv))
+	r.GET("/system/api/checkPermission", _Api_CheckPermission0_HTTP_Handler(srv))
 	r.GET("/system/api/{id}", _Api_FindApi0_HTTP_Handler(srv))
 	r.DELETE("/system/api/{id}", _Api_DeleteApi0_HTTP_Handler(srv))
 }
@@ -156,6 +164,47 @@ func _Api_QueryPolicyPathByRoleKey0_HTTP_Handler(srv ApiHTTPServer) func(ctx htt
 	}
 }
 
+func _Api_SyncApi0_HTTP_Handler(srv ApiHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in SyncApiRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationApiSyncApi)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.SyncApi(ctx, req.(*SyncApiRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*SyncApiReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Api_CheckPermission0_HTTP_Handler(srv ApiHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CheckPermissionRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationApiCheckPermission)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CheckPermission(ctx, req.(*CheckPermissionRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CheckPermissionReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Api_FindApi0_HTTP_Handler(srv ApiHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in FindApiRequest
@@ -203,6 +252,8 @@ func _Api_DeleteApi0_HTTP_Handler(srv ApiHTTPServer) func(ctx http.Context) erro
 type ApiHTTPClient interface {
 	// AllApi 获取所有api
 	AllApi(ctx context.Context, req *AllApiRequest, opts ...http.CallOption) (rsp *AllApiReply, err error)
+	// CheckPermission 权限校验，返回是否放行及命中的策略
+	CheckPermission(ctx context.Context, req *CheckPermissionRequest, opts ...http.CallOption) (rsp *CheckPermissionReply, err error)
 	// CreateApi 创建api
 	CreateApi(ctx context.Context, req *CreateApiRequest, opts ...http.CallOption) (rsp *CreateApiReply, err error)
 	// DeleteApi 删除api
@@ -213,6 +264,8 @@ type ApiHTTPClient interface {
 	ListApi(ctx context.Context, req *ListApiRequest, opts ...http.CallOption) (rsp *ListApiReply, err error)
 	// QueryPolicyPathByRoleKey 获取角色拥有的api权限
 	QueryPolicyPathByRoleKey(ctx context.Context, req *QueryPolicyPathByRoleKeyRequest, opts ...http.CallOption) (rsp *QueryPolicyPathByRoleKeyReply, err error)
+	// SyncApi 根据已注册的路由同步api，并标记已失效的api
+	SyncApi(ctx context.Context, req *SyncApiRequest, opts ...http.CallOption) (rsp *SyncApiReply, err error)
 	// UpdateApi 更新api
 	UpdateApi(ctx context.Context, req *UpdateApiRequest, opts ...http.CallOption) (rsp *UpdateApiReply, err error)
 }
@@ -239,6 +292,20 @@ func (c *ApiHTTPClientImpl) AllApi(ctx context.Context, in *AllApiRequest, opts
 	return &out, nil
 }
 
+// CheckPermission 权限校验，返回是否放行及命中的策略
+func (c *ApiHTTPClientImpl) CheckPermission(ctx context.Context, in *CheckPermissionRequest, opts ...http.CallOption) (*CheckPermissionReply, error) {
+	var out CheckPermissionReply
+	pattern := "/system/api/checkPermission"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationApiCheckPermission))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateApi 创建api
 func (c *ApiHTTPClientImpl) CreateApi(ctx context.Context, in *CreateApiRequest, opts ...http.CallOption) (*CreateApiReply, error) {
 	var out CreateApiReply
@@ -309,6 +376,20 @@ func (c *ApiHTTPClientImpl) QueryPolicyPathByRoleKey(ctx context.Context, in *Qu
 	return &out, nil
 }
 
+// SyncApi 根据已注册的路由同步api，并标记已失效的api
+func (c *ApiHTTPClientImpl) SyncApi(ctx context.Context, in *SyncApiRequest, opts ...http.CallOption) (*SyncApiReply, error) {
+	var out SyncApiReply
+	pattern := "/system/api/sync"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationApiSyncApi))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateApi 更新api
 func (c *ApiHTTPClientImpl) UpdateApi(ctx context.Context, in *UpdateApiRequest, opts ...http.CallOption) (*UpdateApiReply, error) {
 	var out UpdateApiReply
@@ -911,31 +911,34 @@ func (x *SimpleMenu) GetChildren() []*SimpleMenu {
 }
 
 type UserData struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	NickName      string                 `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
-	Phone         string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
-	RoleId        int32                  `protobuf:"varint,5,opt,name=roleId,proto3" json:"roleId,omitempty"`
-	Salt          string                 `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
-	Avatar        string                 `protobuf:"bytes,7,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Sex           int64                  `protobuf:"varint,8,opt,name=sex,proto3" json:"sex,omitempty"`
-	Email         string                 `protobuf:"bytes,9,opt,name=email,proto3" json:"email,omitempty"`
-	DeptId        int32                  `protobuf:"varint,10,opt,name=deptId,proto3" json:"deptId,omitempty"`
-	PostId        int32                  `protobuf:"varint,11,opt,name=postId,proto3" json:"postId,omitempty"`
-	RoleIds       string                 `protobuf:"bytes,12,opt,name=roleIds,proto3" json:"roleIds,omitempty"`
-	PostIds       string                 `protobuf:"bytes,13,opt,name=postIds,proto3" json:"postIds,omitempty"`
-	CreateBy      string                 `protobuf:"bytes,14,opt,name=createBy,proto3" json:"createBy,omitempty"`
-	UpdateBy      string                 `protobuf:"bytes,15,opt,name=updateBy,proto3" json:"updateBy,omitempty"`
-	Remark        string                 `protobuf:"bytes,16,opt,name=remark,proto3" json:"remark,omitempty"`
-	Status        int32                  `protobuf:"varint,17,opt,name=status,proto3" json:"status,omitempty"`
-	Username      string                 `protobuf:"bytes,18,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,19,opt,name=password,proto3" json:"password,omitempty"`
-	RoleName      string                 `protobuf:"bytes,20,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
-	DeptName      string                 `protobuf:"bytes,21,opt,name=dept_name,json=deptName,proto3" json:"dept_name,omitempty"`
-	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=createTime,proto3" json:"createTime,omitempty"`
-	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
-	Secret        string                 `protobuf:"bytes,24,opt,name=secret,proto3" json:"secret,omitempty"`
-	Qrcode        string                 `protobuf:"bytes,25,opt,name=qrcode,proto3" json:"qrcode,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	UserId     int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	NickName   string                 `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
+	Phone      string                 `protobuf:"bytes,4,opt,name=phone,proto3" json:"phone,omitempty"`
+	RoleId     int32                  `protobuf:"varint,5,opt,name=roleId,proto3" json:"roleId,omitempty"`
+	Salt       string                 `protobuf:"bytes,6,opt,name=salt,proto3" json:"salt,omitempty"`
+	Avatar     string                 `protobuf:"bytes,7,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Sex        int64                  `protobuf:"varint,8,opt,name=sex,proto3" json:"sex,omitempty"`
+	Email      string                 `protobuf:"bytes,9,opt,name=email,proto3" json:"email,omitempty"`
+	DeptId     int32                  `protobuf:"varint,10,opt,name=deptId,proto3" json:"deptId,omitempty"`
+	PostId     int32                  `protobuf:"varint,11,opt,name=postId,proto3" json:"postId,omitempty"`
+	RoleIds    string                 `protobuf:"bytes,12,opt,name=roleIds,proto3" json:"roleIds,omitempty"`
+	PostIds    string                 `protobuf:"bytes,13,opt,name=postIds,proto3" json:"postIds,omitempty"`
+	CreateBy   string                 `protobuf:"bytes,14,opt,name=createBy,proto3" json:"createBy,omitempty"`
+	UpdateBy   string                 `protobuf:"bytes,15,opt,name=updateBy,proto3" json:"updateBy,omitempty"`
+	Remark     string                 `protobuf:"bytes,16,opt,name=remark,proto3" json:"remark,omitempty"`
+	Status     int32                  `protobuf:"varint,17,opt,name=status,proto3" json:"status,omitempty"`
+	Username   string                 `protobuf:"bytes,18,opt,name=username,proto3" json:"username,omitempty"`
+	Password   string                 `protobuf:"bytes,19,opt,name=password,proto3" json:"password,omitempty"`
+	RoleName   string                 `protobuf:"bytes,20,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	DeptName   string                 `protobuf:"bytes,21,opt,name=dept_name,json=deptName,proto3" json:"dept_name,omitempty"`
+	CreateTime *timestamppb.Timestamp `protobuf:"bytes,22,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	UpdateTime *timestamppb.Timestamp `protobuf:"bytes,23,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	Secret     string                 `protobuf:"bytes,24,opt,name=secret,proto3" json:"secret,omitempty"`
+	Qrcode     string                 `protobuf:"bytes,25,opt,name=qrcode,proto3" json:"qrcode,omitempty"`
+	// 最近一次成功登录
+	LastLoginTime *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=lastLoginTime,proto3" json:"lastLoginTime,omitempty"`
+	LastLoginIp   string                 `protobuf:"bytes,27,opt,name=lastLoginIp,proto3" json:"lastLoginIp,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1138,6 +1141,20 @@ func (x *UserData) GetQrcode() string {
 	return ""
 }
 
+func (x *UserData) GetLastLoginTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastLoginTime
+	}
+	return nil
+}
+
+func (x *UserData) GetLastLoginIp() string {
+	if x != nil {
+		return x.LastLoginIp
+	}
+	return ""
+}
+
 type PostData struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	PostId        int64                  `protobuf:"varint,1,opt,name=postId,proto3" json:"postId,omitempty"`
@@ -1900,7 +1917,7 @@ var File_base_proto protoreflect.FileDescriptor
 const file_base_proto_rawDesc = "" +
 	"\n" +
 	"\n" +
-	"base.proto\x12\fapi.admin.v1\x1a\x1fgoogle/protobuf/timestamp.proto\"5\n" +
+	"base.proto\x12\fapi.admin.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\roptions.proto\"5\n" +
 	"\aApiBase\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
 	"\x06method\x18\x02 \x01(\tR\x06method\"\xca\x03\n" +
@@ -2005,13 +2022,13 @@ const file_base_proto_rawDesc = "" +
 	"SimpleMenu\x12\x16\n" +
 	"\x06menuId\x18\x01 \x01(\x03R\x06menuId\x12\x1a\n" +
 	"\bmenuName\x18\x02 \x01(\tR\bmenuName\x124\n" +
-	"\bchildren\x18\x03 \x03(\v2\x18.api.admin.v1.SimpleMenuR\bchildren\"\xa6\x05\n" +
+	"\bchildren\x18\x03 \x03(\v2\x18.api.admin.v1.SimpleMenuR\bchildren\"\xa2\x06\n" +
 	"\bUserData\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\bnickName\x18\x03 \x01(\tR\bnickName\x12\x14\n" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x16\n" +
-	"\x06roleId\x18\x05 \x01(\x05R\x06roleId\x12\x12\n" +
-	"\x04salt\x18\x06 \x01(\tR\x04salt\x12\x16\n" +
+	"\x06roleId\x18\x05 \x01(\x05R\x06roleId\x12\x18\n" +
+	"\x04salt\x18\x06 \x01(\tB\x04\x88\xb2\x19\x01R\x04salt\x12\x16\n" +
 	"\x06avatar\x18\a \x01(\tR\x06avatar\x12\x10\n" +
 	"\x03sex\x18\b \x01(\x03R\x03sex\x12\x14\n" +
 	"\x05email\x18\t \x01(\tR\x05email\x12\x16\n" +
@@ -2024,8 +2041,8 @@ const file_base_proto_rawDesc = "" +
 	"\bupdateBy\x18\x0f \x01(\tR\bupdateBy\x12\x16\n" +
 	"\x06remark\x18\x10 \x01(\tR\x06remark\x12\x16\n" +
 	"\x06status\x18\x11 \x01(\x05R\x06status\x12\x1a\n" +
-	"\busername\x18\x12 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x13 \x01(\tR\bpassword\x12\x1b\n" +
+	"\busername\x18\x12 \x01(\tR\busername\x12 \n" +
+	"\bpassword\x18\x13 \x01(\tB\x04\x88\xb2\x19\x01R\bpassword\x12\x1b\n" +
 	"\trole_name\x18\x14 \x01(\tR\broleName\x12\x1b\n" +
 	"\tdept_name\x18\x15 \x01(\tR\bdeptName\x12:\n" +
 	"\n" +
@@ -2033,9 +2050,11 @@ const file_base_proto_rawDesc = "" +
 	"createTime\x12:\n" +
 	"\n" +
 	"updateTime\x18\x17 \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updateTime\x12\x16\n" +
-	"\x06secret\x18\x18 \x01(\tR\x06secret\x12\x16\n" +
-	"\x06qrcode\x18\x19 \x01(\tR\x06qrcode\"\xce\x02\n" +
+	"updateTime\x12\x1c\n" +
+	"\x06secret\x18\x18 \x01(\tB\x04\x88\xb2\x19\x01R\x06secret\x12\x1c\n" +
+	"\x06qrcode\x18\x19 \x01(\tB\x04\x88\xb2\x19\x01R\x06qrcode\x12@\n" +
+	"\rlastLoginTime\x18\x1a \x01(\v2\x1a.google.protobuf.TimestampR\rlastLoginTime\x12 \n" +
+	"\vlastLoginIp\x18\x1b \x01(\tR\vlastLoginIp\"\xce\x02\n" +
 	"\bPostData\x12\x16\n" +
 	"\x06postId\x18\x01 \x01(\x03R\x06postId\x12\x1a\n" +
 	"\bpostName\x18\x03 \x01(\tR\bpostName\x12\x1a\n" +
@@ -2175,24 +2194,25 @@ var file_base_proto_depIdxs = []int32{
 	7,  // 12: api.admin.v1.SimpleMenu.children:type_name -> api.admin.v1.SimpleMenu
 	16, // 13: api.admin.v1.UserData.createTime:type_name -> google.protobuf.Timestamp
 	16, // 14: api.admin.v1.UserData.updateTime:type_name -> google.protobuf.Timestamp
-	16, // 15: api.admin.v1.PostData.createTime:type_name -> google.protobuf.Timestamp
-	16, // 16: api.admin.v1.PostData.updateTime:type_name -> google.protobuf.Timestamp
-	10, // 17: api.admin.v1.DeptData.children:type_name -> api.admin.v1.DeptData
-	16, // 18: api.admin.v1.DeptData.createTime:type_name -> google.protobuf.Timestamp
-	16, // 19: api.admin.v1.DeptData.updateTime:type_name -> google.protobuf.Timestamp
-	16, // 20: api.admin.v1.SensitiveInfo.createdAt:type_name -> google.protobuf.Timestamp
-	16, // 21: api.admin.v1.UserInfo.birth:type_name -> google.protobuf.Timestamp
-	16, // 22: api.admin.v1.UserInfo.createTime:type_name -> google.protobuf.Timestamp
-	16, // 23: api.admin.v1.UserInfo.lastLoginTime:type_name -> google.protobuf.Timestamp
-	13, // 24: api.admin.v1.UserInfo.functionStatus:type_name -> api.admin.v1.UserInfo.functionStatusList
-	15, // 25: api.admin.v1.UserInfo.ipList:type_name -> api.admin.v1.UserInfo.ipItemSet
-	16, // 26: api.admin.v1.UserInfo.freezeAt:type_name -> google.protobuf.Timestamp
-	14, // 27: api.admin.v1.UserInfo.ipItemSet.ipItem:type_name -> api.admin.v1.UserInfo.ipItem
-	28, // [28:28] is the sub-list for method output_type
-	28, // [28:28] is the sub-list for method input_type
-	28, // [28:28] is the sub-list for extension type_name
-	28, // [28:28] is the sub-list for extension extendee
-	0,  // [0:28] is the sub-list for field type_name
+	16, // 15: api.admin.v1.UserData.lastLoginTime:type_name -> google.protobuf.Timestamp
+	16, // 16: api.admin.v1.PostData.createTime:type_name -> google.protobuf.Timestamp
+	16, // 17: api.admin.v1.PostData.updateTime:type_name -> google.protobuf.Timestamp
+	10, // 18: api.admin.v1.DeptData.children:type_name -> api.admin.v1.DeptData
+	16, // 19: api.admin.v1.DeptData.createTime:type_name -> google.protobuf.Timestamp
+	16, // 20: api.admin.v1.DeptData.updateTime:type_name -> google.protobuf.Timestamp
+	16, // 21: api.admin.v1.SensitiveInfo.createdAt:type_name -> google.protobuf.Timestamp
+	16, // 22: api.admin.v1.UserInfo.birth:type_name -> google.protobuf.Timestamp
+	16, // 23: api.admin.v1.UserInfo.createTime:type_name -> google.protobuf.Timestamp
+	16, // 24: api.admin.v1.UserInfo.lastLoginTime:type_name -> google.protobuf.Timestamp
+	13, // 25: api.admin.v1.UserInfo.functionStatus:type_name -> api.admin.v1.UserInfo.functionStatusList
+	15, // 26: api.admin.v1.UserInfo.ipList:type_name -> api.admin.v1.UserInfo.ipItemSet
+	16, // 27: api.admin.v1.UserInfo.freezeAt:type_name -> google.protobuf.Timestamp
+	14, // 28: api.admin.v1.UserInfo.ipItemSet.ipItem:type_name -> api.admin.v1.UserInfo.ipItem
+	29, // [29:29] is the sub-list for method output_type
+	29, // [29:29] is the sub-list for method input_type
+	29, // [29:29] is the sub-list for extension type_name
+	29, // [29:29] is the sub-list for extension extendee
+	0,  // [0:29] is the sub-list for field type_name
 }
 
 func init() { file_base_proto_init() }
@@ -2200,6 +2220,7 @@ func file_base_proto_init() {
 	if File_base_proto != nil {
 		return
 	}
+	file_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
@@ -615,7 +615,11 @@ func (x *UpdateDeptRequest) GetSort() int32 {
 }
 
 type UpdateDeptReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 修改了上级部门时，路径被重写的部门，含部门本身
+	DeptIds []int64 `protobuf:"varint,1,rep,packed,name=deptIds,proto3" json:"deptIds,omitempty"`
+	// 修改了上级部门时，各角色数据权限的变化
+	RoleChanges   []*RoleDeptChange `protobuf:"bytes,2,rep,name=roleChanges,proto3" json:"roleChanges,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -650,16 +654,213 @@ func (*UpdateDeptReply) Descriptor() ([]byte, []int) {
 	return file_dept_proto_rawDescGZIP(), []int{10}
 }
 
-type DeleteDeptRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+func (x *UpdateDeptReply) GetDeptIds() []int64 {
+	if x != nil {
+		return x.DeptIds
+	}
+	return nil
+}
+
+func (x *UpdateDeptReply) GetRoleChanges() []*RoleDeptChange {
+	if x != nil {
+		return x.RoleChanges
+	}
+	return nil
+}
+
+type MoveDeptRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	DeptId int64                  `protobuf:"varint,1,opt,name=deptId,proto3" json:"deptId,omitempty"`
+	// 新的上级部门，0 表示移动为顶级部门
+	ParentId      int64 `protobuf:"varint,2,opt,name=parentId,proto3" json:"parentId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MoveDeptRequest) Reset() {
+	*x = MoveDeptRequest{}
+	mi := &file_dept_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveDeptRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveDeptRequest) ProtoMessage() {}
+
+func (x *MoveDeptRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dept_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveDeptRequest.ProtoReflect.Descriptor instead.
+func (*MoveDeptRequest) Descriptor() ([]byte, []int) {
+	return file_dept_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *MoveDeptRequest) GetDeptId() int64 {
+	if x != nil {
+		return x.DeptId
+	}
+	return 0
+}
+
+func (x *MoveDeptRequest) GetParentId() int64 {
+	if x != nil {
+		return x.ParentId
+	}
+	return 0
+}
+
+type MoveDeptReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 路径被重写的部门，含被移动的部门本身
+	DeptIds []int64 `protobuf:"varint,1,rep,packed,name=deptIds,proto3" json:"deptIds,omitempty"`
+	// 数据权限发生变化的角色
+	RoleIds []int64 `protobuf:"varint,2,rep,packed,name=roleIds,proto3" json:"roleIds,omitempty"`
+	// 各角色数据权限的变化
+	RoleChanges   []*RoleDeptChange `protobuf:"bytes,3,rep,name=roleChanges,proto3" json:"roleChanges,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
+func (x *MoveDeptReply) Reset() {
+	*x = MoveDeptReply{}
+	mi := &file_dept_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MoveDeptReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveDeptReply) ProtoMessage() {}
+
+func (x *MoveDeptReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dept_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveDeptReply.ProtoReflect.Descriptor instead.
+func (*MoveDeptReply) Descriptor() ([]byte, []int) {
+	return file_dept_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *MoveDeptReply) GetDeptIds() []int64 {
+	if x != nil {
+		return x.DeptIds
+	}
+	return nil
+}
+
+func (x *MoveDeptReply) GetRoleIds() []int64 {
+	if x != nil {
+		return x.RoleIds
+	}
+	return nil
+}
+
+func (x *MoveDeptReply) GetRoleChanges() []*RoleDeptChange {
+	if x != nil {
+		return x.RoleChanges
+	}
+	return nil
+}
+
+// RoleDeptChange 移动部门后角色数据权限的变化
+type RoleDeptChange struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	RoleId int64                  `protobuf:"varint,1,opt,name=roleId,proto3" json:"roleId,omitempty"`
+	// 移动后新覆盖的部门
+	GrantedDeptIds []int64 `protobuf:"varint,2,rep,packed,name=grantedDeptIds,proto3" json:"grantedDeptIds,omitempty"`
+	// 移动后不再覆盖的部门
+	RevokedDeptIds []int64 `protobuf:"varint,3,rep,packed,name=revokedDeptIds,proto3" json:"revokedDeptIds,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RoleDeptChange) Reset() {
+	*x = RoleDeptChange{}
+	mi := &file_dept_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleDeptChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleDeptChange) ProtoMessage() {}
+
+func (x *RoleDeptChange) ProtoReflect() protoreflect.Message {
+	mi := &file_dept_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleDeptChange.ProtoReflect.Descriptor instead.
+func (*RoleDeptChange) Descriptor() ([]byte, []int) {
+	return file_dept_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RoleDeptChange) GetRoleId() int64 {
+	if x != nil {
+		return x.RoleId
+	}
+	return 0
+}
+
+func (x *RoleDeptChange) GetGrantedDeptIds() []int64 {
+	if x != nil {
+		return x.GrantedDeptIds
+	}
+	return nil
+}
+
+func (x *RoleDeptChange) GetRevokedDeptIds() []int64 {
+	if x != nil {
+		return x.RevokedDeptIds
+	}
+	return nil
+}
+
+type DeleteDeptRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 同时删除全部下级部门并解除角色的数据权限关联，否则存在下级或关联时拒绝删除
+	Cascade bool `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	// 将被删除部门的用户转移到该部门，为 0 时存在用户则拒绝删除
+	ReassignDeptId int64 `protobuf:"varint,3,opt,name=reassignDeptId,proto3" json:"reassignDeptId,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
 func (x *DeleteDeptRequest) Reset() {
 	*x = DeleteDeptRequest{}
-	mi := &file_dept_proto_msgTypes[11]
+	mi := &file_dept_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -671,7 +872,7 @@ func (x *DeleteDeptRequest) String() string {
 func (*DeleteDeptRequest) ProtoMessage() {}
 
 func (x *DeleteDeptRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_dept_proto_msgTypes[11]
+	mi := &file_dept_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -684,7 +885,7 @@ func (x *DeleteDeptRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDeptRequest.ProtoReflect.Descriptor instead.
 func (*DeleteDeptRequest) Descriptor() ([]byte, []int) {
-	return file_dept_proto_rawDescGZIP(), []int{11}
+	return file_dept_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *DeleteDeptRequest) GetId() int64 {
@@ -694,6 +895,20 @@ func (x *DeleteDeptRequest) GetId() int64 {
 	return 0
 }
 
+func (x *DeleteDeptRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
+func (x *DeleteDeptRequest) GetReassignDeptId() int64 {
+	if x != nil {
+		return x.ReassignDeptId
+	}
+	return 0
+}
+
 type DeleteDeptReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -702,7 +917,7 @@ type DeleteDeptReply struct {
 
 func (x *DeleteDeptReply) Reset() {
 	*x = DeleteDeptReply{}
-	mi := &file_dept_proto_msgTypes[12]
+	mi := &file_dept_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -714,7 +929,7 @@ func (x *DeleteDeptReply) String() string {
 func (*DeleteDeptReply) ProtoMessage() {}
 
 func (x *DeleteDeptReply) ProtoReflect() protoreflect.Message {
-	mi := &file_dept_proto_msgTypes[12]
+	mi := &file_dept_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -727,7 +942,7 @@ func (x *DeleteDeptReply) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteDeptReply.ProtoReflect.Descriptor instead.
 func (*DeleteDeptReply) Descriptor() ([]byte, []int) {
-	return file_dept_proto_rawDescGZIP(), []int{12}
+	return file_dept_proto_rawDescGZIP(), []int{15}
 }
 
 var File_dept_proto protoreflect.FileDescriptor
@@ -775,18 +990,34 @@ const file_dept_proto_rawDesc = "" +
 	"\x05phone\x18\x05 \x01(\tR\x05phone\x12\x14\n" +
 	"\x05email\x18\x06 \x01(\tR\x05email\x12\x16\n" +
 	"\x06status\x18\a \x01(\x03R\x06status\x12\x12\n" +
-	"\x04sort\x18\b \x01(\x05R\x04sort\"\x11\n" +
-	"\x0fUpdateDeptReply\"#\n" +
+	"\x04sort\x18\b \x01(\x05R\x04sort\"k\n" +
+	"\x0fUpdateDeptReply\x12\x18\n" +
+	"\adeptIds\x18\x01 \x03(\x03R\adeptIds\x12>\n" +
+	"\vroleChanges\x18\x02 \x03(\v2\x1c.api.admin.v1.RoleDeptChangeR\vroleChanges\"E\n" +
+	"\x0fMoveDeptRequest\x12\x16\n" +
+	"\x06deptId\x18\x01 \x01(\x03R\x06deptId\x12\x1a\n" +
+	"\bparentId\x18\x02 \x01(\x03R\bparentId\"\x83\x01\n" +
+	"\rMoveDeptReply\x12\x18\n" +
+	"\adeptIds\x18\x01 \x03(\x03R\adeptIds\x12\x18\n" +
+	"\aroleIds\x18\x02 \x03(\x03R\aroleIds\x12>\n" +
+	"\vroleChanges\x18\x03 \x03(\v2\x1c.api.admin.v1.RoleDeptChangeR\vroleChanges\"x\n" +
+	"\x0eRoleDeptChange\x12\x16\n" +
+	"\x06roleId\x18\x01 \x01(\x03R\x06roleId\x12&\n" +
+	"\x0egrantedDeptIds\x18\x02 \x03(\x03R\x0egrantedDeptIds\x12&\n" +
+	"\x0erevokedDeptIds\x18\x03 \x03(\x03R\x0erevokedDeptIds\"e\n" +
 	"\x11DeleteDeptRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x11\n" +
-	"\x0fDeleteDeptReply2\xaf\x05\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\x12&\n" +
+	"\x0ereassignDeptId\x18\x03 \x01(\x03R\x0ereassignDeptId\"\x11\n" +
+	"\x0fDeleteDeptReply2\x95\x06\n" +
 	"\x04Dept\x12a\n" +
 	"\bListDept\x12\x1d.api.admin.v1.ListDeptRequest\x1a\x1b.api.admin.v1.ListDeptReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/system/dept/list\x12t\n" +
 	"\rQueryDeptTree\x12\".api.admin.v1.QueryDeptTreeRequest\x1a .api.admin.v1.QueryDeptTreeReply\"\x1d\x82\xd3\xe4\x93\x02\x17\x12\x15/system/dept/deptTree\x12e\n" +
 	"\n" +
 	"CreateDept\x12\x1f.api.admin.v1.CreateDeptRequest\x1a\x1d.api.admin.v1.CreateDeptReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/system/dept\x12e\n" +
 	"\n" +
-	"UpdateDept\x12\x1f.api.admin.v1.UpdateDeptRequest\x1a\x1d.api.admin.v1.UpdateDeptReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/system/dept\x12g\n" +
+	"UpdateDept\x12\x1f.api.admin.v1.UpdateDeptRequest\x1a\x1d.api.admin.v1.UpdateDeptReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/system/dept\x12d\n" +
+	"\bMoveDept\x12\x1d.api.admin.v1.MoveDeptRequest\x1a\x1b.api.admin.v1.MoveDeptReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/system/dept/move\x12g\n" +
 	"\n" +
 	"DeleteDept\x12\x1f.api.admin.v1.DeleteDeptRequest\x1a\x1d.api.admin.v1.DeleteDeptReply\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/system/dept/{id}\x12\x96\x01\n" +
 	"\x12RoleDeptTreeSelect\x12'.api.admin.v1.RoleDeptTreeSelectRequest\x1a%.api.admin.v1.RoleDeptTreeSelectReply\"0\x82\xd3\xe4\x93\x02*\x12(/system/dept/roleDeptTreeSelect/{roleId}B6Z4github.com/swordkee/kratos-vue-admin/api/admin/v1;v1b\x06proto3"
@@ -803,7 +1034,7 @@ func file_dept_proto_rawDescGZIP() []byte {
 	return file_dept_proto_rawDescData
 }
 
-var file_dept_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_dept_proto_msgTypes = make([]protoimpl.MessageInfo, 16)
 var file_dept_proto_goTypes = []any{
 	(*RoleDeptTreeSelectRequest)(nil), // 0: api.admin.v1.RoleDeptTreeSelectRequest
 	(*RoleDeptTreeSelectReply)(nil),   // 1: api.admin.v1.RoleDeptTreeSelectReply
@@ -816,32 +1047,39 @@ var file_dept_proto_goTypes = []any{
 	(*CreateDeptReply)(nil),           // 8: api.admin.v1.CreateDeptReply
 	(*UpdateDeptRequest)(nil),         // 9: api.admin.v1.UpdateDeptRequest
 	(*UpdateDeptReply)(nil),           // 10: api.admin.v1.UpdateDeptReply
-	(*DeleteDeptRequest)(nil),         // 11: api.admin.v1.DeleteDeptRequest
-	(*DeleteDeptReply)(nil),           // 12: api.admin.v1.DeleteDeptReply
-	(*DeptTree)(nil),                  // 13: api.admin.v1.DeptTree
+	(*MoveDeptRequest)(nil),           // 11: api.admin.v1.MoveDeptRequest
+	(*MoveDeptReply)(nil),             // 12: api.admin.v1.MoveDeptReply
+	(*RoleDeptChange)(nil),            // 13: api.admin.v1.RoleDeptChange
+	(*DeleteDeptRequest)(nil),         // 14: api.admin.v1.DeleteDeptRequest
+	(*DeleteDeptReply)(nil),           // 15: api.admin.v1.DeleteDeptReply
+	(*DeptTree)(nil),                  // 16: api.admin.v1.DeptTree
 }
 var file_dept_proto_depIdxs = []int32{
 	2,  // 0: api.admin.v1.RoleDeptTreeSelectReply.depts:type_name -> api.admin.v1.DeptLabel
 	2,  // 1: api.admin.v1.DeptLabel.children:type_name -> api.admin.v1.DeptLabel
-	13, // 2: api.admin.v1.ListDeptReply.data:type_name -> api.admin.v1.DeptTree
-	13, // 3: api.admin.v1.QueryDeptTreeReply.data:type_name -> api.admin.v1.DeptTree
-	3,  // 4: api.admin.v1.Dept.ListDept:input_type -> api.admin.v1.ListDeptRequest
-	5,  // 5: api.admin.v1.Dept.QueryDeptTree:input_type -> api.admin.v1.QueryDeptTreeRequest
-	7,  // 6: api.admin.v1.Dept.CreateDept:input_type -> api.admin.v1.CreateDeptRequest
-	9,  // 7: api.admin.v1.Dept.UpdateDept:input_type -> api.admin.v1.UpdateDeptRequest
-	11, // 8: api.admin.v1.Dept.DeleteDept:input_type -> api.admin.v1.DeleteDeptRequest
-	0,  // 9: api.admin.v1.Dept.RoleDeptTreeSelect:input_type -> api.admin.v1.RoleDeptTreeSelectRequest
-	4,  // 10: api.admin.v1.Dept.ListDept:output_type -> api.admin.v1.ListDeptReply
-	6,  // 11: api.admin.v1.Dept.QueryDeptTree:output_type -> api.admin.v1.QueryDeptTreeReply
-	8,  // 12: api.admin.v1.Dept.CreateDept:output_type -> api.admin.v1.CreateDeptReply
-	10, // 13: api.admin.v1.Dept.UpdateDept:output_type -> api.admin.v1.UpdateDeptReply
-	12, // 14: api.admin.v1.Dept.DeleteDept:output_type -> api.admin.v1.DeleteDeptReply
-	1,  // 15: api.admin.v1.Dept.RoleDeptTreeSelect:output_type -> api.admin.v1.RoleDeptTreeSelectReply
-	10, // [10:16] is the sub-list for method output_type
-	4,  // [4:10] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	16, // 2: api.admin.v1.ListDeptReply.data:type_name -> api.admin.v1.DeptTree
+	16, // 3: api.admin.v1.QueryDeptTreeReply.data:type_name -> api.admin.v1.DeptTree
+	13, // 4: api.admin.v1.UpdateDeptReply.roleChanges:type_name -> api.admin.v1.RoleDeptChange
+	13, // 5: api.admin.v1.MoveDeptReply.roleChanges:type_name -> api.admin.v1.RoleDeptChange
+	3,  // 6: api.admin.v1.Dept.ListDept:input_type -> api.admin.v1.ListDeptRequest
+	5,  // 7: api.admin.v1.Dept.QueryDeptTree:input_type -> api.admin.v1.QueryDeptTreeRequest
+	7,  // 8: api.admin.v1.Dept.CreateDept:input_type -> api.admin.v1.CreateDeptRequest
+	9,  // 9: api.admin.v1.Dept.UpdateDept:input_type -> api.admin.v1.UpdateDeptRequest
+	11, // 10: api.admin.v1.Dept.MoveDept:input_type -> api.admin.v1.MoveDeptRequest
+	14, // 11: api.admin.v1.Dept.DeleteDept:input_type -> api.admin.v1.DeleteDeptRequest
+	0,  // 12: api.admin.v1.Dept.RoleDeptTreeSelect:input_type -> api.admin.v1.RoleDeptTreeSelectRequest
+	4,  // 13: api.admin.v1.Dept.ListDept:output_type -> api.admin.v1.ListDeptReply
+	6,  // 14: api.admin.v1.Dept.QueryDeptTree:output_type -> api.admin.v1.QueryDeptTreeReply
+	8,  // 15: api.admin.v1.Dept.CreateDept:output_type -> api.admin.v1.CreateDeptReply
+	10, // 16: api.admin.v1.Dept.UpdateDept:output_type -> api.admin.v1.UpdateDeptReply
+	12, // 17: api.admin.v1.Dept.MoveDept:output_type -> api.admin.v1.MoveDeptReply
+	15, // 18: api.admin.v1.Dept.DeleteDept:output_type -> api.admin.v1.DeleteDeptReply
+	1,  // 19: api.admin.v1.Dept.RoleDeptTreeSelect:output_type -> api.admin.v1.RoleDeptTreeSelectReply
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_dept_proto_init() }
@@ -856,7 +1094,7 @@ func file_dept_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dept_proto_rawDesc), len(file_dept_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   16,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
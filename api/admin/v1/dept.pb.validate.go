@@ -1252,6 +1252,40 @@ func (m *UpdateDeptReply) validate(all bool) error {
 
 	var errors []error
 
+	for idx, item := range m.GetRoleChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UpdateDeptReplyValidationError{
+						field:  fmt.Sprintf("RoleChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UpdateDeptReplyValidationError{
+						field:  fmt.Sprintf("RoleChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UpdateDeptReplyValidationError{
+					field:  fmt.Sprintf("RoleChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return UpdateDeptReplyMultiError(errors)
 	}
@@ -1330,6 +1364,346 @@ var _ interface {
 	ErrorName() string
 } = UpdateDeptReplyValidationError{}
 
+// Validate checks the field values on MoveDeptRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveDeptRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveDeptRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveDeptRequestMultiError, or nil if none found.
+func (m *MoveDeptRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveDeptRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DeptId
+
+	// no validation rules for ParentId
+
+	if len(errors) > 0 {
+		return MoveDeptRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveDeptRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveDeptRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MoveDeptRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveDeptRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveDeptRequestMultiError) AllErrors() []error { return m }
+
+// MoveDeptRequestValidationError is the validation error returned by
+// MoveDeptRequest.Validate if the designated constraints aren't met.
+type MoveDeptRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveDeptRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveDeptRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveDeptRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveDeptRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveDeptRequestValidationError) ErrorName() string { return "MoveDeptRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveDeptRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveDeptRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveDeptRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveDeptRequestValidationError{}
+
+// Validate checks the field values on MoveDeptReply with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *MoveDeptReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveDeptReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in MoveDeptReplyMultiError, or
+// nil if none found.
+func (m *MoveDeptReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveDeptReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoleChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, MoveDeptReplyValidationError{
+						field:  fmt.Sprintf("RoleChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, MoveDeptReplyValidationError{
+						field:  fmt.Sprintf("RoleChanges[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return MoveDeptReplyValidationError{
+					field:  fmt.Sprintf("RoleChanges[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return MoveDeptReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoveDeptReplyMultiError is an error wrapping multiple validation errors
+// returned by MoveDeptReply.ValidateAll() if the designated constraints
+// aren't met.
+type MoveDeptReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoveDeptReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoveDeptReplyMultiError) AllErrors() []error { return m }
+
+// MoveDeptReplyValidationError is the validation error returned by
+// MoveDeptReply.Validate if the designated constraints aren't met.
+type MoveDeptReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoveDeptReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoveDeptReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoveDeptReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoveDeptReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoveDeptReplyValidationError) ErrorName() string { return "MoveDeptReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoveDeptReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoveDeptReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoveDeptReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoveDeptReplyValidationError{}
+
+// Validate checks the field values on RoleDeptChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleDeptChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleDeptChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleDeptChangeMultiError,
+// or nil if none found.
+func (m *RoleDeptChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleDeptChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoleId
+
+	if len(errors) > 0 {
+		return RoleDeptChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleDeptChangeMultiError is an error wrapping multiple validation errors
+// returned by RoleDeptChange.ValidateAll() if the designated constraints
+// aren't met.
+type RoleDeptChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleDeptChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleDeptChangeMultiError) AllErrors() []error { return m }
+
+// RoleDeptChangeValidationError is the validation error returned by
+// RoleDeptChange.Validate if the designated constraints aren't met.
+type RoleDeptChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleDeptChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleDeptChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleDeptChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleDeptChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleDeptChangeValidationError) ErrorName() string { return "RoleDeptChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleDeptChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleDeptChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleDeptChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleDeptChangeValidationError{}
+
 // Validate checks the field values on DeleteDeptRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
@@ -1354,6 +1728,10 @@ func (m *DeleteDeptRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Cascade
+
+	// no validation rules for ReassignDeptId
+
 	if len(errors) > 0 {
 		return DeleteDeptRequestMultiError(errors)
 	}
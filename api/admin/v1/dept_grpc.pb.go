@@ -23,6 +23,7 @@ const (
 	Dept_QueryDeptTree_FullMethodName      = "/api.admin.v1.Dept/QueryDeptTree"
 	Dept_CreateDept_FullMethodName         = "/api.admin.v1.Dept/CreateDept"
 	Dept_UpdateDept_FullMethodName         = "/api.admin.v1.Dept/UpdateDept"
+	Dept_MoveDept_FullMethodName           = "/api.admin.v1.Dept/MoveDept"
 	Dept_DeleteDept_FullMethodName         = "/api.admin.v1.Dept/DeleteDept"
 	Dept_RoleDeptTreeSelect_FullMethodName = "/api.admin.v1.Dept/RoleDeptTreeSelect"
 )
@@ -41,6 +42,8 @@ type DeptClient interface {
 	CreateDept(ctx context.Context, in *CreateDeptRequest, opts ...grpc.CallOption) (*CreateDeptReply, error)
 	// 更新部门
 	UpdateDept(ctx context.Context, in *UpdateDeptRequest, opts ...grpc.CallOption) (*UpdateDeptReply, error)
+	// 移动部门及其下级到新的上级部门
+	MoveDept(ctx context.Context, in *MoveDeptRequest, opts ...grpc.CallOption) (*MoveDeptReply, error)
 	// 删除部门
 	DeleteDept(ctx context.Context, in *DeleteDeptRequest, opts ...grpc.CallOption) (*DeleteDeptReply, error)
 	// 获取角色部门树
@@ -95,6 +98,16 @@ func (c *deptClient) UpdateDept(ctx context.Context, in *UpdateDeptRequest, opts
 	return out, nil
 }
 
+func (c *deptClient) MoveDept(ctx context.Context, in *MoveDeptRequest, opts ...grpc.CallOption) (*MoveDeptReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MoveDeptReply)
+	err := c.cc.Invoke(ctx, Dept_MoveDept_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *deptClient) DeleteDept(ctx context.Context, in *DeleteDeptRequest, opts ...grpc.CallOption) (*DeleteDeptReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteDeptReply)
@@ -129,6 +142,8 @@ type DeptServer interface {
 	CreateDept(context.Context, *CreateDeptRequest) (*CreateDeptReply, error)
 	// 更新部门
 	UpdateDept(context.Context, *UpdateDeptRequest) (*UpdateDeptReply, error)
+	// 移动部门及其下级到新的上级部门
+	MoveDept(context.Context, *MoveDeptRequest) (*MoveDeptReply, error)
 	// 删除部门
 	DeleteDept(context.Context, *DeleteDeptRequest) (*DeleteDeptReply, error)
 	// 获取角色部门树
@@ -155,6 +170,9 @@ func (UnimplementedDeptServer) CreateDept(context.Context, *CreateDeptRequest) (
 func (UnimplementedDeptServer) UpdateDept(context.Context, *UpdateDeptRequest) (*UpdateDeptReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method UpdateDept not implemented")
 }
+func (UnimplementedDeptServer) MoveDept(context.Context, *MoveDeptRequest) (*MoveDeptReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method MoveDept not implemented")
+}
 func (UnimplementedDeptServer) DeleteDept(context.Context, *DeleteDeptRequest) (*DeleteDeptReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteDept not implemented")
 }
@@ -254,6 +272,24 @@ func _Dept_UpdateDept_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Dept_MoveDept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveDeptRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeptServer).MoveDept(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Dept_MoveDept_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeptServer).MoveDept(ctx, req.(*MoveDeptRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Dept_DeleteDept_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteDeptRequest)
 	if err := dec(in); err != nil {
@@ -313,6 +349,10 @@ var Dept_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDept",
 			Handler:    _Dept_UpdateDept_Handler,
 		},
+		{
+			MethodName: "MoveDept",
+			Handler:    _Dept_MoveDept_Handler,
+		},
 		{
 			MethodName: "DeleteDept",
 			Handler:    _Dept_DeleteDept_Handler,
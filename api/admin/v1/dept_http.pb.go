@@ -22,6 +22,7 @@ const _ = http.SupportPackageIsVersion1
 const OperationDeptCreateDept = "/api.admin.v1.Dept/CreateDept"
 const OperationDeptDeleteDept = "/api.admin.v1.Dept/DeleteDept"
 const OperationDeptListDept = "/api.admin.v1.Dept/ListDept"
+const OperationDeptMoveDept = "/api.admin.v1.Dept/MoveDept"
 const OperationDeptQueryDeptTree = "/api.admin.v1.Dept/QueryDeptTree"
 const OperationDeptRoleDeptTreeSelect = "/api.admin.v1.Dept/RoleDeptTreeSelect"
 const OperationDeptUpdateDept = "/api.admin.v1.Dept/UpdateDept"
@@ -33,6 +34,8 @@ type DeptHTTPServer interface {
 	DeleteDept(context.Context, *DeleteDeptRequest) (*DeleteDeptReply, error)
 	// ListDept 部门列表
 	ListDept(context.Context, *ListDeptRequest) (*ListDeptReply, error)
+	// MoveDept 移动部门及其下级到新的上级部门
+	MoveDept(context.Context, *MoveDeptRequest) (*MoveDeptReply, error)
 	// QueryDeptTree 获取部门关系结构
 	QueryDeptTree(context.Context, *QueryDeptTreeRequest) (*QueryDeptTreeReply, error)
 	// RoleDeptTreeSelect 获取角色部门树
@@ -47,6 +50,7 @@ func RegisterDeptHTTPServer(s *http.Server, srv DeptHTTPServer) {
 	r.GET("/system/dept/deptTree", _Dept_QueryDeptTree0_HTTP_Handler(srv))
 	r.POST("/system/dept", _Dept_CreateDept0_HTTP_Handler(srv))
 	r.PUT("/system/dept", _Dept_UpdateDept0_HTTP_Handler(srv))
+	r.PUT("/system/dept/move", _Dept_MoveDept0_HTTP_Handler(srv))
 	r.DELETE("/system/dept/{id}", _Dept_DeleteDept0_HTTP_Handler(srv))
 	r.GET("/system/dept/roleDeptTreeSelect/{roleId}", _Dept_RoleDeptTreeSelect0_HTTP_Handler(srv))
 }
@@ -133,6 +137,28 @@ func _Dept_UpdateDept0_HTTP_Handler(srv DeptHTTPServer) func(ctx http.Context) e
 	}
 }
 
+func _Dept_MoveDept0_HTTP_Handler(srv DeptHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in MoveDeptRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDeptMoveDept)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.MoveDept(ctx, req.(*MoveDeptRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*MoveDeptReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Dept_DeleteDept0_HTTP_Handler(srv DeptHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DeleteDeptRequest
@@ -184,6 +210,8 @@ type DeptHTTPClient interface {
 	DeleteDept(ctx context.Context, req *DeleteDeptRequest, opts ...http.CallOption) (rsp *DeleteDeptReply, err error)
 	// ListDept 部门列表
 	ListDept(ctx context.Context, req *ListDeptRequest, opts ...http.CallOption) (rsp *ListDeptReply, err error)
+	// MoveDept 移动部门及其下级到新的上级部门
+	MoveDept(ctx context.Context, req *MoveDeptRequest, opts ...http.CallOption) (rsp *MoveDeptReply, err error)
 	// QueryDeptTree 获取部门关系结构
 	QueryDeptTree(ctx context.Context, req *QueryDeptTreeRequest, opts ...http.CallOption) (rsp *QueryDeptTreeReply, err error)
 	// RoleDeptTreeSelect 获取角色部门树
@@ -242,6 +270,20 @@ func (c *DeptHTTPClientImpl) ListDept(ctx context.Context, in *ListDeptRequest,
 	return &out, nil
 }
 
+// MoveDept 移动部门及其下级到新的上级部门
+func (c *DeptHTTPClientImpl) MoveDept(ctx context.Context, in *MoveDeptRequest, opts ...http.CallOption) (*MoveDeptReply, error) {
+	var out MoveDeptReply
+	pattern := "/system/dept/move"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDeptMoveDept))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // QueryDeptTree 获取部门关系结构
 func (c *DeptHTTPClientImpl) QueryDeptTree(ctx context.Context, in *QueryDeptTreeRequest, opts ...http.CallOption) (*QueryDeptTreeReply, error) {
 	var out QueryDeptTreeReply
@@ -803,6 +803,172 @@ func (x *DictDataContent) GetUpdateTime() *timestamppb.Timestamp {
 	return nil
 }
 
+type BatchDictDataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 逗号分隔的字典类型，如 sys_normal_disable,sys_user_sex
+	DictTypes string `protobuf:"bytes,1,opt,name=dictTypes,proto3" json:"dictTypes,omitempty"`
+	// 上次返回的 etag，数据未变化时只返回 notModified，也可以通过 If-None-Match 请求头传递
+	Etag          string `protobuf:"bytes,2,opt,name=etag,proto3" json:"etag,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDictDataRequest) Reset() {
+	*x = BatchDictDataRequest{}
+	mi := &file_dict_data_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDictDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDictDataRequest) ProtoMessage() {}
+
+func (x *BatchDictDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_data_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDictDataRequest.ProtoReflect.Descriptor instead.
+func (*BatchDictDataRequest) Descriptor() ([]byte, []int) {
+	return file_dict_data_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *BatchDictDataRequest) GetDictTypes() string {
+	if x != nil {
+		return x.DictTypes
+	}
+	return ""
+}
+
+func (x *BatchDictDataRequest) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+type DictTypeDataList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DictType      string                 `protobuf:"bytes,1,opt,name=dictType,proto3" json:"dictType,omitempty"`
+	List          []*DictDataContent     `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictTypeDataList) Reset() {
+	*x = DictTypeDataList{}
+	mi := &file_dict_data_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictTypeDataList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictTypeDataList) ProtoMessage() {}
+
+func (x *DictTypeDataList) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_data_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictTypeDataList.ProtoReflect.Descriptor instead.
+func (*DictTypeDataList) Descriptor() ([]byte, []int) {
+	return file_dict_data_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *DictTypeDataList) GetDictType() string {
+	if x != nil {
+		return x.DictType
+	}
+	return ""
+}
+
+func (x *DictTypeDataList) GetList() []*DictDataContent {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type BatchDictDataReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Etag          string                 `protobuf:"bytes,1,opt,name=etag,proto3" json:"etag,omitempty"`
+	NotModified   bool                   `protobuf:"varint,2,opt,name=notModified,proto3" json:"notModified,omitempty"`
+	List          []*DictTypeDataList    `protobuf:"bytes,3,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BatchDictDataReply) Reset() {
+	*x = BatchDictDataReply{}
+	mi := &file_dict_data_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BatchDictDataReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BatchDictDataReply) ProtoMessage() {}
+
+func (x *BatchDictDataReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_data_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BatchDictDataReply.ProtoReflect.Descriptor instead.
+func (*BatchDictDataReply) Descriptor() ([]byte, []int) {
+	return file_dict_data_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *BatchDictDataReply) GetEtag() string {
+	if x != nil {
+		return x.Etag
+	}
+	return ""
+}
+
+func (x *BatchDictDataReply) GetNotModified() bool {
+	if x != nil {
+		return x.NotModified
+	}
+	return false
+}
+
+func (x *BatchDictDataReply) GetList() []*DictTypeDataList {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
 var File_dict_data_proto protoreflect.FileDescriptor
 
 const file_dict_data_proto_rawDesc = "" +
@@ -876,13 +1042,24 @@ const file_dict_data_proto_rawDesc = "" +
 	"createTime\x12:\n" +
 	"\n" +
 	"updateTime\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\n" +
-	"updateTime2\xed\x04\n" +
+	"updateTime\"H\n" +
+	"\x14BatchDictDataRequest\x12\x1c\n" +
+	"\tdictTypes\x18\x01 \x01(\tR\tdictTypes\x12\x12\n" +
+	"\x04etag\x18\x02 \x01(\tR\x04etag\"a\n" +
+	"\x10DictTypeDataList\x12\x1a\n" +
+	"\bdictType\x18\x01 \x01(\tR\bdictType\x121\n" +
+	"\x04list\x18\x02 \x03(\v2\x1d.api.admin.v1.DictDataContentR\x04list\"~\n" +
+	"\x12BatchDictDataReply\x12\x12\n" +
+	"\x04etag\x18\x01 \x01(\tR\x04etag\x12 \n" +
+	"\vnotModified\x18\x02 \x01(\bR\vnotModified\x122\n" +
+	"\x04list\x18\x03 \x03(\v2\x1e.api.admin.v1.DictTypeDataListR\x04list2\xe5\x05\n" +
 	"\bDictData\x12r\n" +
 	"\fListDictData\x12!.api.admin.v1.ListDictDataRequest\x1a\x1f.api.admin.v1.ListDictDataReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/system/dict/data/type\x12v\n" +
 	"\x0eCreateDictData\x12#.api.admin.v1.CreateDictDataRequest\x1a!.api.admin.v1.CreateDictDataReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/system/dict/data\x12v\n" +
 	"\x0eUpdateDictData\x12#.api.admin.v1.UpdateDictDataRequest\x1a!.api.admin.v1.UpdateDictDataReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/system/dict/data\x12~\n" +
 	"\x0eDeleteDictData\x12#.api.admin.v1.DeleteDictDataRequest\x1a!.api.admin.v1.DeleteDictDataReply\"$\x82\xd3\xe4\x93\x02\x1e*\x1c/system/dict/data/{dictCode}\x12}\n" +
-	"\fFindDictData\x12!.api.admin.v1.FindDictDataRequest\x1a\x1f.api.admin.v1.FindDictDataReply\")\x82\xd3\xe4\x93\x02#\x12!/system/dict/data/info/{dictCode}B6Z4github.com/swordkee/kratos-vue-admin/api/admin/v1;v1b\x06proto3"
+	"\fFindDictData\x12!.api.admin.v1.FindDictDataRequest\x1a\x1f.api.admin.v1.FindDictDataReply\")\x82\xd3\xe4\x93\x02#\x12!/system/dict/data/info/{dictCode}\x12v\n" +
+	"\rBatchDictData\x12\".api.admin.v1.BatchDictDataRequest\x1a .api.admin.v1.BatchDictDataReply\"\x1f\x82\xd3\xe4\x93\x02\x19\x12\x17/system/dict/data/batchB6Z4github.com/swordkee/kratos-vue-admin/api/admin/v1;v1b\x06proto3"
 
 var (
 	file_dict_data_proto_rawDescOnce sync.Once
@@ -896,7 +1073,7 @@ func file_dict_data_proto_rawDescGZIP() []byte {
 	return file_dict_data_proto_rawDescData
 }
 
-var file_dict_data_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
+var file_dict_data_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
 var file_dict_data_proto_goTypes = []any{
 	(*CreateDictDataRequest)(nil), // 0: api.admin.v1.CreateDictDataRequest
 	(*CreateDictDataReply)(nil),   // 1: api.admin.v1.CreateDictDataReply
@@ -909,29 +1086,36 @@ var file_dict_data_proto_goTypes = []any{
 	(*ListDictDataRequest)(nil),   // 8: api.admin.v1.ListDictDataRequest
 	(*ListDictDataReply)(nil),     // 9: api.admin.v1.ListDictDataReply
 	(*DictDataContent)(nil),       // 10: api.admin.v1.DictDataContent
-	(*timestamppb.Timestamp)(nil), // 11: google.protobuf.Timestamp
+	(*BatchDictDataRequest)(nil),  // 11: api.admin.v1.BatchDictDataRequest
+	(*DictTypeDataList)(nil),      // 12: api.admin.v1.DictTypeDataList
+	(*BatchDictDataReply)(nil),    // 13: api.admin.v1.BatchDictDataReply
+	(*timestamppb.Timestamp)(nil), // 14: google.protobuf.Timestamp
 }
 var file_dict_data_proto_depIdxs = []int32{
-	11, // 0: api.admin.v1.UpdateDictDataRequest.createTime:type_name -> google.protobuf.Timestamp
-	11, // 1: api.admin.v1.UpdateDictDataRequest.updateTime:type_name -> google.protobuf.Timestamp
+	14, // 0: api.admin.v1.UpdateDictDataRequest.createTime:type_name -> google.protobuf.Timestamp
+	14, // 1: api.admin.v1.UpdateDictDataRequest.updateTime:type_name -> google.protobuf.Timestamp
 	10, // 2: api.admin.v1.ListDictDataReply.list:type_name -> api.admin.v1.DictDataContent
-	11, // 3: api.admin.v1.DictDataContent.createTime:type_name -> google.protobuf.Timestamp
-	11, // 4: api.admin.v1.DictDataContent.updateTime:type_name -> google.protobuf.Timestamp
-	8,  // 5: api.admin.v1.DictData.ListDictData:input_type -> api.admin.v1.ListDictDataRequest
-	0,  // 6: api.admin.v1.DictData.CreateDictData:input_type -> api.admin.v1.CreateDictDataRequest
-	2,  // 7: api.admin.v1.DictData.UpdateDictData:input_type -> api.admin.v1.UpdateDictDataRequest
-	4,  // 8: api.admin.v1.DictData.DeleteDictData:input_type -> api.admin.v1.DeleteDictDataRequest
-	6,  // 9: api.admin.v1.DictData.FindDictData:input_type -> api.admin.v1.FindDictDataRequest
-	9,  // 10: api.admin.v1.DictData.ListDictData:output_type -> api.admin.v1.ListDictDataReply
-	1,  // 11: api.admin.v1.DictData.CreateDictData:output_type -> api.admin.v1.CreateDictDataReply
-	3,  // 12: api.admin.v1.DictData.UpdateDictData:output_type -> api.admin.v1.UpdateDictDataReply
-	5,  // 13: api.admin.v1.DictData.DeleteDictData:output_type -> api.admin.v1.DeleteDictDataReply
-	7,  // 14: api.admin.v1.DictData.FindDictData:output_type -> api.admin.v1.FindDictDataReply
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	14, // 3: api.admin.v1.DictDataContent.createTime:type_name -> google.protobuf.Timestamp
+	14, // 4: api.admin.v1.DictDataContent.updateTime:type_name -> google.protobuf.Timestamp
+	10, // 5: api.admin.v1.DictTypeDataList.list:type_name -> api.admin.v1.DictDataContent
+	12, // 6: api.admin.v1.BatchDictDataReply.list:type_name -> api.admin.v1.DictTypeDataList
+	8,  // 7: api.admin.v1.DictData.ListDictData:input_type -> api.admin.v1.ListDictDataRequest
+	0,  // 8: api.admin.v1.DictData.CreateDictData:input_type -> api.admin.v1.CreateDictDataRequest
+	2,  // 9: api.admin.v1.DictData.UpdateDictData:input_type -> api.admin.v1.UpdateDictDataRequest
+	4,  // 10: api.admin.v1.DictData.DeleteDictData:input_type -> api.admin.v1.DeleteDictDataRequest
+	6,  // 11: api.admin.v1.DictData.FindDictData:input_type -> api.admin.v1.FindDictDataRequest
+	11, // 12: api.admin.v1.DictData.BatchDictData:input_type -> api.admin.v1.BatchDictDataRequest
+	9,  // 13: api.admin.v1.DictData.ListDictData:output_type -> api.admin.v1.ListDictDataReply
+	1,  // 14: api.admin.v1.DictData.CreateDictData:output_type -> api.admin.v1.CreateDictDataReply
+	3,  // 15: api.admin.v1.DictData.UpdateDictData:output_type -> api.admin.v1.UpdateDictDataReply
+	5,  // 16: api.admin.v1.DictData.DeleteDictData:output_type -> api.admin.v1.DeleteDictDataReply
+	7,  // 17: api.admin.v1.DictData.FindDictData:output_type -> api.admin.v1.FindDictDataReply
+	13, // 18: api.admin.v1.DictData.BatchDictData:output_type -> api.admin.v1.BatchDictDataReply
+	13, // [13:19] is the sub-list for method output_type
+	7,  // [7:13] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_dict_data_proto_init() }
@@ -945,7 +1129,7 @@ func file_dict_data_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dict_data_proto_rawDesc), len(file_dict_data_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   11,
+			NumMessages:   14,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
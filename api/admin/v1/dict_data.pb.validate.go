@@ -1390,3 +1390,387 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DictDataContentValidationError{}
+
+// Validate checks the field values on BatchDictDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *BatchDictDataRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDictDataRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDictDataRequestMultiError, or nil if none found.
+func (m *BatchDictDataRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDictDataRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DictTypes
+
+	// no validation rules for Etag
+
+	if len(errors) > 0 {
+		return BatchDictDataRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDictDataRequestMultiError is an error wrapping multiple validation
+// errors returned by BatchDictDataRequest.ValidateAll() if the designated
+// constraints aren't met.
+type BatchDictDataRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDictDataRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDictDataRequestMultiError) AllErrors() []error { return m }
+
+// BatchDictDataRequestValidationError is the validation error returned by
+// BatchDictDataRequest.Validate if the designated constraints aren't met.
+type BatchDictDataRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDictDataRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDictDataRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDictDataRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDictDataRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDictDataRequestValidationError) ErrorName() string {
+	return "BatchDictDataRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDictDataRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDictDataRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDictDataRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDictDataRequestValidationError{}
+
+// Validate checks the field values on DictTypeDataList with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DictTypeDataList) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DictTypeDataList with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DictTypeDataListMultiError, or nil if none found.
+func (m *DictTypeDataList) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DictTypeDataList) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DictType
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, DictTypeDataListValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, DictTypeDataListValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return DictTypeDataListValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return DictTypeDataListMultiError(errors)
+	}
+
+	return nil
+}
+
+// DictTypeDataListMultiError is an error wrapping multiple validation errors
+// returned by DictTypeDataList.ValidateAll() if the designated constraints
+// aren't met.
+type DictTypeDataListMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DictTypeDataListMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DictTypeDataListMultiError) AllErrors() []error { return m }
+
+// DictTypeDataListValidationError is the validation error returned by
+// DictTypeDataList.Validate if the designated constraints aren't met.
+type DictTypeDataListValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DictTypeDataListValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DictTypeDataListValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DictTypeDataListValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DictTypeDataListValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DictTypeDataListValidationError) ErrorName() string { return "DictTypeDataListValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DictTypeDataListValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDictTypeDataList.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DictTypeDataListValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DictTypeDataListValidationError{}
+
+// Validate checks the field values on BatchDictDataReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *BatchDictDataReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on BatchDictDataReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// BatchDictDataReplyMultiError, or nil if none found.
+func (m *BatchDictDataReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *BatchDictDataReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Etag
+
+	// no validation rules for NotModified
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, BatchDictDataReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, BatchDictDataReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return BatchDictDataReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return BatchDictDataReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// BatchDictDataReplyMultiError is an error wrapping multiple validation errors
+// returned by BatchDictDataReply.ValidateAll() if the designated constraints
+// aren't met.
+type BatchDictDataReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m BatchDictDataReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m BatchDictDataReplyMultiError) AllErrors() []error { return m }
+
+// BatchDictDataReplyValidationError is the validation error returned by
+// BatchDictDataReply.Validate if the designated constraints aren't met.
+type BatchDictDataReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e BatchDictDataReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e BatchDictDataReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e BatchDictDataReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e BatchDictDataReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e BatchDictDataReplyValidationError) ErrorName() string {
+	return "BatchDictDataReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e BatchDictDataReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sBatchDictDataReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = BatchDictDataReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = BatchDictDataReplyValidationError{}
@@ -24,22 +24,25 @@ const (
 	DictData_UpdateDictData_FullMethodName = "/api.admin.v1.DictData/UpdateDictData"
 	DictData_DeleteDictData_FullMethodName = "/api.admin.v1.DictData/DeleteDictData"
 	DictData_FindDictData_FullMethodName   = "/api.admin.v1.DictData/FindDictData"
+	DictData_BatchDictData_FullMethodName  = "/api.admin.v1.DictData/BatchDictData"
 )
 
 // DictDataClient is the client API for DictData service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type DictDataClient interface {
-	// 列表信息
+	//列表信息
 	ListDictData(ctx context.Context, in *ListDictDataRequest, opts ...grpc.CallOption) (*ListDictDataReply, error)
-	// 创建
+	//创建
 	CreateDictData(ctx context.Context, in *CreateDictDataRequest, opts ...grpc.CallOption) (*CreateDictDataReply, error)
-	// 更新
+	//更新
 	UpdateDictData(ctx context.Context, in *UpdateDictDataRequest, opts ...grpc.CallOption) (*UpdateDictDataReply, error)
-	// 删除
+	//删除
 	DeleteDictData(ctx context.Context, in *DeleteDictDataRequest, opts ...grpc.CallOption) (*DeleteDictDataReply, error)
-	// 获取信息
+	//获取信息
 	FindDictData(ctx context.Context, in *FindDictDataRequest, opts ...grpc.CallOption) (*FindDictDataReply, error)
+	//批量获取多个字典类型的数据，带 ETag 供前端本地缓存
+	BatchDictData(ctx context.Context, in *BatchDictDataRequest, opts ...grpc.CallOption) (*BatchDictDataReply, error)
 }
 
 type dictDataClient struct {
@@ -100,20 +103,32 @@ func (c *dictDataClient) FindDictData(ctx context.Context, in *FindDictDataReque
 	return out, nil
 }
 
+func (c *dictDataClient) BatchDictData(ctx context.Context, in *BatchDictDataRequest, opts ...grpc.CallOption) (*BatchDictDataReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(BatchDictDataReply)
+	err := c.cc.Invoke(ctx, DictData_BatchDictData_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DictDataServer is the server API for DictData service.
 // All implementations must embed UnimplementedDictDataServer
 // for forward compatibility.
 type DictDataServer interface {
-	// 列表信息
+	//列表信息
 	ListDictData(context.Context, *ListDictDataRequest) (*ListDictDataReply, error)
-	// 创建
+	//创建
 	CreateDictData(context.Context, *CreateDictDataRequest) (*CreateDictDataReply, error)
-	// 更新
+	//更新
 	UpdateDictData(context.Context, *UpdateDictDataRequest) (*UpdateDictDataReply, error)
-	// 删除
+	//删除
 	DeleteDictData(context.Context, *DeleteDictDataRequest) (*DeleteDictDataReply, error)
-	// 获取信息
+	//获取信息
 	FindDictData(context.Context, *FindDictDataRequest) (*FindDictDataReply, error)
+	//批量获取多个字典类型的数据，带 ETag 供前端本地缓存
+	BatchDictData(context.Context, *BatchDictDataRequest) (*BatchDictDataReply, error)
 	mustEmbedUnimplementedDictDataServer()
 }
 
@@ -139,6 +154,9 @@ func (UnimplementedDictDataServer) DeleteDictData(context.Context, *DeleteDictDa
 func (UnimplementedDictDataServer) FindDictData(context.Context, *FindDictDataRequest) (*FindDictDataReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method FindDictData not implemented")
 }
+func (UnimplementedDictDataServer) BatchDictData(context.Context, *BatchDictDataRequest) (*BatchDictDataReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method BatchDictData not implemented")
+}
 func (UnimplementedDictDataServer) mustEmbedUnimplementedDictDataServer() {}
 func (UnimplementedDictDataServer) testEmbeddedByValue()                  {}
 
@@ -250,6 +268,24 @@ func _DictData_FindDictData_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DictData_BatchDictData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BatchDictDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DictDataServer).BatchDictData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DictData_BatchDictData_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DictDataServer).BatchDictData(ctx, req.(*BatchDictDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DictData_ServiceDesc is the grpc.ServiceDesc for DictData service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +313,10 @@ var DictData_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindDictData",
 			Handler:    _DictData_FindDictData_Handler,
 		},
+		{
+			MethodName: "BatchDictData",
+			Handler:    _DictData_BatchDictData_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dict_data.proto",
@@ -19,6 +19,7 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationDictDataBatchDictData = "/api.admin.v1.DictData/BatchDictData"
 const OperationDictDataCreateDictData = "/api.admin.v1.DictData/CreateDictData"
 const OperationDictDataDeleteDictData = "/api.admin.v1.DictData/DeleteDictData"
 const OperationDictDataFindDictData = "/api.admin.v1.DictData/FindDictData"
@@ -26,6 +27,8 @@ const OperationDictDataListDictData = "/api.admin.v1.DictData/ListDictData"
 const OperationDictDataUpdateDictData = "/api.admin.v1.DictData/UpdateDictData"
 
 type DictDataHTTPServer interface {
+	// BatchDictData批量获取多个字典类型的数据，带 ETag 供前端本地缓存
+	BatchDictData(context.Context, *BatchDictDataRequest) (*BatchDictDataReply, error)
 	// CreateDictData创建
 	CreateDictData(context.Context, *CreateDictDataRequest) (*CreateDictDataReply, error)
 	// DeleteDictData删除
@@ -45,6 +48,7 @@ func RegisterDictDataHTTPServer(s *http.Server, srv DictDataHTTPServer) {
 	r.PUT("/system/dict/data", _DictData_UpdateDictData0_HTTP_Handler(srv))
 	r.DELETE("/system/dict/data/{dictCode}", _DictData_DeleteDictData0_HTTP_Handler(srv))
 	r.GET("/system/dict/data/info/{dictCode}", _DictData_FindDictData0_HTTP_Handler(srv))
+	r.GET("/system/dict/data/batch", _DictData_BatchDictData0_HTTP_Handler(srv))
 }
 
 func _DictData_ListDictData0_HTTP_Handler(srv DictDataHTTPServer) func(ctx http.Context) error {
@@ -154,7 +158,28 @@ func _DictData_FindDictData0_HTTP_Handler(srv DictDataHTTPServer) func(ctx http.
 	}
 }
 
+func _DictData_BatchDictData0_HTTP_Handler(srv DictDataHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in BatchDictDataRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDictDataBatchDictData)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.BatchDictData(ctx, req.(*BatchDictDataRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*BatchDictDataReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type DictDataHTTPClient interface {
+	// BatchDictData批量获取多个字典类型的数据，带 ETag 供前端本地缓存
+	BatchDictData(ctx context.Context, req *BatchDictDataRequest, opts ...http.CallOption) (rsp *BatchDictDataReply, err error)
 	// CreateDictData创建
 	CreateDictData(ctx context.Context, req *CreateDictDataRequest, opts ...http.CallOption) (rsp *CreateDictDataReply, err error)
 	// DeleteDictData删除
@@ -175,6 +200,20 @@ func NewDictDataHTTPClient(client *http.Client) DictDataHTTPClient {
 	return &DictDataHTTPClientImpl{client}
 }
 
+// BatchDictData批量获取多个字典类型的数据，带 ETag 供前端本地缓存
+func (c *DictDataHTTPClientImpl) BatchDictData(ctx context.Context, in *BatchDictDataRequest, opts ...http.CallOption) (*BatchDictDataReply, error) {
+	var out BatchDictDataReply
+	pattern := "/system/dict/data/batch"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDictDataBatchDictData))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // CreateDictData创建
 func (c *DictDataHTTPClientImpl) CreateDictData(ctx context.Context, in *CreateDictDataRequest, opts ...http.CallOption) (*CreateDictDataReply, error) {
 	var out CreateDictDataReply
@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -787,11 +788,320 @@ func (*GetDesignateDictTypesReply) Descriptor() ([]byte, []int) {
 	return file_dict_type_proto_rawDescGZIP(), []int{12}
 }
 
+type ExportDictsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 逗号分隔的字典类型，为空时导出全部
+	DictTypes string `protobuf:"bytes,1,opt,name=dictTypes,proto3" json:"dictTypes,omitempty"`
+	// yaml、json 或 xlsx，默认 yaml
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDictsRequest) Reset() {
+	*x = ExportDictsRequest{}
+	mi := &file_dict_type_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDictsRequest) ProtoMessage() {}
+
+func (x *ExportDictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_type_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDictsRequest.ProtoReflect.Descriptor instead.
+func (*ExportDictsRequest) Descriptor() ([]byte, []int) {
+	return file_dict_type_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ExportDictsRequest) GetDictTypes() string {
+	if x != nil {
+		return x.DictTypes
+	}
+	return ""
+}
+
+func (x *ExportDictsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportDictsReply struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Format string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// xlsx 格式时为 base64 编码的文件内容
+	Content       string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportDictsReply) Reset() {
+	*x = ExportDictsReply{}
+	mi := &file_dict_type_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportDictsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDictsReply) ProtoMessage() {}
+
+func (x *ExportDictsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_type_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDictsReply.ProtoReflect.Descriptor instead.
+func (*ExportDictsReply) Descriptor() ([]byte, []int) {
+	return file_dict_type_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportDictsReply) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportDictsReply) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ImportDictsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// yaml、json 或 xlsx，默认 yaml
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// xlsx 格式时为 base64 编码的文件内容
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// skip 不修改已存在的字典类型和数据，overwrite 用导入内容覆盖，默认 skip
+	Mode string `protobuf:"bytes,3,opt,name=mode,proto3" json:"mode,omitempty"`
+	// 只返回导入结果，不写入数据库
+	DryRun        bool `protobuf:"varint,4,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDictsRequest) Reset() {
+	*x = ImportDictsRequest{}
+	mi := &file_dict_type_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDictsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDictsRequest) ProtoMessage() {}
+
+func (x *ImportDictsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_type_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDictsRequest.ProtoReflect.Descriptor instead.
+func (*ImportDictsRequest) Descriptor() ([]byte, []int) {
+	return file_dict_type_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ImportDictsRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportDictsRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ImportDictsRequest) GetMode() string {
+	if x != nil {
+		return x.Mode
+	}
+	return ""
+}
+
+func (x *ImportDictsRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportDictsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dicts         []*DictImportResult    `protobuf:"bytes,1,rep,name=dicts,proto3" json:"dicts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportDictsReply) Reset() {
+	*x = ImportDictsReply{}
+	mi := &file_dict_type_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportDictsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportDictsReply) ProtoMessage() {}
+
+func (x *ImportDictsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_type_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportDictsReply.ProtoReflect.Descriptor instead.
+func (*ImportDictsReply) Descriptor() ([]byte, []int) {
+	return file_dict_type_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportDictsReply) GetDicts() []*DictImportResult {
+	if x != nil {
+		return x.Dicts
+	}
+	return nil
+}
+
+// 单个字典类型的导入结果
+type DictImportResult struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DictType string                 `protobuf:"bytes,1,opt,name=dictType,proto3" json:"dictType,omitempty"`
+	// 字典类型本身的动作：create、update、skip、unchanged
+	Action        string   `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	ChangedFields []string `protobuf:"bytes,3,rep,name=changedFields,proto3" json:"changedFields,omitempty"`
+	CreatedValues []string `protobuf:"bytes,4,rep,name=createdValues,proto3" json:"createdValues,omitempty"`
+	UpdatedValues []string `protobuf:"bytes,5,rep,name=updatedValues,proto3" json:"updatedValues,omitempty"`
+	SkippedValues []string `protobuf:"bytes,6,rep,name=skippedValues,proto3" json:"skippedValues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DictImportResult) Reset() {
+	*x = DictImportResult{}
+	mi := &file_dict_type_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DictImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DictImportResult) ProtoMessage() {}
+
+func (x *DictImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_dict_type_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DictImportResult.ProtoReflect.Descriptor instead.
+func (*DictImportResult) Descriptor() ([]byte, []int) {
+	return file_dict_type_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *DictImportResult) GetDictType() string {
+	if x != nil {
+		return x.DictType
+	}
+	return ""
+}
+
+func (x *DictImportResult) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *DictImportResult) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *DictImportResult) GetCreatedValues() []string {
+	if x != nil {
+		return x.CreatedValues
+	}
+	return nil
+}
+
+func (x *DictImportResult) GetUpdatedValues() []string {
+	if x != nil {
+		return x.UpdatedValues
+	}
+	return nil
+}
+
+func (x *DictImportResult) GetSkippedValues() []string {
+	if x != nil {
+		return x.SkippedValues
+	}
+	return nil
+}
+
 var File_dict_type_proto protoreflect.FileDescriptor
 
 const file_dict_type_proto_rawDesc = "" +
 	"\n" +
-	"\x0fdict_type.proto\x12\fapi.admin.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\"\x7f\n" +
+	"\x0fdict_type.proto\x12\fapi.admin.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\"\x7f\n" +
 	"\x15CreateDictTypeRequest\x12\x1a\n" +
 	"\bdictName\x18\x01 \x01(\tR\bdictName\x12\x1a\n" +
 	"\bdictType\x18\x02 \x01(\tR\bdictType\x12\x16\n" +
@@ -849,13 +1159,35 @@ const file_dict_type_proto_rawDesc = "" +
 	"updateTime\"8\n" +
 	"\x1cGetDesignateDictTypesRequest\x12\x18\n" +
 	"\adictIDs\x18\x01 \x03(\tR\adictIDs\"\x1c\n" +
-	"\x1aGetDesignateDictTypesReply2\xe4\x04\n" +
+	"\x1aGetDesignateDictTypesReply\"e\n" +
+	"\x12ExportDictsRequest\x12\x1c\n" +
+	"\tdictTypes\x18\x01 \x01(\tR\tdictTypes\x121\n" +
+	"\x06format\x18\x02 \x01(\tB\x19\xfaB\x16r\x14R\x00R\x04yamlR\x04jsonR\x04xlsxR\x06format\"D\n" +
+	"\x10ExportDictsReply\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"\xb0\x01\n" +
+	"\x12ImportDictsRequest\x121\n" +
+	"\x06format\x18\x01 \x01(\tB\x19\xfaB\x16r\x14R\x00R\x04yamlR\x04jsonR\x04xlsxR\x06format\x12!\n" +
+	"\acontent\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\acontent\x12,\n" +
+	"\x04mode\x18\x03 \x01(\tB\x18\xfaB\x15r\x13R\x00R\x04skipR\toverwriteR\x04mode\x12\x16\n" +
+	"\x06dryRun\x18\x04 \x01(\bR\x06dryRun\"H\n" +
+	"\x10ImportDictsReply\x124\n" +
+	"\x05dicts\x18\x01 \x03(\v2\x1e.api.admin.v1.DictImportResultR\x05dicts\"\xde\x01\n" +
+	"\x10DictImportResult\x12\x1a\n" +
+	"\bdictType\x18\x01 \x01(\tR\bdictType\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12$\n" +
+	"\rchangedFields\x18\x03 \x03(\tR\rchangedFields\x12$\n" +
+	"\rcreatedValues\x18\x04 \x03(\tR\rcreatedValues\x12$\n" +
+	"\rupdatedValues\x18\x05 \x03(\tR\rupdatedValues\x12$\n" +
+	"\rskippedValues\x18\x06 \x03(\tR\rskippedValues2\xc3\x06\n" +
 	"\bDictType\x12r\n" +
 	"\fListDictType\x12!.api.admin.v1.ListDictTypeRequest\x1a\x1f.api.admin.v1.ListDictTypeReply\"\x1e\x82\xd3\xe4\x93\x02\x18\x12\x16/system/dict/type/list\x12v\n" +
 	"\x0eCreateDictType\x12#.api.admin.v1.CreateDictTypeRequest\x1a!.api.admin.v1.CreateDictTypeReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\"\x11/system/dict/type\x12v\n" +
 	"\x0eUpdateDictType\x12#.api.admin.v1.UpdateDictTypeRequest\x1a!.api.admin.v1.UpdateDictTypeReply\"\x1c\x82\xd3\xe4\x93\x02\x16:\x01*\x1a\x11/system/dict/type\x12|\n" +
 	"\x0eDeleteDictType\x12#.api.admin.v1.DeleteDictTypeRequest\x1a!.api.admin.v1.DeleteDictTypeReply\"\"\x82\xd3\xe4\x93\x02\x1c*\x1a/system/dict/type/{dictId}\x12v\n" +
-	"\fFindDictType\x12!.api.admin.v1.FindDictTypeRequest\x1a\x1f.api.admin.v1.FindDictTypeReply\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/system/dict/type/{dictId}B6Z4github.com/swordkee/kratos-vue-admin/api/admin/v1;v1b\x06proto3"
+	"\fFindDictType\x12!.api.admin.v1.FindDictTypeRequest\x1a\x1f.api.admin.v1.FindDictTypeReply\"\"\x82\xd3\xe4\x93\x02\x1c\x12\x1a/system/dict/type/{dictId}\x12l\n" +
+	"\vExportDicts\x12 .api.admin.v1.ExportDictsRequest\x1a\x1e.api.admin.v1.ExportDictsReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/system/dict/export\x12o\n" +
+	"\vImportDicts\x12 .api.admin.v1.ImportDictsRequest\x1a\x1e.api.admin.v1.ImportDictsReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/system/dict/importB6Z4github.com/swordkee/kratos-vue-admin/api/admin/v1;v1b\x06proto3"
 
 var (
 	file_dict_type_proto_rawDescOnce sync.Once
@@ -869,7 +1201,7 @@ func file_dict_type_proto_rawDescGZIP() []byte {
 	return file_dict_type_proto_rawDescData
 }
 
-var file_dict_type_proto_msgTypes = make([]protoimpl.MessageInfo, 13)
+var file_dict_type_proto_msgTypes = make([]protoimpl.MessageInfo, 18)
 var file_dict_type_proto_goTypes = []any{
 	(*CreateDictTypeRequest)(nil),        // 0: api.admin.v1.CreateDictTypeRequest
 	(*CreateDictTypeReply)(nil),          // 1: api.admin.v1.CreateDictTypeReply
@@ -884,29 +1216,39 @@ var file_dict_type_proto_goTypes = []any{
 	(*DictTypeContent)(nil),              // 10: api.admin.v1.DictTypeContent
 	(*GetDesignateDictTypesRequest)(nil), // 11: api.admin.v1.GetDesignateDictTypesRequest
 	(*GetDesignateDictTypesReply)(nil),   // 12: api.admin.v1.GetDesignateDictTypesReply
-	(*timestamppb.Timestamp)(nil),        // 13: google.protobuf.Timestamp
+	(*ExportDictsRequest)(nil),           // 13: api.admin.v1.ExportDictsRequest
+	(*ExportDictsReply)(nil),             // 14: api.admin.v1.ExportDictsReply
+	(*ImportDictsRequest)(nil),           // 15: api.admin.v1.ImportDictsRequest
+	(*ImportDictsReply)(nil),             // 16: api.admin.v1.ImportDictsReply
+	(*DictImportResult)(nil),             // 17: api.admin.v1.DictImportResult
+	(*timestamppb.Timestamp)(nil),        // 18: google.protobuf.Timestamp
 }
 var file_dict_type_proto_depIdxs = []int32{
-	13, // 0: api.admin.v1.UpdateDictTypeRequest.createTime:type_name -> google.protobuf.Timestamp
-	13, // 1: api.admin.v1.UpdateDictTypeRequest.updateTime:type_name -> google.protobuf.Timestamp
+	18, // 0: api.admin.v1.UpdateDictTypeRequest.createTime:type_name -> google.protobuf.Timestamp
+	18, // 1: api.admin.v1.UpdateDictTypeRequest.updateTime:type_name -> google.protobuf.Timestamp
 	10, // 2: api.admin.v1.ListDictTypeReply.data:type_name -> api.admin.v1.DictTypeContent
-	13, // 3: api.admin.v1.DictTypeContent.createTime:type_name -> google.protobuf.Timestamp
-	13, // 4: api.admin.v1.DictTypeContent.updateTime:type_name -> google.protobuf.Timestamp
-	8,  // 5: api.admin.v1.DictType.ListDictType:input_type -> api.admin.v1.ListDictTypeRequest
-	0,  // 6: api.admin.v1.DictType.CreateDictType:input_type -> api.admin.v1.CreateDictTypeRequest
-	2,  // 7: api.admin.v1.DictType.UpdateDictType:input_type -> api.admin.v1.UpdateDictTypeRequest
-	4,  // 8: api.admin.v1.DictType.DeleteDictType:input_type -> api.admin.v1.DeleteDictTypeRequest
-	6,  // 9: api.admin.v1.DictType.FindDictType:input_type -> api.admin.v1.FindDictTypeRequest
-	9,  // 10: api.admin.v1.DictType.ListDictType:output_type -> api.admin.v1.ListDictTypeReply
-	1,  // 11: api.admin.v1.DictType.CreateDictType:output_type -> api.admin.v1.CreateDictTypeReply
-	3,  // 12: api.admin.v1.DictType.UpdateDictType:output_type -> api.admin.v1.UpdateDictTypeReply
-	5,  // 13: api.admin.v1.DictType.DeleteDictType:output_type -> api.admin.v1.DeleteDictTypeReply
-	7,  // 14: api.admin.v1.DictType.FindDictType:output_type -> api.admin.v1.FindDictTypeReply
-	10, // [10:15] is the sub-list for method output_type
-	5,  // [5:10] is the sub-list for method input_type
-	5,  // [5:5] is the sub-list for extension type_name
-	5,  // [5:5] is the sub-list for extension extendee
-	0,  // [0:5] is the sub-list for field type_name
+	18, // 3: api.admin.v1.DictTypeContent.createTime:type_name -> google.protobuf.Timestamp
+	18, // 4: api.admin.v1.DictTypeContent.updateTime:type_name -> google.protobuf.Timestamp
+	17, // 5: api.admin.v1.ImportDictsReply.dicts:type_name -> api.admin.v1.DictImportResult
+	8,  // 6: api.admin.v1.DictType.ListDictType:input_type -> api.admin.v1.ListDictTypeRequest
+	0,  // 7: api.admin.v1.DictType.CreateDictType:input_type -> api.admin.v1.CreateDictTypeRequest
+	2,  // 8: api.admin.v1.DictType.UpdateDictType:input_type -> api.admin.v1.UpdateDictTypeRequest
+	4,  // 9: api.admin.v1.DictType.DeleteDictType:input_type -> api.admin.v1.DeleteDictTypeRequest
+	6,  // 10: api.admin.v1.DictType.FindDictType:input_type -> api.admin.v1.FindDictTypeRequest
+	13, // 11: api.admin.v1.DictType.ExportDicts:input_type -> api.admin.v1.ExportDictsRequest
+	15, // 12: api.admin.v1.DictType.ImportDicts:input_type -> api.admin.v1.ImportDictsRequest
+	9,  // 13: api.admin.v1.DictType.ListDictType:output_type -> api.admin.v1.ListDictTypeReply
+	1,  // 14: api.admin.v1.DictType.CreateDictType:output_type -> api.admin.v1.CreateDictTypeReply
+	3,  // 15: api.admin.v1.DictType.UpdateDictType:output_type -> api.admin.v1.UpdateDictTypeReply
+	5,  // 16: api.admin.v1.DictType.DeleteDictType:output_type -> api.admin.v1.DeleteDictTypeReply
+	7,  // 17: api.admin.v1.DictType.FindDictType:output_type -> api.admin.v1.FindDictTypeReply
+	14, // 18: api.admin.v1.DictType.ExportDicts:output_type -> api.admin.v1.ExportDictsReply
+	16, // 19: api.admin.v1.DictType.ImportDicts:output_type -> api.admin.v1.ImportDictsReply
+	13, // [13:20] is the sub-list for method output_type
+	6,  // [6:13] is the sub-list for method input_type
+	6,  // [6:6] is the sub-list for extension type_name
+	6,  // [6:6] is the sub-list for extension extendee
+	0,  // [0:6] is the sub-list for field type_name
 }
 
 func init() { file_dict_type_proto_init() }
@@ -920,7 +1262,7 @@ func file_dict_type_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_dict_type_proto_rawDesc), len(file_dict_type_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   13,
+			NumMessages:   18,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -1571,3 +1571,619 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetDesignateDictTypesReplyValidationError{}
+
+// Validate checks the field values on ExportDictsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ExportDictsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportDictsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportDictsRequestMultiError, or nil if none found.
+func (m *ExportDictsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportDictsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DictTypes
+
+	if _, ok := _ExportDictsRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ExportDictsRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ yaml json xlsx]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportDictsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportDictsRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportDictsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportDictsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportDictsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportDictsRequestMultiError) AllErrors() []error { return m }
+
+// ExportDictsRequestValidationError is the validation error returned by
+// ExportDictsRequest.Validate if the designated constraints aren't met.
+type ExportDictsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportDictsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportDictsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportDictsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportDictsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportDictsRequestValidationError) ErrorName() string {
+	return "ExportDictsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportDictsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportDictsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportDictsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportDictsRequestValidationError{}
+
+var _ExportDictsRequest_Format_InLookup = map[string]struct{}{
+	"":     {},
+	"yaml": {},
+	"json": {},
+	"xlsx": {},
+}
+
+// Validate checks the field values on ExportDictsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExportDictsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportDictsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportDictsReplyMultiError, or nil if none found.
+func (m *ExportDictsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportDictsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return ExportDictsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportDictsReplyMultiError is an error wrapping multiple validation errors
+// returned by ExportDictsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ExportDictsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportDictsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportDictsReplyMultiError) AllErrors() []error { return m }
+
+// ExportDictsReplyValidationError is the validation error returned by
+// ExportDictsReply.Validate if the designated constraints aren't met.
+type ExportDictsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportDictsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportDictsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportDictsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportDictsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportDictsReplyValidationError) ErrorName() string { return "ExportDictsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportDictsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportDictsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportDictsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportDictsReplyValidationError{}
+
+// Validate checks the field values on ImportDictsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ImportDictsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportDictsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportDictsRequestMultiError, or nil if none found.
+func (m *ImportDictsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportDictsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ImportDictsRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ImportDictsRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ yaml json xlsx]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContent()) < 1 {
+		err := ImportDictsRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _ImportDictsRequest_Mode_InLookup[m.GetMode()]; !ok {
+		err := ImportDictsRequestValidationError{
+			field:  "Mode",
+			reason: "value must be in list [ skip overwrite]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportDictsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportDictsRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportDictsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportDictsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportDictsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportDictsRequestMultiError) AllErrors() []error { return m }
+
+// ImportDictsRequestValidationError is the validation error returned by
+// ImportDictsRequest.Validate if the designated constraints aren't met.
+type ImportDictsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportDictsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportDictsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportDictsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportDictsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportDictsRequestValidationError) ErrorName() string {
+	return "ImportDictsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportDictsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportDictsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportDictsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportDictsRequestValidationError{}
+
+var _ImportDictsRequest_Format_InLookup = map[string]struct{}{
+	"":     {},
+	"yaml": {},
+	"json": {},
+	"xlsx": {},
+}
+
+var _ImportDictsRequest_Mode_InLookup = map[string]struct{}{
+	"":          {},
+	"skip":      {},
+	"overwrite": {},
+}
+
+// Validate checks the field values on ImportDictsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportDictsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportDictsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportDictsReplyMultiError, or nil if none found.
+func (m *ImportDictsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportDictsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDicts() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportDictsReplyValidationError{
+						field:  fmt.Sprintf("Dicts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportDictsReplyValidationError{
+						field:  fmt.Sprintf("Dicts[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportDictsReplyValidationError{
+					field:  fmt.Sprintf("Dicts[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportDictsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportDictsReplyMultiError is an error wrapping multiple validation errors
+// returned by ImportDictsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ImportDictsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportDictsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportDictsReplyMultiError) AllErrors() []error { return m }
+
+// ImportDictsReplyValidationError is the validation error returned by
+// ImportDictsReply.Validate if the designated constraints aren't met.
+type ImportDictsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportDictsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportDictsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportDictsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportDictsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportDictsReplyValidationError) ErrorName() string { return "ImportDictsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportDictsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportDictsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportDictsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportDictsReplyValidationError{}
+
+// Validate checks the field values on DictImportResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DictImportResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DictImportResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DictImportResultMultiError, or nil if none found.
+func (m *DictImportResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DictImportResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for DictType
+
+	// no validation rules for Action
+
+	if len(errors) > 0 {
+		return DictImportResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// DictImportResultMultiError is an error wrapping multiple validation errors
+// returned by DictImportResult.ValidateAll() if the designated constraints
+// aren't met.
+type DictImportResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DictImportResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DictImportResultMultiError) AllErrors() []error { return m }
+
+// DictImportResultValidationError is the validation error returned by
+// DictImportResult.Validate if the designated constraints aren't met.
+type DictImportResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DictImportResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DictImportResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DictImportResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DictImportResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DictImportResultValidationError) ErrorName() string { return "DictImportResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DictImportResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDictImportResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DictImportResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DictImportResultValidationError{}
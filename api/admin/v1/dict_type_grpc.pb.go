@@ -24,6 +24,8 @@ const (
 	DictType_UpdateDictType_FullMethodName = "/api.admin.v1.DictType/UpdateDictType"
 	DictType_DeleteDictType_FullMethodName = "/api.admin.v1.DictType/DeleteDictType"
 	DictType_FindDictType_FullMethodName   = "/api.admin.v1.DictType/FindDictType"
+	DictType_ExportDicts_FullMethodName    = "/api.admin.v1.DictType/ExportDicts"
+	DictType_ImportDicts_FullMethodName    = "/api.admin.v1.DictType/ImportDicts"
 )
 
 // DictTypeClient is the client API for DictType service.
@@ -40,6 +42,10 @@ type DictTypeClient interface {
 	DeleteDictType(ctx context.Context, in *DeleteDictTypeRequest, opts ...grpc.CallOption) (*DeleteDictTypeReply, error)
 	//获取信息
 	FindDictType(ctx context.Context, in *FindDictTypeRequest, opts ...grpc.CallOption) (*FindDictTypeReply, error)
+	//导出字典类型及字典数据
+	ExportDicts(ctx context.Context, in *ExportDictsRequest, opts ...grpc.CallOption) (*ExportDictsReply, error)
+	//导入字典，按字典类型和字典键值合并
+	ImportDicts(ctx context.Context, in *ImportDictsRequest, opts ...grpc.CallOption) (*ImportDictsReply, error)
 }
 
 type dictTypeClient struct {
@@ -100,6 +106,26 @@ func (c *dictTypeClient) FindDictType(ctx context.Context, in *FindDictTypeReque
 	return out, nil
 }
 
+func (c *dictTypeClient) ExportDicts(ctx context.Context, in *ExportDictsRequest, opts ...grpc.CallOption) (*ExportDictsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportDictsReply)
+	err := c.cc.Invoke(ctx, DictType_ExportDicts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dictTypeClient) ImportDicts(ctx context.Context, in *ImportDictsRequest, opts ...grpc.CallOption) (*ImportDictsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportDictsReply)
+	err := c.cc.Invoke(ctx, DictType_ImportDicts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DictTypeServer is the server API for DictType service.
 // All implementations must embed UnimplementedDictTypeServer
 // for forward compatibility.
@@ -114,6 +140,10 @@ type DictTypeServer interface {
 	DeleteDictType(context.Context, *DeleteDictTypeRequest) (*DeleteDictTypeReply, error)
 	//获取信息
 	FindDictType(context.Context, *FindDictTypeRequest) (*FindDictTypeReply, error)
+	//导出字典类型及字典数据
+	ExportDicts(context.Context, *ExportDictsRequest) (*ExportDictsReply, error)
+	//导入字典，按字典类型和字典键值合并
+	ImportDicts(context.Context, *ImportDictsRequest) (*ImportDictsReply, error)
 	mustEmbedUnimplementedDictTypeServer()
 }
 
@@ -139,6 +169,12 @@ func (UnimplementedDictTypeServer) DeleteDictType(context.Context, *DeleteDictTy
 func (UnimplementedDictTypeServer) FindDictType(context.Context, *FindDictTypeRequest) (*FindDictTypeReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method FindDictType not implemented")
 }
+func (UnimplementedDictTypeServer) ExportDicts(context.Context, *ExportDictsRequest) (*ExportDictsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportDicts not implemented")
+}
+func (UnimplementedDictTypeServer) ImportDicts(context.Context, *ImportDictsRequest) (*ImportDictsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportDicts not implemented")
+}
 func (UnimplementedDictTypeServer) mustEmbedUnimplementedDictTypeServer() {}
 func (UnimplementedDictTypeServer) testEmbeddedByValue()                  {}
 
@@ -250,6 +286,42 @@ func _DictType_FindDictType_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DictType_ExportDicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DictTypeServer).ExportDicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DictType_ExportDicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DictTypeServer).ExportDicts(ctx, req.(*ExportDictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DictType_ImportDicts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportDictsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DictTypeServer).ImportDicts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DictType_ImportDicts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DictTypeServer).ImportDicts(ctx, req.(*ImportDictsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DictType_ServiceDesc is the grpc.ServiceDesc for DictType service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -277,6 +349,14 @@ var DictType_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindDictType",
 			Handler:    _DictType_FindDictType_Handler,
 		},
+		{
+			MethodName: "ExportDicts",
+			Handler:    _DictType_ExportDicts_Handler,
+		},
+		{
+			MethodName: "ImportDicts",
+			Handler:    _DictType_ImportDicts_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "dict_type.proto",
@@ -21,7 +21,9 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationDictTypeCreateDictType = "/api.admin.v1.DictType/CreateDictType"
 const OperationDictTypeDeleteDictType = "/api.admin.v1.DictType/DeleteDictType"
+const OperationDictTypeExportDicts = "/api.admin.v1.DictType/ExportDicts"
 const OperationDictTypeFindDictType = "/api.admin.v1.DictType/FindDictType"
+const OperationDictTypeImportDicts = "/api.admin.v1.DictType/ImportDicts"
 const OperationDictTypeListDictType = "/api.admin.v1.DictType/ListDictType"
 const OperationDictTypeUpdateDictType = "/api.admin.v1.DictType/UpdateDictType"
 
@@ -30,8 +32,12 @@ type DictTypeHTTPServer interface {
 	CreateDictType(context.Context, *CreateDictTypeRequest) (*CreateDictTypeReply, error)
 	// DeleteDictType删除
 	DeleteDictType(context.Context, *DeleteDictTypeRequest) (*DeleteDictTypeReply, error)
+	// ExportDicts导出字典类型及字典数据
+	ExportDicts(context.Context, *ExportDictsRequest) (*ExportDictsReply, error)
 	// FindDictType获取信息
 	FindDictType(context.Context, *FindDictTypeRequest) (*FindDictTypeReply, error)
+	// ImportDicts导入字典，按字典类型和字典键值合并
+	ImportDicts(context.Context, *ImportDictsRequest) (*ImportDictsReply, error)
 	// ListDictType列表信息
 	ListDictType(context.Context, *ListDictTypeRequest) (*ListDictTypeReply, error)
 	// UpdateDictType更新
@@ -45,6 +51,8 @@ func RegisterDictTypeHTTPServer(s *http.Server, srv DictTypeHTTPServer) {
 	r.PUT("/system/dict/type", _DictType_UpdateDictType0_HTTP_Handler(srv))
 	r.DELETE("/system/dict/type/{dictId}", _DictType_DeleteDictType0_HTTP_Handler(srv))
 	r.GET("/system/dict/type/{dictId}", _DictType_FindDictType0_HTTP_Handler(srv))
+	r.GET("/system/dict/export", _DictType_ExportDicts0_HTTP_Handler(srv))
+	r.POST("/system/dict/import", _DictType_ImportDicts0_HTTP_Handler(srv))
 }
 
 func _DictType_ListDictType0_HTTP_Handler(srv DictTypeHTTPServer) func(ctx http.Context) error {
@@ -154,13 +162,58 @@ func _DictType_FindDictType0_HTTP_Handler(srv DictTypeHTTPServer) func(ctx http.
 	}
 }
 
+func _DictType_ExportDicts0_HTTP_Handler(srv DictTypeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportDictsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDictTypeExportDicts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportDicts(ctx, req.(*ExportDictsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportDictsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _DictType_ImportDicts0_HTTP_Handler(srv DictTypeHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportDictsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationDictTypeImportDicts)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportDicts(ctx, req.(*ImportDictsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportDictsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type DictTypeHTTPClient interface {
 	// CreateDictType创建
 	CreateDictType(ctx context.Context, req *CreateDictTypeRequest, opts ...http.CallOption) (rsp *CreateDictTypeReply, err error)
 	// DeleteDictType删除
 	DeleteDictType(ctx context.Context, req *DeleteDictTypeRequest, opts ...http.CallOption) (rsp *DeleteDictTypeReply, err error)
+	// ExportDicts导出字典类型及字典数据
+	ExportDicts(ctx context.Context, req *ExportDictsRequest, opts ...http.CallOption) (rsp *ExportDictsReply, err error)
 	// FindDictType获取信息
 	FindDictType(ctx context.Context, req *FindDictTypeRequest, opts ...http.CallOption) (rsp *FindDictTypeReply, err error)
+	// ImportDicts导入字典，按字典类型和字典键值合并
+	ImportDicts(ctx context.Context, req *ImportDictsRequest, opts ...http.CallOption) (rsp *ImportDictsReply, err error)
 	// ListDictType列表信息
 	ListDictType(ctx context.Context, req *ListDictTypeRequest, opts ...http.CallOption) (rsp *ListDictTypeReply, err error)
 	// UpdateDictType更新
@@ -203,6 +256,20 @@ func (c *DictTypeHTTPClientImpl) DeleteDictType(ctx context.Context, in *DeleteD
 	return &out, nil
 }
 
+// ExportDicts导出字典类型及字典数据
+func (c *DictTypeHTTPClientImpl) ExportDicts(ctx context.Context, in *ExportDictsRequest, opts ...http.CallOption) (*ExportDictsReply, error) {
+	var out ExportDictsReply
+	pattern := "/system/dict/export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationDictTypeExportDicts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // FindDictType获取信息
 func (c *DictTypeHTTPClientImpl) FindDictType(ctx context.Context, in *FindDictTypeRequest, opts ...http.CallOption) (*FindDictTypeReply, error) {
 	var out FindDictTypeReply
@@ -217,6 +284,20 @@ func (c *DictTypeHTTPClientImpl) FindDictType(ctx context.Context, in *FindDictT
 	return &out, nil
 }
 
+// ImportDicts导入字典，按字典类型和字典键值合并
+func (c *DictTypeHTTPClientImpl) ImportDicts(ctx context.Context, in *ImportDictsRequest, opts ...http.CallOption) (*ImportDictsReply, error) {
+	var out ImportDictsReply
+	pattern := "/system/dict/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationDictTypeImportDicts))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListDictType列表信息
 func (c *DictTypeHTTPClientImpl) ListDictType(ctx context.Context, in *ListDictTypeRequest, opts ...http.CallOption) (*ListDictTypeReply, error) {
 	var out ListDictTypeReply
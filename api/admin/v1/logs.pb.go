@@ -7,6 +7,7 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
@@ -23,20 +24,22 @@ const (
 )
 
 type SysLogs struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	Username      string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
-	Nickname      string                 `protobuf:"bytes,6,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Operation     string                 `protobuf:"bytes,7,opt,name=operation,proto3" json:"operation,omitempty"`
-	Method        string                 `protobuf:"bytes,8,opt,name=method,proto3" json:"method,omitempty"`
-	Params        string                 `protobuf:"bytes,9,opt,name=params,proto3" json:"params,omitempty"`
-	Ip            string                 `protobuf:"bytes,10,opt,name=ip,proto3" json:"ip,omitempty"`
-	Location      string                 `protobuf:"bytes,11,opt,name=location,proto3" json:"location,omitempty"`
-	Status        int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
-	Remark        string                 `protobuf:"bytes,13,opt,name=remark,proto3" json:"remark,omitempty"`
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	UserId    int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username  string                 `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	Nickname  string                 `protobuf:"bytes,6,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Operation string                 `protobuf:"bytes,7,opt,name=operation,proto3" json:"operation,omitempty"`
+	Method    string                 `protobuf:"bytes,8,opt,name=method,proto3" json:"method,omitempty"`
+	Params    string                 `protobuf:"bytes,9,opt,name=params,proto3" json:"params,omitempty"`
+	Ip        string                 `protobuf:"bytes,10,opt,name=ip,proto3" json:"ip,omitempty"`
+	Location  string                 `protobuf:"bytes,11,opt,name=location,proto3" json:"location,omitempty"`
+	Status    int32                  `protobuf:"varint,12,opt,name=status,proto3" json:"status,omitempty"`
+	Remark    string                 `protobuf:"bytes,13,opt,name=remark,proto3" json:"remark,omitempty"`
+	// 本次操作修改的实体字段
+	Changes       []*LogFieldChange `protobuf:"bytes,14,rep,name=changes,proto3" json:"changes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -162,22 +165,39 @@ func (x *SysLogs) GetRemark() string {
 	return ""
 }
 
+func (x *SysLogs) GetChanges() []*LogFieldChange {
+	if x != nil {
+		return x.Changes
+	}
+	return nil
+}
+
 // Extended fields for detailed operation records (from SQL schema)
 type SysLogsDetail struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	CreatedAt     string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	UpdatedAt     string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
-	Ip            string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
-	Method        string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
-	Path          string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
-	Status        int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
-	Latency       string                 `protobuf:"bytes,8,opt,name=latency,proto3" json:"latency,omitempty"`
-	Agent         string                 `protobuf:"bytes,9,opt,name=agent,proto3" json:"agent,omitempty"`
-	ErrorMessage  string                 `protobuf:"bytes,10,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
-	Body          string                 `protobuf:"bytes,11,opt,name=body,proto3" json:"body,omitempty"`
-	Resp          string                 `protobuf:"bytes,12,opt,name=resp,proto3" json:"resp,omitempty"`
-	UserId        int64                  `protobuf:"varint,13,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	state        protoimpl.MessageState `protogen:"open.v1"`
+	Id           int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	CreatedAt    string                 `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt    string                 `protobuf:"bytes,3,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	Ip           string                 `protobuf:"bytes,4,opt,name=ip,proto3" json:"ip,omitempty"`
+	Method       string                 `protobuf:"bytes,5,opt,name=method,proto3" json:"method,omitempty"`
+	Path         string                 `protobuf:"bytes,6,opt,name=path,proto3" json:"path,omitempty"`
+	Status       int32                  `protobuf:"varint,7,opt,name=status,proto3" json:"status,omitempty"`
+	Latency      string                 `protobuf:"bytes,8,opt,name=latency,proto3" json:"latency,omitempty"`
+	Agent        string                 `protobuf:"bytes,9,opt,name=agent,proto3" json:"agent,omitempty"`
+	ErrorMessage string                 `protobuf:"bytes,10,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	Body         string                 `protobuf:"bytes,11,opt,name=body,proto3" json:"body,omitempty"`
+	Resp         string                 `protobuf:"bytes,12,opt,name=resp,proto3" json:"resp,omitempty"`
+	UserId       int64                  `protobuf:"varint,13,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username     string                 `protobuf:"bytes,14,opt,name=username,proto3" json:"username,omitempty"`
+	Nickname     string                 `protobuf:"bytes,15,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Operation    string                 `protobuf:"bytes,16,opt,name=operation,proto3" json:"operation,omitempty"`
+	// kratos 错误原因和错误码，成功时为空
+	Reason    string `protobuf:"bytes,17,opt,name=reason,proto3" json:"reason,omitempty"`
+	ErrorCode int32  `protobuf:"varint,18,opt,name=error_code,json=errorCode,proto3" json:"error_code,omitempty"`
+	TraceId   string `protobuf:"bytes,19,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	// 审计链序号和哈希，未开启审计时为空
+	Seq           int64  `protobuf:"varint,20,opt,name=seq,proto3" json:"seq,omitempty"`
+	Hash          string `protobuf:"bytes,21,opt,name=hash,proto3" json:"hash,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -303,18 +323,85 @@ func (x *SysLogsDetail) GetUserId() int64 {
 	return 0
 }
 
+func (x *SysLogsDetail) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SysLogsDetail) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *SysLogsDetail) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *SysLogsDetail) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *SysLogsDetail) GetErrorCode() int32 {
+	if x != nil {
+		return x.ErrorCode
+	}
+	return 0
+}
+
+func (x *SysLogsDetail) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *SysLogsDetail) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *SysLogsDetail) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
 type ListLogsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	PageNum       int32                  `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
-	PageSize      int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
-	Username      string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	Nickname      string                 `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`
-	Operation     string                 `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
-	Method        string                 `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
-	Ip            string                 `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip,omitempty"`
-	Status        int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
-	StartTime     string                 `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
-	EndTime       string                 `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageNum  int32                  `protobuf:"varint,1,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	Username string                 `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	Nickname string                 `protobuf:"bytes,4,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// 按操作前缀过滤，如 /api.admin.v1.Roles/
+	Operation string `protobuf:"bytes,5,opt,name=operation,proto3" json:"operation,omitempty"`
+	Method    string `protobuf:"bytes,6,opt,name=method,proto3" json:"method,omitempty"`
+	Ip        string `protobuf:"bytes,7,opt,name=ip,proto3" json:"ip,omitempty"`
+	Status    int32  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	StartTime string `protobuf:"bytes,9,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime   string `protobuf:"bytes,10,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	// 排序字段，如 created_at、latency、status，默认 id
+	SortField string `protobuf:"bytes,11,opt,name=sort_field,json=sortField,proto3" json:"sort_field,omitempty"`
+	// asc 或 desc，默认 desc
+	SortOrder string `protobuf:"bytes,12,opt,name=sort_order,json=sortOrder,proto3" json:"sort_order,omitempty"`
+	// 按路径前缀过滤
+	Path    string `protobuf:"bytes,13,opt,name=path,proto3" json:"path,omitempty"`
+	Reason  string `protobuf:"bytes,14,opt,name=reason,proto3" json:"reason,omitempty"`
+	TraceId string `protobuf:"bytes,15,opt,name=trace_id,json=traceId,proto3" json:"trace_id,omitempty"`
+	// 只返回失败的请求（HTTP 状态码 >= 400）
+	Failed        bool `protobuf:"varint,16,opt,name=failed,proto3" json:"failed,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -419,6 +506,48 @@ func (x *ListLogsRequest) GetEndTime() string {
 	return ""
 }
 
+func (x *ListLogsRequest) GetSortField() string {
+	if x != nil {
+		return x.SortField
+	}
+	return ""
+}
+
+func (x *ListLogsRequest) GetSortOrder() string {
+	if x != nil {
+		return x.SortOrder
+	}
+	return ""
+}
+
+func (x *ListLogsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ListLogsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ListLogsRequest) GetTraceId() string {
+	if x != nil {
+		return x.TraceId
+	}
+	return ""
+}
+
+func (x *ListLogsRequest) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
 type ListLogsReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
@@ -735,80 +864,1092 @@ func (*DeleteLogsByIdsReply) Descriptor() ([]byte, []int) {
 	return file_logs_proto_rawDescGZIP(), []int{9}
 }
 
-var File_logs_proto protoreflect.FileDescriptor
+type LogChainProblem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Seq   int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Id    int64                  `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// gap、link、modified、deleted、signature、tail
+	Kind          string `protobuf:"bytes,3,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message       string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_logs_proto_rawDesc = "" +
-	"\n" +
-	"\n" +
-	"logs.proto\x12\fapi.admin.v1\x1a\x1cgoogle/api/annotations.proto\"\xd2\x02\n" +
-	"\aSysLogs\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x17\n" +
-	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\x1a\n" +
-	"\busername\x18\x05 \x01(\tR\busername\x12\x1a\n" +
-	"\bnickname\x18\x06 \x01(\tR\bnickname\x12\x1c\n" +
-	"\toperation\x18\a \x01(\tR\toperation\x12\x16\n" +
-	"\x06method\x18\b \x01(\tR\x06method\x12\x16\n" +
-	"\x06params\x18\t \x01(\tR\x06params\x12\x0e\n" +
-	"\x02ip\x18\n" +
-	" \x01(\tR\x02ip\x12\x1a\n" +
-	"\blocation\x18\v \x01(\tR\blocation\x12\x16\n" +
-	"\x06status\x18\f \x01(\x05R\x06status\x12\x16\n" +
-	"\x06remark\x18\r \x01(\tR\x06remark\"\xc7\x02\n" +
-	"\rSysLogsDetail\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
-	"\n" +
-	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
-	"\n" +
-	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x0e\n" +
-	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x16\n" +
-	"\x06method\x18\x05 \x01(\tR\x06method\x12\x12\n" +
-	"\x04path\x18\x06 \x01(\tR\x04path\x12\x16\n" +
-	"\x06status\x18\a \x01(\x05R\x06status\x12\x18\n" +
-	"\alatency\x18\b \x01(\tR\alatency\x12\x14\n" +
-	"\x05agent\x18\t \x01(\tR\x05agent\x12#\n" +
-	"\rerror_message\x18\n" +
-	" \x01(\tR\ferrorMessage\x12\x12\n" +
-	"\x04body\x18\v \x01(\tR\x04body\x12\x12\n" +
-	"\x04resp\x18\f \x01(\tR\x04resp\x12\x17\n" +
-	"\auser_id\x18\r \x01(\x03R\x06userId\"\x99\x02\n" +
-	"\x0fListLogsRequest\x12\x19\n" +
-	"\bpage_num\x18\x01 \x01(\x05R\apageNum\x12\x1b\n" +
-	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1a\n" +
-	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
-	"\bnickname\x18\x04 \x01(\tR\bnickname\x12\x1c\n" +
-	"\toperation\x18\x05 \x01(\tR\toperation\x12\x16\n" +
-	"\x06method\x18\x06 \x01(\tR\x06method\x12\x0e\n" +
-	"\x02ip\x18\a \x01(\tR\x02ip\x12\x16\n" +
-	"\x06status\x18\b \x01(\x05R\x06status\x12\x1d\n" +
-	"\n" +
-	"start_time\x18\t \x01(\tR\tstartTime\x12\x19\n" +
-	"\bend_time\x18\n" +
-	" \x01(\tR\aendTime\"V\n" +
-	"\rListLogsReply\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x05R\x05total\x12/\n" +
-	"\x04list\x18\x02 \x03(\v2\x1b.api.admin.v1.SysLogsDetailR\x04list\"!\n" +
-	"\x0fFindLogsRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\":\n" +
-	"\rFindLogsReply\x12)\n" +
-	"\x04data\x18\x01 \x01(\v2\x15.api.admin.v1.SysLogsR\x04data\"L\n" +
-	"\x10CleanLogsRequest\x12\x1d\n" +
-	"\n" +
-	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x19\n" +
-	"\bend_time\x18\x02 \x01(\tR\aendTime\"*\n" +
-	"\x0eCleanLogsReply\x12\x18\n" +
-	"\adeleted\x18\x01 \x01(\x03R\adeleted\"*\n" +
-	"\x16DeleteLogsByIdsRequest\x12\x10\n" +
-	"\x03ids\x18\x01 \x01(\tR\x03ids\"\x16\n" +
-	"\x14DeleteLogsByIdsReply2\xb9\x03\n" +
-	"\vLogsService\x12a\n" +
-	"\bListLogs\x12\x1d.api.admin.v1.ListLogsRequest\x1a\x1b.api.admin.v1.ListLogsReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/system/logs/list\x12a\n" +
-	"\bFindLogs\x12\x1d.api.admin.v1.FindLogsRequest\x1a\x1b.api.admin.v1.FindLogsReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/system/logs/{id}\x12e\n" +
-	"\tCleanLogs\x12\x1e.api.admin.v1.CleanLogsRequest\x1a\x1c.api.admin.v1.CleanLogsReply\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/system/logs/clean\x12}\n" +
-	"\x0fDeleteLogsByIds\x12$.api.admin.v1.DeleteLogsByIdsRequest\x1a\".api.admin.v1.DeleteLogsByIdsReply\" \x82\xd3\xe4\x93\x02\x1a*\x18/system/logs/deleteByIdsB6Z4github.com/swordkee/kratos-vue-admin/api/admin/v1;v1b\x06proto3"
+func (x *LogChainProblem) Reset() {
+	*x = LogChainProblem{}
+	mi := &file_logs_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogChainProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogChainProblem) ProtoMessage() {}
+
+func (x *LogChainProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogChainProblem.ProtoReflect.Descriptor instead.
+func (*LogChainProblem) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *LogChainProblem) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *LogChainProblem) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LogChainProblem) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LogChainProblem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type VerifyLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyLogsRequest) Reset() {
+	*x = VerifyLogsRequest{}
+	mi := &file_logs_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLogsRequest) ProtoMessage() {}
+
+func (x *VerifyLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLogsRequest.ProtoReflect.Descriptor instead.
+func (*VerifyLogsRequest) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{11}
+}
+
+type VerifyLogsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Valid         bool                   `protobuf:"varint,1,opt,name=valid,proto3" json:"valid,omitempty"`
+	Checked       int64                  `protobuf:"varint,2,opt,name=checked,proto3" json:"checked,omitempty"`
+	LastSeq       int64                  `protobuf:"varint,3,opt,name=last_seq,json=lastSeq,proto3" json:"last_seq,omitempty"`
+	Checkpoints   int32                  `protobuf:"varint,4,opt,name=checkpoints,proto3" json:"checkpoints,omitempty"`
+	Problems      []*LogChainProblem     `protobuf:"bytes,5,rep,name=problems,proto3" json:"problems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VerifyLogsReply) Reset() {
+	*x = VerifyLogsReply{}
+	mi := &file_logs_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VerifyLogsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyLogsReply) ProtoMessage() {}
+
+func (x *VerifyLogsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyLogsReply.ProtoReflect.Descriptor instead.
+func (*VerifyLogsReply) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *VerifyLogsReply) GetValid() bool {
+	if x != nil {
+		return x.Valid
+	}
+	return false
+}
+
+func (x *VerifyLogsReply) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *VerifyLogsReply) GetLastSeq() int64 {
+	if x != nil {
+		return x.LastSeq
+	}
+	return 0
+}
+
+func (x *VerifyLogsReply) GetCheckpoints() int32 {
+	if x != nil {
+		return x.Checkpoints
+	}
+	return 0
+}
+
+func (x *VerifyLogsReply) GetProblems() []*LogChainProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+type GetLogsWriterStatsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLogsWriterStatsRequest) Reset() {
+	*x = GetLogsWriterStatsRequest{}
+	mi := &file_logs_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLogsWriterStatsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsWriterStatsRequest) ProtoMessage() {}
+
+func (x *GetLogsWriterStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsWriterStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetLogsWriterStatsRequest) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{13}
+}
+
+type GetLogsWriterStatsReply struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Queued          int64                  `protobuf:"varint,1,opt,name=queued,proto3" json:"queued,omitempty"`                                          // 当前队列长度
+	Capacity        int64                  `protobuf:"varint,2,opt,name=capacity,proto3" json:"capacity,omitempty"`                                      // 队列容量
+	Written         int64                  `protobuf:"varint,3,opt,name=written,proto3" json:"written,omitempty"`                                        // 已写入条数
+	Failed          int64                  `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`                                          // 写入失败条数
+	Dropped         int64                  `protobuf:"varint,5,opt,name=dropped,proto3" json:"dropped,omitempty"`                                        // 队列已满被丢弃的条数
+	FlushFailures   int64                  `protobuf:"varint,6,opt,name=flush_failures,json=flushFailures,proto3" json:"flush_failures,omitempty"`       // 批量写入失败次数
+	PublishFailures int64                  `protobuf:"varint,7,opt,name=publish_failures,json=publishFailures,proto3" json:"publish_failures,omitempty"` // 实时推送广播失败次数
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetLogsWriterStatsReply) Reset() {
+	*x = GetLogsWriterStatsReply{}
+	mi := &file_logs_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLogsWriterStatsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLogsWriterStatsReply) ProtoMessage() {}
+
+func (x *GetLogsWriterStatsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLogsWriterStatsReply.ProtoReflect.Descriptor instead.
+func (*GetLogsWriterStatsReply) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetLogsWriterStatsReply) GetQueued() int64 {
+	if x != nil {
+		return x.Queued
+	}
+	return 0
+}
+
+func (x *GetLogsWriterStatsReply) GetCapacity() int64 {
+	if x != nil {
+		return x.Capacity
+	}
+	return 0
+}
+
+func (x *GetLogsWriterStatsReply) GetWritten() int64 {
+	if x != nil {
+		return x.Written
+	}
+	return 0
+}
+
+func (x *GetLogsWriterStatsReply) GetFailed() int64 {
+	if x != nil {
+		return x.Failed
+	}
+	return 0
+}
+
+func (x *GetLogsWriterStatsReply) GetDropped() int64 {
+	if x != nil {
+		return x.Dropped
+	}
+	return 0
+}
+
+func (x *GetLogsWriterStatsReply) GetFlushFailures() int64 {
+	if x != nil {
+		return x.FlushFailures
+	}
+	return 0
+}
+
+func (x *GetLogsWriterStatsReply) GetPublishFailures() int64 {
+	if x != nil {
+		return x.PublishFailures
+	}
+	return 0
+}
+
+type LogCheckpoint struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	StartSeq      int64                  `protobuf:"varint,2,opt,name=start_seq,json=startSeq,proto3" json:"start_seq,omitempty"`
+	EndSeq        int64                  `protobuf:"varint,3,opt,name=end_seq,json=endSeq,proto3" json:"end_seq,omitempty"`
+	PrevHash      string                 `protobuf:"bytes,4,opt,name=prev_hash,json=prevHash,proto3" json:"prev_hash,omitempty"`
+	EndHash       string                 `protobuf:"bytes,5,opt,name=end_hash,json=endHash,proto3" json:"end_hash,omitempty"`
+	Count         int64                  `protobuf:"varint,6,opt,name=count,proto3" json:"count,omitempty"`
+	EndTime       string                 `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Signature     string                 `protobuf:"bytes,8,opt,name=signature,proto3" json:"signature,omitempty"`
+	CreateBy      string                 `protobuf:"bytes,9,opt,name=create_by,json=createBy,proto3" json:"create_by,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,10,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogCheckpoint) Reset() {
+	*x = LogCheckpoint{}
+	mi := &file_logs_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogCheckpoint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogCheckpoint) ProtoMessage() {}
+
+func (x *LogCheckpoint) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogCheckpoint.ProtoReflect.Descriptor instead.
+func (*LogCheckpoint) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *LogCheckpoint) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LogCheckpoint) GetStartSeq() int64 {
+	if x != nil {
+		return x.StartSeq
+	}
+	return 0
+}
+
+func (x *LogCheckpoint) GetEndSeq() int64 {
+	if x != nil {
+		return x.EndSeq
+	}
+	return 0
+}
+
+func (x *LogCheckpoint) GetPrevHash() string {
+	if x != nil {
+		return x.PrevHash
+	}
+	return ""
+}
+
+func (x *LogCheckpoint) GetEndHash() string {
+	if x != nil {
+		return x.EndHash
+	}
+	return ""
+}
+
+func (x *LogCheckpoint) GetCount() int64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+func (x *LogCheckpoint) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+func (x *LogCheckpoint) GetSignature() string {
+	if x != nil {
+		return x.Signature
+	}
+	return ""
+}
+
+func (x *LogCheckpoint) GetCreateBy() string {
+	if x != nil {
+		return x.CreateBy
+	}
+	return ""
+}
+
+func (x *LogCheckpoint) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ArchiveLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 归档截止时间，格式 2006-01-02 15:04:05
+	EndTime       string `protobuf:"bytes,1,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveLogsRequest) Reset() {
+	*x = ArchiveLogsRequest{}
+	mi := &file_logs_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveLogsRequest) ProtoMessage() {}
+
+func (x *ArchiveLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveLogsRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveLogsRequest) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ArchiveLogsRequest) GetEndTime() string {
+	if x != nil {
+		return x.EndTime
+	}
+	return ""
+}
+
+type ArchiveLogsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checkpoint    *LogCheckpoint         `protobuf:"bytes,1,opt,name=checkpoint,proto3" json:"checkpoint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveLogsReply) Reset() {
+	*x = ArchiveLogsReply{}
+	mi := &file_logs_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveLogsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveLogsReply) ProtoMessage() {}
+
+func (x *ArchiveLogsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveLogsReply.ProtoReflect.Descriptor instead.
+func (*ArchiveLogsReply) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ArchiveLogsReply) GetCheckpoint() *LogCheckpoint {
+	if x != nil {
+		return x.Checkpoint
+	}
+	return nil
+}
+
+type ListLogCheckpointsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLogCheckpointsRequest) Reset() {
+	*x = ListLogCheckpointsRequest{}
+	mi := &file_logs_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLogCheckpointsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogCheckpointsRequest) ProtoMessage() {}
+
+func (x *ListLogCheckpointsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLogCheckpointsRequest.ProtoReflect.Descriptor instead.
+func (*ListLogCheckpointsRequest) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{18}
+}
+
+type ListLogCheckpointsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	List          []*LogCheckpoint       `protobuf:"bytes,1,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListLogCheckpointsReply) Reset() {
+	*x = ListLogCheckpointsReply{}
+	mi := &file_logs_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListLogCheckpointsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListLogCheckpointsReply) ProtoMessage() {}
+
+func (x *ListLogCheckpointsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListLogCheckpointsReply.ProtoReflect.Descriptor instead.
+func (*ListLogCheckpointsReply) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListLogCheckpointsReply) GetList() []*LogCheckpoint {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+type LogFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	LogId         int64                  `protobuf:"varint,2,opt,name=log_id,json=logId,proto3" json:"log_id,omitempty"`
+	Entity        string                 `protobuf:"bytes,3,opt,name=entity,proto3" json:"entity,omitempty"`
+	EntityId      int64                  `protobuf:"varint,4,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Field         string                 `protobuf:"bytes,5,opt,name=field,proto3" json:"field,omitempty"`
+	OldValue      string                 `protobuf:"bytes,6,opt,name=old_value,json=oldValue,proto3" json:"old_value,omitempty"`
+	NewValue      string                 `protobuf:"bytes,7,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+	UserId        int64                  `protobuf:"varint,8,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username      string                 `protobuf:"bytes,9,opt,name=username,proto3" json:"username,omitempty"`
+	Nickname      string                 `protobuf:"bytes,10,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	Operation     string                 `protobuf:"bytes,11,opt,name=operation,proto3" json:"operation,omitempty"`
+	CreatedAt     string                 `protobuf:"bytes,12,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogFieldChange) Reset() {
+	*x = LogFieldChange{}
+	mi := &file_logs_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogFieldChange) ProtoMessage() {}
+
+func (x *LogFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogFieldChange.ProtoReflect.Descriptor instead.
+func (*LogFieldChange) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *LogFieldChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *LogFieldChange) GetLogId() int64 {
+	if x != nil {
+		return x.LogId
+	}
+	return 0
+}
+
+func (x *LogFieldChange) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *LogFieldChange) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *LogFieldChange) GetField() string {
+	if x != nil {
+		return x.Field
+	}
+	return ""
+}
+
+func (x *LogFieldChange) GetOldValue() string {
+	if x != nil {
+		return x.OldValue
+	}
+	return ""
+}
+
+func (x *LogFieldChange) GetNewValue() string {
+	if x != nil {
+		return x.NewValue
+	}
+	return ""
+}
+
+func (x *LogFieldChange) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *LogFieldChange) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *LogFieldChange) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *LogFieldChange) GetOperation() string {
+	if x != nil {
+		return x.Operation
+	}
+	return ""
+}
+
+func (x *LogFieldChange) GetCreatedAt() string {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return ""
+}
+
+type ListEntityHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Entity        string                 `protobuf:"bytes,1,opt,name=entity,proto3" json:"entity,omitempty"`
+	EntityId      int64                  `protobuf:"varint,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	PageNum       int32                  `protobuf:"varint,3,opt,name=page_num,json=pageNum,proto3" json:"page_num,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntityHistoryRequest) Reset() {
+	*x = ListEntityHistoryRequest{}
+	mi := &file_logs_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntityHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntityHistoryRequest) ProtoMessage() {}
+
+func (x *ListEntityHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntityHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ListEntityHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *ListEntityHistoryRequest) GetEntity() string {
+	if x != nil {
+		return x.Entity
+	}
+	return ""
+}
+
+func (x *ListEntityHistoryRequest) GetEntityId() int64 {
+	if x != nil {
+		return x.EntityId
+	}
+	return 0
+}
+
+func (x *ListEntityHistoryRequest) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListEntityHistoryRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListEntityHistoryReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	List          []*LogFieldChange      `protobuf:"bytes,2,rep,name=list,proto3" json:"list,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListEntityHistoryReply) Reset() {
+	*x = ListEntityHistoryReply{}
+	mi := &file_logs_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListEntityHistoryReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListEntityHistoryReply) ProtoMessage() {}
+
+func (x *ListEntityHistoryReply) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListEntityHistoryReply.ProtoReflect.Descriptor instead.
+func (*ListEntityHistoryReply) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *ListEntityHistoryReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListEntityHistoryReply) GetList() []*LogFieldChange {
+	if x != nil {
+		return x.List
+	}
+	return nil
+}
+
+// 实时推送 /system/logs/stream 的查询参数，事件数据为 SysLogsDetail
+type StreamLogsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	UserId   int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Username string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// 按路径前缀过滤
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// 只推送失败的请求（HTTP 状态码 >= 400）
+	Failed        bool `protobuf:"varint,4,opt,name=failed,proto3" json:"failed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamLogsRequest) Reset() {
+	*x = StreamLogsRequest{}
+	mi := &file_logs_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsRequest) ProtoMessage() {}
+
+func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_logs_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return file_logs_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *StreamLogsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *StreamLogsRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *StreamLogsRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *StreamLogsRequest) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+var File_logs_proto protoreflect.FileDescriptor
+
+const file_logs_proto_rawDesc = "" +
+	"\n" +
+	"\n" +
+	"logs.proto\x12\fapi.admin.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\"\x8a\x03\n" +
+	"\aSysLogs\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\x06 \x01(\tR\bnickname\x12\x1c\n" +
+	"\toperation\x18\a \x01(\tR\toperation\x12\x16\n" +
+	"\x06method\x18\b \x01(\tR\x06method\x12\x16\n" +
+	"\x06params\x18\t \x01(\tR\x06params\x12\x0e\n" +
+	"\x02ip\x18\n" +
+	" \x01(\tR\x02ip\x12\x1a\n" +
+	"\blocation\x18\v \x01(\tR\blocation\x12\x16\n" +
+	"\x06status\x18\f \x01(\x05R\x06status\x12\x16\n" +
+	"\x06remark\x18\r \x01(\tR\x06remark\x126\n" +
+	"\achanges\x18\x0e \x03(\v2\x1c.api.admin.v1.LogFieldChangeR\achanges\"\x95\x04\n" +
+	"\rSysLogsDetail\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x02 \x01(\tR\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\x03 \x01(\tR\tupdatedAt\x12\x0e\n" +
+	"\x02ip\x18\x04 \x01(\tR\x02ip\x12\x16\n" +
+	"\x06method\x18\x05 \x01(\tR\x06method\x12\x12\n" +
+	"\x04path\x18\x06 \x01(\tR\x04path\x12\x16\n" +
+	"\x06status\x18\a \x01(\x05R\x06status\x12\x18\n" +
+	"\alatency\x18\b \x01(\tR\alatency\x12\x14\n" +
+	"\x05agent\x18\t \x01(\tR\x05agent\x12#\n" +
+	"\rerror_message\x18\n" +
+	" \x01(\tR\ferrorMessage\x12\x12\n" +
+	"\x04body\x18\v \x01(\tR\x04body\x12\x12\n" +
+	"\x04resp\x18\f \x01(\tR\x04resp\x12\x17\n" +
+	"\auser_id\x18\r \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x0e \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\x0f \x01(\tR\bnickname\x12\x1c\n" +
+	"\toperation\x18\x10 \x01(\tR\toperation\x12\x16\n" +
+	"\x06reason\x18\x11 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"error_code\x18\x12 \x01(\x05R\terrorCode\x12\x19\n" +
+	"\btrace_id\x18\x13 \x01(\tR\atraceId\x12\x10\n" +
+	"\x03seq\x18\x14 \x01(\x03R\x03seq\x12\x12\n" +
+	"\x04hash\x18\x15 \x01(\tR\x04hash\"\xb6\x03\n" +
+	"\x0fListLogsRequest\x12\x19\n" +
+	"\bpage_num\x18\x01 \x01(\x05R\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\x05R\bpageSize\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\x04 \x01(\tR\bnickname\x12\x1c\n" +
+	"\toperation\x18\x05 \x01(\tR\toperation\x12\x16\n" +
+	"\x06method\x18\x06 \x01(\tR\x06method\x12\x0e\n" +
+	"\x02ip\x18\a \x01(\tR\x02ip\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\t \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\n" +
+	" \x01(\tR\aendTime\x12\x1d\n" +
+	"\n" +
+	"sort_field\x18\v \x01(\tR\tsortField\x12\x1d\n" +
+	"\n" +
+	"sort_order\x18\f \x01(\tR\tsortOrder\x12\x12\n" +
+	"\x04path\x18\r \x01(\tR\x04path\x12\x16\n" +
+	"\x06reason\x18\x0e \x01(\tR\x06reason\x12\x19\n" +
+	"\btrace_id\x18\x0f \x01(\tR\atraceId\x12\x16\n" +
+	"\x06failed\x18\x10 \x01(\bR\x06failed\"V\n" +
+	"\rListLogsReply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12/\n" +
+	"\x04list\x18\x02 \x03(\v2\x1b.api.admin.v1.SysLogsDetailR\x04list\"!\n" +
+	"\x0fFindLogsRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\":\n" +
+	"\rFindLogsReply\x12)\n" +
+	"\x04data\x18\x01 \x01(\v2\x15.api.admin.v1.SysLogsR\x04data\"L\n" +
+	"\x10CleanLogsRequest\x12\x1d\n" +
+	"\n" +
+	"start_time\x18\x01 \x01(\tR\tstartTime\x12\x19\n" +
+	"\bend_time\x18\x02 \x01(\tR\aendTime\"*\n" +
+	"\x0eCleanLogsReply\x12\x18\n" +
+	"\adeleted\x18\x01 \x01(\x03R\adeleted\"*\n" +
+	"\x16DeleteLogsByIdsRequest\x12\x10\n" +
+	"\x03ids\x18\x01 \x01(\tR\x03ids\"\x16\n" +
+	"\x14DeleteLogsByIdsReply\"a\n" +
+	"\x0fLogChainProblem\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\x03R\x02id\x12\x12\n" +
+	"\x04kind\x18\x03 \x01(\tR\x04kind\x12\x18\n" +
+	"\amessage\x18\x04 \x01(\tR\amessage\"\x13\n" +
+	"\x11VerifyLogsRequest\"\xb9\x01\n" +
+	"\x0fVerifyLogsReply\x12\x14\n" +
+	"\x05valid\x18\x01 \x01(\bR\x05valid\x12\x18\n" +
+	"\achecked\x18\x02 \x01(\x03R\achecked\x12\x19\n" +
+	"\blast_seq\x18\x03 \x01(\x03R\alastSeq\x12 \n" +
+	"\vcheckpoints\x18\x04 \x01(\x05R\vcheckpoints\x129\n" +
+	"\bproblems\x18\x05 \x03(\v2\x1d.api.admin.v1.LogChainProblemR\bproblems\"\x1b\n" +
+	"\x19GetLogsWriterStatsRequest\"\xeb\x01\n" +
+	"\x17GetLogsWriterStatsReply\x12\x16\n" +
+	"\x06queued\x18\x01 \x01(\x03R\x06queued\x12\x1a\n" +
+	"\bcapacity\x18\x02 \x01(\x03R\bcapacity\x12\x18\n" +
+	"\awritten\x18\x03 \x01(\x03R\awritten\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\x03R\x06failed\x12\x18\n" +
+	"\adropped\x18\x05 \x01(\x03R\adropped\x12%\n" +
+	"\x0eflush_failures\x18\x06 \x01(\x03R\rflushFailures\x12)\n" +
+	"\x10publish_failures\x18\a \x01(\x03R\x0fpublishFailures\"\x98\x02\n" +
+	"\rLogCheckpoint\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1b\n" +
+	"\tstart_seq\x18\x02 \x01(\x03R\bstartSeq\x12\x17\n" +
+	"\aend_seq\x18\x03 \x01(\x03R\x06endSeq\x12\x1b\n" +
+	"\tprev_hash\x18\x04 \x01(\tR\bprevHash\x12\x19\n" +
+	"\bend_hash\x18\x05 \x01(\tR\aendHash\x12\x14\n" +
+	"\x05count\x18\x06 \x01(\x03R\x05count\x12\x19\n" +
+	"\bend_time\x18\a \x01(\tR\aendTime\x12\x1c\n" +
+	"\tsignature\x18\b \x01(\tR\tsignature\x12\x1b\n" +
+	"\tcreate_by\x18\t \x01(\tR\bcreateBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\n" +
+	" \x01(\tR\tcreatedAt\"8\n" +
+	"\x12ArchiveLogsRequest\x12\"\n" +
+	"\bend_time\x18\x01 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\aendTime\"O\n" +
+	"\x10ArchiveLogsReply\x12;\n" +
+	"\n" +
+	"checkpoint\x18\x01 \x01(\v2\x1b.api.admin.v1.LogCheckpointR\n" +
+	"checkpoint\"\x1b\n" +
+	"\x19ListLogCheckpointsRequest\"J\n" +
+	"\x17ListLogCheckpointsReply\x12/\n" +
+	"\x04list\x18\x01 \x03(\v2\x1b.api.admin.v1.LogCheckpointR\x04list\"\xca\x02\n" +
+	"\x0eLogFieldChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x15\n" +
+	"\x06log_id\x18\x02 \x01(\x03R\x05logId\x12\x16\n" +
+	"\x06entity\x18\x03 \x01(\tR\x06entity\x12\x1b\n" +
+	"\tentity_id\x18\x04 \x01(\x03R\bentityId\x12\x14\n" +
+	"\x05field\x18\x05 \x01(\tR\x05field\x12\x1b\n" +
+	"\told_value\x18\x06 \x01(\tR\boldValue\x12\x1b\n" +
+	"\tnew_value\x18\a \x01(\tR\bnewValue\x12\x17\n" +
+	"\auser_id\x18\b \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\t \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\n" +
+	" \x01(\tR\bnickname\x12\x1c\n" +
+	"\toperation\x18\v \x01(\tR\toperation\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\f \x01(\tR\tcreatedAt\"\xc5\x01\n" +
+	"\x18ListEntityHistoryRequest\x12K\n" +
+	"\x06entity\x18\x01 \x01(\tB3\xfaB0r.R\x04userR\x04roleR\x04deptR\x04menuR\tdict_typeR\tdict_dataR\x06entity\x12$\n" +
+	"\tentity_id\x18\x02 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\bentityId\x12\x19\n" +
+	"\bpage_num\x18\x03 \x01(\x05R\apageNum\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"`\n" +
+	"\x16ListEntityHistoryReply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x120\n" +
+	"\x04list\x18\x02 \x03(\v2\x1c.api.admin.v1.LogFieldChangeR\x04list\"t\n" +
+	"\x11StreamLogsRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12\x16\n" +
+	"\x06failed\x18\x04 \x01(\bR\x06failed2\xd2\b\n" +
+	"\vLogsService\x12a\n" +
+	"\bListLogs\x12\x1d.api.admin.v1.ListLogsRequest\x1a\x1b.api.admin.v1.ListLogsReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/system/logs/list\x12a\n" +
+	"\bFindLogs\x12\x1d.api.admin.v1.FindLogsRequest\x1a\x1b.api.admin.v1.FindLogsReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/system/logs/{id}\x12e\n" +
+	"\tCleanLogs\x12\x1e.api.admin.v1.CleanLogsRequest\x1a\x1c.api.admin.v1.CleanLogsReply\"\x1a\x82\xd3\xe4\x93\x02\x14*\x12/system/logs/clean\x12}\n" +
+	"\x0fDeleteLogsByIds\x12$.api.admin.v1.DeleteLogsByIdsRequest\x1a\".api.admin.v1.DeleteLogsByIdsReply\" \x82\xd3\xe4\x93\x02\x1a*\x18/system/logs/deleteByIds\x12o\n" +
+	"\n" +
+	"VerifyLogs\x12\x1f.api.admin.v1.VerifyLogsRequest\x1a\x1d.api.admin.v1.VerifyLogsReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/system/logs/chain/verify\x12v\n" +
+	"\vArchiveLogs\x12 .api.admin.v1.ArchiveLogsRequest\x1a\x1e.api.admin.v1.ArchiveLogsReply\"%\x82\xd3\xe4\x93\x02\x1f:\x01*\"\x1a/system/logs/chain/archive\x12\x8c\x01\n" +
+	"\x12ListLogCheckpoints\x12'.api.admin.v1.ListLogCheckpointsRequest\x1a%.api.admin.v1.ListLogCheckpointsReply\"&\x82\xd3\xe4\x93\x02 \x12\x1e/system/logs/chain/checkpoints\x12\x87\x01\n" +
+	"\x12GetLogsWriterStats\x12'.api.admin.v1.GetLogsWriterStatsRequest\x1a%.api.admin.v1.GetLogsWriterStatsReply\"!\x82\xd3\xe4\x93\x02\x1b\x12\x19/system/logs/writer/stats\x12\x94\x01\n" +
+	"\x11ListEntityHistory\x12&.api.admin.v1.ListEntityHistoryRequest\x1a$.api.admin.v1.ListEntityHistoryReply\"1\x82\xd3\xe4\x93\x02+\x12)/system/logs/history/{entity}/{entity_id}B6Z4github.com/swordkee/kratos-vue-admin/api/admin/v1;v1b\x06proto3"
 
 var (
 	file_logs_proto_rawDescOnce sync.Once
@@ -822,35 +1963,64 @@ func file_logs_proto_rawDescGZIP() []byte {
 	return file_logs_proto_rawDescData
 }
 
-var file_logs_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_logs_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_logs_proto_goTypes = []any{
-	(*SysLogs)(nil),                // 0: api.admin.v1.SysLogs
-	(*SysLogsDetail)(nil),          // 1: api.admin.v1.SysLogsDetail
-	(*ListLogsRequest)(nil),        // 2: api.admin.v1.ListLogsRequest
-	(*ListLogsReply)(nil),          // 3: api.admin.v1.ListLogsReply
-	(*FindLogsRequest)(nil),        // 4: api.admin.v1.FindLogsRequest
-	(*FindLogsReply)(nil),          // 5: api.admin.v1.FindLogsReply
-	(*CleanLogsRequest)(nil),       // 6: api.admin.v1.CleanLogsRequest
-	(*CleanLogsReply)(nil),         // 7: api.admin.v1.CleanLogsReply
-	(*DeleteLogsByIdsRequest)(nil), // 8: api.admin.v1.DeleteLogsByIdsRequest
-	(*DeleteLogsByIdsReply)(nil),   // 9: api.admin.v1.DeleteLogsByIdsReply
+	(*SysLogs)(nil),                   // 0: api.admin.v1.SysLogs
+	(*SysLogsDetail)(nil),             // 1: api.admin.v1.SysLogsDetail
+	(*ListLogsRequest)(nil),           // 2: api.admin.v1.ListLogsRequest
+	(*ListLogsReply)(nil),             // 3: api.admin.v1.ListLogsReply
+	(*FindLogsRequest)(nil),           // 4: api.admin.v1.FindLogsRequest
+	(*FindLogsReply)(nil),             // 5: api.admin.v1.FindLogsReply
+	(*CleanLogsRequest)(nil),          // 6: api.admin.v1.CleanLogsRequest
+	(*CleanLogsReply)(nil),            // 7: api.admin.v1.CleanLogsReply
+	(*DeleteLogsByIdsRequest)(nil),    // 8: api.admin.v1.DeleteLogsByIdsRequest
+	(*DeleteLogsByIdsReply)(nil),      // 9: api.admin.v1.DeleteLogsByIdsReply
+	(*LogChainProblem)(nil),           // 10: api.admin.v1.LogChainProblem
+	(*VerifyLogsRequest)(nil),         // 11: api.admin.v1.VerifyLogsRequest
+	(*VerifyLogsReply)(nil),           // 12: api.admin.v1.VerifyLogsReply
+	(*GetLogsWriterStatsRequest)(nil), // 13: api.admin.v1.GetLogsWriterStatsRequest
+	(*GetLogsWriterStatsReply)(nil),   // 14: api.admin.v1.GetLogsWriterStatsReply
+	(*LogCheckpoint)(nil),             // 15: api.admin.v1.LogCheckpoint
+	(*ArchiveLogsRequest)(nil),        // 16: api.admin.v1.ArchiveLogsRequest
+	(*ArchiveLogsReply)(nil),          // 17: api.admin.v1.ArchiveLogsReply
+	(*ListLogCheckpointsRequest)(nil), // 18: api.admin.v1.ListLogCheckpointsRequest
+	(*ListLogCheckpointsReply)(nil),   // 19: api.admin.v1.ListLogCheckpointsReply
+	(*LogFieldChange)(nil),            // 20: api.admin.v1.LogFieldChange
+	(*ListEntityHistoryRequest)(nil),  // 21: api.admin.v1.ListEntityHistoryRequest
+	(*ListEntityHistoryReply)(nil),    // 22: api.admin.v1.ListEntityHistoryReply
+	(*StreamLogsRequest)(nil),         // 23: api.admin.v1.StreamLogsRequest
 }
 var file_logs_proto_depIdxs = []int32{
-	1, // 0: api.admin.v1.ListLogsReply.list:type_name -> api.admin.v1.SysLogsDetail
-	0, // 1: api.admin.v1.FindLogsReply.data:type_name -> api.admin.v1.SysLogs
-	2, // 2: api.admin.v1.LogsService.ListLogs:input_type -> api.admin.v1.ListLogsRequest
-	4, // 3: api.admin.v1.LogsService.FindLogs:input_type -> api.admin.v1.FindLogsRequest
-	6, // 4: api.admin.v1.LogsService.CleanLogs:input_type -> api.admin.v1.CleanLogsRequest
-	8, // 5: api.admin.v1.LogsService.DeleteLogsByIds:input_type -> api.admin.v1.DeleteLogsByIdsRequest
-	3, // 6: api.admin.v1.LogsService.ListLogs:output_type -> api.admin.v1.ListLogsReply
-	5, // 7: api.admin.v1.LogsService.FindLogs:output_type -> api.admin.v1.FindLogsReply
-	7, // 8: api.admin.v1.LogsService.CleanLogs:output_type -> api.admin.v1.CleanLogsReply
-	9, // 9: api.admin.v1.LogsService.DeleteLogsByIds:output_type -> api.admin.v1.DeleteLogsByIdsReply
-	6, // [6:10] is the sub-list for method output_type
-	2, // [2:6] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	20, // 0: api.admin.v1.SysLogs.changes:type_name -> api.admin.v1.LogFieldChange
+	1,  // 1: api.admin.v1.ListLogsReply.list:type_name -> api.admin.v1.SysLogsDetail
+	0,  // 2: api.admin.v1.FindLogsReply.data:type_name -> api.admin.v1.SysLogs
+	10, // 3: api.admin.v1.VerifyLogsReply.problems:type_name -> api.admin.v1.LogChainProblem
+	15, // 4: api.admin.v1.ArchiveLogsReply.checkpoint:type_name -> api.admin.v1.LogCheckpoint
+	15, // 5: api.admin.v1.ListLogCheckpointsReply.list:type_name -> api.admin.v1.LogCheckpoint
+	20, // 6: api.admin.v1.ListEntityHistoryReply.list:type_name -> api.admin.v1.LogFieldChange
+	2,  // 7: api.admin.v1.LogsService.ListLogs:input_type -> api.admin.v1.ListLogsRequest
+	4,  // 8: api.admin.v1.LogsService.FindLogs:input_type -> api.admin.v1.FindLogsRequest
+	6,  // 9: api.admin.v1.LogsService.CleanLogs:input_type -> api.admin.v1.CleanLogsRequest
+	8,  // 10: api.admin.v1.LogsService.DeleteLogsByIds:input_type -> api.admin.v1.DeleteLogsByIdsRequest
+	11, // 11: api.admin.v1.LogsService.VerifyLogs:input_type -> api.admin.v1.VerifyLogsRequest
+	16, // 12: api.admin.v1.LogsService.ArchiveLogs:input_type -> api.admin.v1.ArchiveLogsRequest
+	18, // 13: api.admin.v1.LogsService.ListLogCheckpoints:input_type -> api.admin.v1.ListLogCheckpointsRequest
+	13, // 14: api.admin.v1.LogsService.GetLogsWriterStats:input_type -> api.admin.v1.GetLogsWriterStatsRequest
+	21, // 15: api.admin.v1.LogsService.ListEntityHistory:input_type -> api.admin.v1.ListEntityHistoryRequest
+	3,  // 16: api.admin.v1.LogsService.ListLogs:output_type -> api.admin.v1.ListLogsReply
+	5,  // 17: api.admin.v1.LogsService.FindLogs:output_type -> api.admin.v1.FindLogsReply
+	7,  // 18: api.admin.v1.LogsService.CleanLogs:output_type -> api.admin.v1.CleanLogsReply
+	9,  // 19: api.admin.v1.LogsService.DeleteLogsByIds:output_type -> api.admin.v1.DeleteLogsByIdsReply
+	12, // 20: api.admin.v1.LogsService.VerifyLogs:output_type -> api.admin.v1.VerifyLogsReply
+	17, // 21: api.admin.v1.LogsService.ArchiveLogs:output_type -> api.admin.v1.ArchiveLogsReply
+	19, // 22: api.admin.v1.LogsService.ListLogCheckpoints:output_type -> api.admin.v1.ListLogCheckpointsReply
+	14, // 23: api.admin.v1.LogsService.GetLogsWriterStats:output_type -> api.admin.v1.GetLogsWriterStatsReply
+	22, // 24: api.admin.v1.LogsService.ListEntityHistory:output_type -> api.admin.v1.ListEntityHistoryReply
+	16, // [16:25] is the sub-list for method output_type
+	7,  // [7:16] is the sub-list for method input_type
+	7,  // [7:7] is the sub-list for extension type_name
+	7,  // [7:7] is the sub-list for extension extendee
+	0,  // [0:7] is the sub-list for field type_name
 }
 
 func init() { file_logs_proto_init() }
@@ -864,7 +2034,7 @@ func file_logs_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_logs_proto_rawDesc), len(file_logs_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   10,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
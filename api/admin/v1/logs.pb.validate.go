@@ -82,6 +82,40 @@ func (m *SysLogs) validate(all bool) error {
 
 	// no validation rules for Remark
 
+	for idx, item := range m.GetChanges() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SysLogsValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SysLogsValidationError{
+						field:  fmt.Sprintf("Changes[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SysLogsValidationError{
+					field:  fmt.Sprintf("Changes[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
 		return SysLogsMultiError(errors)
 	}
@@ -207,6 +241,22 @@ func (m *SysLogsDetail) validate(all bool) error {
 
 	// no validation rules for UserId
 
+	// no validation rules for Username
+
+	// no validation rules for Nickname
+
+	// no validation rules for Operation
+
+	// no validation rules for Reason
+
+	// no validation rules for ErrorCode
+
+	// no validation rules for TraceId
+
+	// no validation rules for Seq
+
+	// no validation rules for Hash
+
 	if len(errors) > 0 {
 		return SysLogsDetailMultiError(errors)
 	}
@@ -327,6 +377,18 @@ func (m *ListLogsRequest) validate(all bool) error {
 
 	// no validation rules for EndTime
 
+	// no validation rules for SortField
+
+	// no validation rules for SortOrder
+
+	// no validation rules for Path
+
+	// no validation rules for Reason
+
+	// no validation rules for TraceId
+
+	// no validation rules for Failed
+
 	if len(errors) > 0 {
 		return ListLogsRequestMultiError(errors)
 	}
@@ -1183,3 +1245,1689 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DeleteLogsByIdsReplyValidationError{}
+
+// Validate checks the field values on LogChainProblem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *LogChainProblem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogChainProblem with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// LogChainProblemMultiError, or nil if none found.
+func (m *LogChainProblem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogChainProblem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Seq
+
+	// no validation rules for Id
+
+	// no validation rules for Kind
+
+	// no validation rules for Message
+
+	if len(errors) > 0 {
+		return LogChainProblemMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogChainProblemMultiError is an error wrapping multiple validation errors
+// returned by LogChainProblem.ValidateAll() if the designated constraints
+// aren't met.
+type LogChainProblemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogChainProblemMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogChainProblemMultiError) AllErrors() []error { return m }
+
+// LogChainProblemValidationError is the validation error returned by
+// LogChainProblem.Validate if the designated constraints aren't met.
+type LogChainProblemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogChainProblemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogChainProblemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogChainProblemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogChainProblemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogChainProblemValidationError) ErrorName() string { return "LogChainProblemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogChainProblemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogChainProblem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogChainProblemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogChainProblemValidationError{}
+
+// Validate checks the field values on VerifyLogsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifyLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyLogsRequestMultiError, or nil if none found.
+func (m *VerifyLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return VerifyLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyLogsRequestMultiError is an error wrapping multiple validation errors
+// returned by VerifyLogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyLogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyLogsRequestMultiError) AllErrors() []error { return m }
+
+// VerifyLogsRequestValidationError is the validation error returned by
+// VerifyLogsRequest.Validate if the designated constraints aren't met.
+type VerifyLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyLogsRequestValidationError) ErrorName() string {
+	return "VerifyLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e VerifyLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyLogsRequestValidationError{}
+
+// Validate checks the field values on VerifyLogsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *VerifyLogsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on VerifyLogsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// VerifyLogsReplyMultiError, or nil if none found.
+func (m *VerifyLogsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *VerifyLogsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Valid
+
+	// no validation rules for Checked
+
+	// no validation rules for LastSeq
+
+	// no validation rules for Checkpoints
+
+	for idx, item := range m.GetProblems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, VerifyLogsReplyValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, VerifyLogsReplyValidationError{
+						field:  fmt.Sprintf("Problems[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return VerifyLogsReplyValidationError{
+					field:  fmt.Sprintf("Problems[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return VerifyLogsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// VerifyLogsReplyMultiError is an error wrapping multiple validation errors
+// returned by VerifyLogsReply.ValidateAll() if the designated constraints
+// aren't met.
+type VerifyLogsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m VerifyLogsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m VerifyLogsReplyMultiError) AllErrors() []error { return m }
+
+// VerifyLogsReplyValidationError is the validation error returned by
+// VerifyLogsReply.Validate if the designated constraints aren't met.
+type VerifyLogsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e VerifyLogsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e VerifyLogsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e VerifyLogsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e VerifyLogsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e VerifyLogsReplyValidationError) ErrorName() string { return "VerifyLogsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e VerifyLogsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sVerifyLogsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = VerifyLogsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = VerifyLogsReplyValidationError{}
+
+// Validate checks the field values on GetLogsWriterStatsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetLogsWriterStatsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLogsWriterStatsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLogsWriterStatsRequestMultiError, or nil if none found.
+func (m *GetLogsWriterStatsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLogsWriterStatsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return GetLogsWriterStatsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLogsWriterStatsRequestMultiError is an error wrapping multiple validation
+// errors returned by GetLogsWriterStatsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type GetLogsWriterStatsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLogsWriterStatsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLogsWriterStatsRequestMultiError) AllErrors() []error { return m }
+
+// GetLogsWriterStatsRequestValidationError is the validation error returned by
+// GetLogsWriterStatsRequest.Validate if the designated constraints aren't met.
+type GetLogsWriterStatsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLogsWriterStatsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLogsWriterStatsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLogsWriterStatsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLogsWriterStatsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLogsWriterStatsRequestValidationError) ErrorName() string {
+	return "GetLogsWriterStatsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLogsWriterStatsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLogsWriterStatsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLogsWriterStatsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLogsWriterStatsRequestValidationError{}
+
+// Validate checks the field values on GetLogsWriterStatsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *GetLogsWriterStatsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetLogsWriterStatsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetLogsWriterStatsReplyMultiError, or nil if none found.
+func (m *GetLogsWriterStatsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetLogsWriterStatsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Queued
+
+	// no validation rules for Capacity
+
+	// no validation rules for Written
+
+	// no validation rules for Failed
+
+	// no validation rules for Dropped
+
+	// no validation rules for FlushFailures
+
+	// no validation rules for PublishFailures
+
+	if len(errors) > 0 {
+		return GetLogsWriterStatsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetLogsWriterStatsReplyMultiError is an error wrapping multiple validation
+// errors returned by GetLogsWriterStatsReply.ValidateAll() if the designated
+// constraints aren't met.
+type GetLogsWriterStatsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetLogsWriterStatsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetLogsWriterStatsReplyMultiError) AllErrors() []error { return m }
+
+// GetLogsWriterStatsReplyValidationError is the validation error returned by
+// GetLogsWriterStatsReply.Validate if the designated constraints aren't met.
+type GetLogsWriterStatsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetLogsWriterStatsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetLogsWriterStatsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetLogsWriterStatsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetLogsWriterStatsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetLogsWriterStatsReplyValidationError) ErrorName() string {
+	return "GetLogsWriterStatsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetLogsWriterStatsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetLogsWriterStatsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetLogsWriterStatsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetLogsWriterStatsReplyValidationError{}
+
+// Validate checks the field values on LogCheckpoint with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogCheckpoint) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogCheckpoint with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogCheckpointMultiError, or
+// nil if none found.
+func (m *LogCheckpoint) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogCheckpoint) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for StartSeq
+
+	// no validation rules for EndSeq
+
+	// no validation rules for PrevHash
+
+	// no validation rules for EndHash
+
+	// no validation rules for Count
+
+	// no validation rules for EndTime
+
+	// no validation rules for Signature
+
+	// no validation rules for CreateBy
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return LogCheckpointMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogCheckpointMultiError is an error wrapping multiple validation errors
+// returned by LogCheckpoint.ValidateAll() if the designated constraints
+// aren't met.
+type LogCheckpointMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogCheckpointMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogCheckpointMultiError) AllErrors() []error { return m }
+
+// LogCheckpointValidationError is the validation error returned by
+// LogCheckpoint.Validate if the designated constraints aren't met.
+type LogCheckpointValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogCheckpointValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogCheckpointValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogCheckpointValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogCheckpointValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogCheckpointValidationError) ErrorName() string { return "LogCheckpointValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogCheckpointValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogCheckpoint.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogCheckpointValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogCheckpointValidationError{}
+
+// Validate checks the field values on ArchiveLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ArchiveLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchiveLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchiveLogsRequestMultiError, or nil if none found.
+func (m *ArchiveLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchiveLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if utf8.RuneCountInString(m.GetEndTime()) < 1 {
+		err := ArchiveLogsRequestValidationError{
+			field:  "EndTime",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ArchiveLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArchiveLogsRequestMultiError is an error wrapping multiple validation errors
+// returned by ArchiveLogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ArchiveLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchiveLogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchiveLogsRequestMultiError) AllErrors() []error { return m }
+
+// ArchiveLogsRequestValidationError is the validation error returned by
+// ArchiveLogsRequest.Validate if the designated constraints aren't met.
+type ArchiveLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchiveLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchiveLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchiveLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchiveLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchiveLogsRequestValidationError) ErrorName() string {
+	return "ArchiveLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ArchiveLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchiveLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchiveLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchiveLogsRequestValidationError{}
+
+// Validate checks the field values on ArchiveLogsReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ArchiveLogsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ArchiveLogsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ArchiveLogsReplyMultiError, or nil if none found.
+func (m *ArchiveLogsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ArchiveLogsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetCheckpoint()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ArchiveLogsReplyValidationError{
+					field:  "Checkpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ArchiveLogsReplyValidationError{
+					field:  "Checkpoint",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCheckpoint()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ArchiveLogsReplyValidationError{
+				field:  "Checkpoint",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ArchiveLogsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ArchiveLogsReplyMultiError is an error wrapping multiple validation errors
+// returned by ArchiveLogsReply.ValidateAll() if the designated constraints
+// aren't met.
+type ArchiveLogsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ArchiveLogsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ArchiveLogsReplyMultiError) AllErrors() []error { return m }
+
+// ArchiveLogsReplyValidationError is the validation error returned by
+// ArchiveLogsReply.Validate if the designated constraints aren't met.
+type ArchiveLogsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ArchiveLogsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ArchiveLogsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ArchiveLogsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ArchiveLogsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ArchiveLogsReplyValidationError) ErrorName() string { return "ArchiveLogsReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ArchiveLogsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sArchiveLogsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ArchiveLogsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ArchiveLogsReplyValidationError{}
+
+// Validate checks the field values on ListLogCheckpointsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListLogCheckpointsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLogCheckpointsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLogCheckpointsRequestMultiError, or nil if none found.
+func (m *ListLogCheckpointsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLogCheckpointsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ListLogCheckpointsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLogCheckpointsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListLogCheckpointsRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ListLogCheckpointsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLogCheckpointsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLogCheckpointsRequestMultiError) AllErrors() []error { return m }
+
+// ListLogCheckpointsRequestValidationError is the validation error returned by
+// ListLogCheckpointsRequest.Validate if the designated constraints aren't met.
+type ListLogCheckpointsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLogCheckpointsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLogCheckpointsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLogCheckpointsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLogCheckpointsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLogCheckpointsRequestValidationError) ErrorName() string {
+	return "ListLogCheckpointsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLogCheckpointsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLogCheckpointsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLogCheckpointsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLogCheckpointsRequestValidationError{}
+
+// Validate checks the field values on ListLogCheckpointsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListLogCheckpointsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListLogCheckpointsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListLogCheckpointsReplyMultiError, or nil if none found.
+func (m *ListLogCheckpointsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListLogCheckpointsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListLogCheckpointsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListLogCheckpointsReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListLogCheckpointsReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListLogCheckpointsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListLogCheckpointsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListLogCheckpointsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListLogCheckpointsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListLogCheckpointsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListLogCheckpointsReplyMultiError) AllErrors() []error { return m }
+
+// ListLogCheckpointsReplyValidationError is the validation error returned by
+// ListLogCheckpointsReply.Validate if the designated constraints aren't met.
+type ListLogCheckpointsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListLogCheckpointsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListLogCheckpointsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListLogCheckpointsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListLogCheckpointsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListLogCheckpointsReplyValidationError) ErrorName() string {
+	return "ListLogCheckpointsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListLogCheckpointsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListLogCheckpointsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListLogCheckpointsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListLogCheckpointsReplyValidationError{}
+
+// Validate checks the field values on LogFieldChange with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *LogFieldChange) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on LogFieldChange with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in LogFieldChangeMultiError,
+// or nil if none found.
+func (m *LogFieldChange) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *LogFieldChange) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for LogId
+
+	// no validation rules for Entity
+
+	// no validation rules for EntityId
+
+	// no validation rules for Field
+
+	// no validation rules for OldValue
+
+	// no validation rules for NewValue
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for Nickname
+
+	// no validation rules for Operation
+
+	// no validation rules for CreatedAt
+
+	if len(errors) > 0 {
+		return LogFieldChangeMultiError(errors)
+	}
+
+	return nil
+}
+
+// LogFieldChangeMultiError is an error wrapping multiple validation errors
+// returned by LogFieldChange.ValidateAll() if the designated constraints
+// aren't met.
+type LogFieldChangeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m LogFieldChangeMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m LogFieldChangeMultiError) AllErrors() []error { return m }
+
+// LogFieldChangeValidationError is the validation error returned by
+// LogFieldChange.Validate if the designated constraints aren't met.
+type LogFieldChangeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e LogFieldChangeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e LogFieldChangeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e LogFieldChangeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e LogFieldChangeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e LogFieldChangeValidationError) ErrorName() string { return "LogFieldChangeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e LogFieldChangeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sLogFieldChange.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = LogFieldChangeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = LogFieldChangeValidationError{}
+
+// Validate checks the field values on ListEntityHistoryRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListEntityHistoryRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEntityHistoryRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEntityHistoryRequestMultiError, or nil if none found.
+func (m *ListEntityHistoryRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEntityHistoryRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ListEntityHistoryRequest_Entity_InLookup[m.GetEntity()]; !ok {
+		err := ListEntityHistoryRequestValidationError{
+			field:  "Entity",
+			reason: "value must be in list [user role dept menu dict_type dict_data]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetEntityId() <= 0 {
+		err := ListEntityHistoryRequestValidationError{
+			field:  "EntityId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	if len(errors) > 0 {
+		return ListEntityHistoryRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEntityHistoryRequestMultiError is an error wrapping multiple validation
+// errors returned by ListEntityHistoryRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListEntityHistoryRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEntityHistoryRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEntityHistoryRequestMultiError) AllErrors() []error { return m }
+
+// ListEntityHistoryRequestValidationError is the validation error returned by
+// ListEntityHistoryRequest.Validate if the designated constraints aren't met.
+type ListEntityHistoryRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEntityHistoryRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEntityHistoryRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEntityHistoryRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEntityHistoryRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEntityHistoryRequestValidationError) ErrorName() string {
+	return "ListEntityHistoryRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEntityHistoryRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEntityHistoryRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEntityHistoryRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEntityHistoryRequestValidationError{}
+
+var _ListEntityHistoryRequest_Entity_InLookup = map[string]struct{}{
+	"user":      {},
+	"role":      {},
+	"dept":      {},
+	"menu":      {},
+	"dict_type": {},
+	"dict_data": {},
+}
+
+// Validate checks the field values on ListEntityHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListEntityHistoryReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListEntityHistoryReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListEntityHistoryReplyMultiError, or nil if none found.
+func (m *ListEntityHistoryReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListEntityHistoryReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	for idx, item := range m.GetList() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListEntityHistoryReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListEntityHistoryReplyValidationError{
+						field:  fmt.Sprintf("List[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListEntityHistoryReplyValidationError{
+					field:  fmt.Sprintf("List[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListEntityHistoryReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListEntityHistoryReplyMultiError is an error wrapping multiple validation
+// errors returned by ListEntityHistoryReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListEntityHistoryReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListEntityHistoryReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListEntityHistoryReplyMultiError) AllErrors() []error { return m }
+
+// ListEntityHistoryReplyValidationError is the validation error returned by
+// ListEntityHistoryReply.Validate if the designated constraints aren't met.
+type ListEntityHistoryReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListEntityHistoryReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListEntityHistoryReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListEntityHistoryReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListEntityHistoryReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListEntityHistoryReplyValidationError) ErrorName() string {
+	return "ListEntityHistoryReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListEntityHistoryReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListEntityHistoryReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListEntityHistoryReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListEntityHistoryReplyValidationError{}
+
+// Validate checks the field values on StreamLogsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StreamLogsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StreamLogsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StreamLogsRequestMultiError, or nil if none found.
+func (m *StreamLogsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StreamLogsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for Path
+
+	// no validation rules for Failed
+
+	if len(errors) > 0 {
+		return StreamLogsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StreamLogsRequestMultiError is an error wrapping multiple validation errors
+// returned by StreamLogsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StreamLogsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StreamLogsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StreamLogsRequestMultiError) AllErrors() []error { return m }
+
+// StreamLogsRequestValidationError is the validation error returned by
+// StreamLogsRequest.Validate if the designated constraints aren't met.
+type StreamLogsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StreamLogsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StreamLogsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StreamLogsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StreamLogsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StreamLogsRequestValidationError) ErrorName() string {
+	return "StreamLogsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e StreamLogsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStreamLogsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StreamLogsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StreamLogsRequestValidationError{}
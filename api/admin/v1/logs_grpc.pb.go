@@ -19,10 +19,15 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	LogsService_ListLogs_FullMethodName        = "/api.admin.v1.LogsService/ListLogs"
-	LogsService_FindLogs_FullMethodName        = "/api.admin.v1.LogsService/FindLogs"
-	LogsService_CleanLogs_FullMethodName       = "/api.admin.v1.LogsService/CleanLogs"
-	LogsService_DeleteLogsByIds_FullMethodName = "/api.admin.v1.LogsService/DeleteLogsByIds"
+	LogsService_ListLogs_FullMethodName           = "/api.admin.v1.LogsService/ListLogs"
+	LogsService_FindLogs_FullMethodName           = "/api.admin.v1.LogsService/FindLogs"
+	LogsService_CleanLogs_FullMethodName          = "/api.admin.v1.LogsService/CleanLogs"
+	LogsService_DeleteLogsByIds_FullMethodName    = "/api.admin.v1.LogsService/DeleteLogsByIds"
+	LogsService_VerifyLogs_FullMethodName         = "/api.admin.v1.LogsService/VerifyLogs"
+	LogsService_ArchiveLogs_FullMethodName        = "/api.admin.v1.LogsService/ArchiveLogs"
+	LogsService_ListLogCheckpoints_FullMethodName = "/api.admin.v1.LogsService/ListLogCheckpoints"
+	LogsService_GetLogsWriterStats_FullMethodName = "/api.admin.v1.LogsService/GetLogsWriterStats"
+	LogsService_ListEntityHistory_FullMethodName  = "/api.admin.v1.LogsService/ListEntityHistory"
 )
 
 // LogsServiceClient is the client API for LogsService service.
@@ -33,6 +38,16 @@ type LogsServiceClient interface {
 	FindLogs(ctx context.Context, in *FindLogsRequest, opts ...grpc.CallOption) (*FindLogsReply, error)
 	CleanLogs(ctx context.Context, in *CleanLogsRequest, opts ...grpc.CallOption) (*CleanLogsReply, error)
 	DeleteLogsByIds(ctx context.Context, in *DeleteLogsByIdsRequest, opts ...grpc.CallOption) (*DeleteLogsByIdsReply, error)
+	// 校验审计哈希链，发现删除或修改的记录
+	VerifyLogs(ctx context.Context, in *VerifyLogsRequest, opts ...grpc.CallOption) (*VerifyLogsReply, error)
+	// 归档截止时间之前的记录，生成签名检查点后删除
+	ArchiveLogs(ctx context.Context, in *ArchiveLogsRequest, opts ...grpc.CallOption) (*ArchiveLogsReply, error)
+	// 归档检查点列表
+	ListLogCheckpoints(ctx context.Context, in *ListLogCheckpointsRequest, opts ...grpc.CallOption) (*ListLogCheckpointsReply, error)
+	// 操作记录异步写入的统计，用于监控积压、丢弃和写入失败
+	GetLogsWriterStats(ctx context.Context, in *GetLogsWriterStatsRequest, opts ...grpc.CallOption) (*GetLogsWriterStatsReply, error)
+	// 实体的字段变更历史，entity 可选 user、role、dept、menu、dict_type、dict_data
+	ListEntityHistory(ctx context.Context, in *ListEntityHistoryRequest, opts ...grpc.CallOption) (*ListEntityHistoryReply, error)
 }
 
 type logsServiceClient struct {
@@ -83,6 +98,56 @@ func (c *logsServiceClient) DeleteLogsByIds(ctx context.Context, in *DeleteLogsB
 	return out, nil
 }
 
+func (c *logsServiceClient) VerifyLogs(ctx context.Context, in *VerifyLogsRequest, opts ...grpc.CallOption) (*VerifyLogsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(VerifyLogsReply)
+	err := c.cc.Invoke(ctx, LogsService_VerifyLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logsServiceClient) ArchiveLogs(ctx context.Context, in *ArchiveLogsRequest, opts ...grpc.CallOption) (*ArchiveLogsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveLogsReply)
+	err := c.cc.Invoke(ctx, LogsService_ArchiveLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logsServiceClient) ListLogCheckpoints(ctx context.Context, in *ListLogCheckpointsRequest, opts ...grpc.CallOption) (*ListLogCheckpointsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListLogCheckpointsReply)
+	err := c.cc.Invoke(ctx, LogsService_ListLogCheckpoints_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logsServiceClient) GetLogsWriterStats(ctx context.Context, in *GetLogsWriterStatsRequest, opts ...grpc.CallOption) (*GetLogsWriterStatsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetLogsWriterStatsReply)
+	err := c.cc.Invoke(ctx, LogsService_GetLogsWriterStats_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *logsServiceClient) ListEntityHistory(ctx context.Context, in *ListEntityHistoryRequest, opts ...grpc.CallOption) (*ListEntityHistoryReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListEntityHistoryReply)
+	err := c.cc.Invoke(ctx, LogsService_ListEntityHistory_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LogsServiceServer is the server API for LogsService service.
 // All implementations must embed UnimplementedLogsServiceServer
 // for forward compatibility.
@@ -91,6 +156,16 @@ type LogsServiceServer interface {
 	FindLogs(context.Context, *FindLogsRequest) (*FindLogsReply, error)
 	CleanLogs(context.Context, *CleanLogsRequest) (*CleanLogsReply, error)
 	DeleteLogsByIds(context.Context, *DeleteLogsByIdsRequest) (*DeleteLogsByIdsReply, error)
+	// 校验审计哈希链，发现删除或修改的记录
+	VerifyLogs(context.Context, *VerifyLogsRequest) (*VerifyLogsReply, error)
+	// 归档截止时间之前的记录，生成签名检查点后删除
+	ArchiveLogs(context.Context, *ArchiveLogsRequest) (*ArchiveLogsReply, error)
+	// 归档检查点列表
+	ListLogCheckpoints(context.Context, *ListLogCheckpointsRequest) (*ListLogCheckpointsReply, error)
+	// 操作记录异步写入的统计，用于监控积压、丢弃和写入失败
+	GetLogsWriterStats(context.Context, *GetLogsWriterStatsRequest) (*GetLogsWriterStatsReply, error)
+	// 实体的字段变更历史，entity 可选 user、role、dept、menu、dict_type、dict_data
+	ListEntityHistory(context.Context, *ListEntityHistoryRequest) (*ListEntityHistoryReply, error)
 	mustEmbedUnimplementedLogsServiceServer()
 }
 
@@ -113,6 +188,21 @@ func (UnimplementedLogsServiceServer) CleanLogs(context.Context, *CleanLogsReque
 func (UnimplementedLogsServiceServer) DeleteLogsByIds(context.Context, *DeleteLogsByIdsRequest) (*DeleteLogsByIdsReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteLogsByIds not implemented")
 }
+func (UnimplementedLogsServiceServer) VerifyLogs(context.Context, *VerifyLogsRequest) (*VerifyLogsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method VerifyLogs not implemented")
+}
+func (UnimplementedLogsServiceServer) ArchiveLogs(context.Context, *ArchiveLogsRequest) (*ArchiveLogsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ArchiveLogs not implemented")
+}
+func (UnimplementedLogsServiceServer) ListLogCheckpoints(context.Context, *ListLogCheckpointsRequest) (*ListLogCheckpointsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListLogCheckpoints not implemented")
+}
+func (UnimplementedLogsServiceServer) GetLogsWriterStats(context.Context, *GetLogsWriterStatsRequest) (*GetLogsWriterStatsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method GetLogsWriterStats not implemented")
+}
+func (UnimplementedLogsServiceServer) ListEntityHistory(context.Context, *ListEntityHistoryRequest) (*ListEntityHistoryReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListEntityHistory not implemented")
+}
 func (UnimplementedLogsServiceServer) mustEmbedUnimplementedLogsServiceServer() {}
 func (UnimplementedLogsServiceServer) testEmbeddedByValue()                     {}
 
@@ -206,6 +296,96 @@ func _LogsService_DeleteLogsByIds_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _LogsService_VerifyLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServiceServer).VerifyLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogsService_VerifyLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServiceServer).VerifyLogs(ctx, req.(*VerifyLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogsService_ArchiveLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServiceServer).ArchiveLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogsService_ArchiveLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServiceServer).ArchiveLogs(ctx, req.(*ArchiveLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogsService_ListLogCheckpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListLogCheckpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServiceServer).ListLogCheckpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogsService_ListLogCheckpoints_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServiceServer).ListLogCheckpoints(ctx, req.(*ListLogCheckpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogsService_GetLogsWriterStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLogsWriterStatsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServiceServer).GetLogsWriterStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogsService_GetLogsWriterStats_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServiceServer).GetLogsWriterStats(ctx, req.(*GetLogsWriterStatsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _LogsService_ListEntityHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntityHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LogsServiceServer).ListEntityHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: LogsService_ListEntityHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LogsServiceServer).ListEntityHistory(ctx, req.(*ListEntityHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // LogsService_ServiceDesc is the grpc.ServiceDesc for LogsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -229,6 +409,26 @@ var LogsService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteLogsByIds",
 			Handler:    _LogsService_DeleteLogsByIds_Handler,
 		},
+		{
+			MethodName: "VerifyLogs",
+			Handler:    _LogsService_VerifyLogs_Handler,
+		},
+		{
+			MethodName: "ArchiveLogs",
+			Handler:    _LogsService_ArchiveLogs_Handler,
+		},
+		{
+			MethodName: "ListLogCheckpoints",
+			Handler:    _LogsService_ListLogCheckpoints_Handler,
+		},
+		{
+			MethodName: "GetLogsWriterStats",
+			Handler:    _LogsService_GetLogsWriterStats_Handler,
+		},
+		{
+			MethodName: "ListEntityHistory",
+			Handler:    _LogsService_ListEntityHistory_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "logs.proto",
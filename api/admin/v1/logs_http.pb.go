@@ -19,16 +19,31 @@ var _ = binding.EncodeURL
 
 const _ = http.SupportPackageIsVersion1
 
+const OperationLogsServiceArchiveLogs = "/api.admin.v1.LogsService/ArchiveLogs"
 const OperationLogsServiceCleanLogs = "/api.admin.v1.LogsService/CleanLogs"
 const OperationLogsServiceDeleteLogsByIds = "/api.admin.v1.LogsService/DeleteLogsByIds"
 const OperationLogsServiceFindLogs = "/api.admin.v1.LogsService/FindLogs"
+const OperationLogsServiceGetLogsWriterStats = "/api.admin.v1.LogsService/GetLogsWriterStats"
+const OperationLogsServiceListEntityHistory = "/api.admin.v1.LogsService/ListEntityHistory"
+const OperationLogsServiceListLogCheckpoints = "/api.admin.v1.LogsService/ListLogCheckpoints"
 const OperationLogsServiceListLogs = "/api.admin.v1.LogsService/ListLogs"
+const OperationLogsServiceVerifyLogs = "/api.admin.v1.LogsService/VerifyLogs"
 
 type LogsServiceHTTPServer interface {
+	// ArchiveLogs 归档截止时间之前的记录，生成签名检查点后删除
+	ArchiveLogs(context.Context, *ArchiveLogsRequest) (*ArchiveLogsReply, error)
 	CleanLogs(context.Context, *CleanLogsRequest) (*CleanLogsReply, error)
 	DeleteLogsByIds(context.Context, *DeleteLogsByIdsRequest) (*DeleteLogsByIdsReply, error)
 	FindLogs(context.Context, *FindLogsRequest) (*FindLogsReply, error)
+	// GetLogsWriterStats 操作记录异步写入的统计，用于监控积压、丢弃和写入失败
+	GetLogsWriterStats(context.Context, *GetLogsWriterStatsRequest) (*GetLogsWriterStatsReply, error)
+	// ListEntityHistory 实体的字段变更历史，entity 可选 user、role、dept、menu、dict_type、dict_data
+	ListEntityHistory(context.Context, *ListEntityHistoryRequest) (*ListEntityHistoryReply, error)
+	// ListLogCheckpoints 归档检查点列表
+	ListLogCheckpoints(context.Context, *ListLogCheckpointsRequest) (*ListLogCheckpointsReply, error)
 	ListLogs(context.Context, *ListLogsRequest) (*ListLogsReply, error)
+	// VerifyLogs 校验审计哈希链，发现删除或修改的记录
+	VerifyLogs(context.Context, *VerifyLogsRequest) (*VerifyLogsReply, error)
 }
 
 func RegisterLogsServiceHTTPServer(s *http.Server, srv LogsServiceHTTPServer) {
@@ -37,6 +52,11 @@ func RegisterLogsServiceHTTPServer(s *http.Server, srv LogsServiceHTTPServer) {
 	r.GET("/system/logs/{id}", _LogsService_FindLogs0_HTTP_Handler(srv))
 	r.DELETE("/system/logs/clean", _LogsService_CleanLogs0_HTTP_Handler(srv))
 	r.DELETE("/system/logs/deleteByIds", _LogsService_DeleteLogsByIds0_HTTP_Handler(srv))
+	r.GET("/system/logs/chain/verify", _LogsService_VerifyLogs0_HTTP_Handler(srv))
+	r.POST("/system/logs/chain/archive", _LogsService_ArchiveLogs0_HTTP_Handler(srv))
+	r.GET("/system/logs/chain/checkpoints", _LogsService_ListLogCheckpoints0_HTTP_Handler(srv))
+	r.GET("/system/logs/writer/stats", _LogsService_GetLogsWriterStats0_HTTP_Handler(srv))
+	r.GET("/system/logs/history/{entity}/{entity_id}", _LogsService_ListEntityHistory0_HTTP_Handler(srv))
 }
 
 func _LogsService_ListLogs0_HTTP_Handler(srv LogsServiceHTTPServer) func(ctx http.Context) error {
@@ -118,11 +138,122 @@ func _LogsService_DeleteLogsByIds0_HTTP_Handler(srv LogsServiceHTTPServer) func(
 	}
 }
 
+func _LogsService_VerifyLogs0_HTTP_Handler(srv LogsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in VerifyLogsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLogsServiceVerifyLogs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.VerifyLogs(ctx, req.(*VerifyLogsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*VerifyLogsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _LogsService_ArchiveLogs0_HTTP_Handler(srv LogsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ArchiveLogsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLogsServiceArchiveLogs)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ArchiveLogs(ctx, req.(*ArchiveLogsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ArchiveLogsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _LogsService_ListLogCheckpoints0_HTTP_Handler(srv LogsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListLogCheckpointsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLogsServiceListLogCheckpoints)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListLogCheckpoints(ctx, req.(*ListLogCheckpointsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListLogCheckpointsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _LogsService_GetLogsWriterStats0_HTTP_Handler(srv LogsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in GetLogsWriterStatsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLogsServiceGetLogsWriterStats)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.GetLogsWriterStats(ctx, req.(*GetLogsWriterStatsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*GetLogsWriterStatsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _LogsService_ListEntityHistory0_HTTP_Handler(srv LogsServiceHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListEntityHistoryRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindVars(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationLogsServiceListEntityHistory)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListEntityHistory(ctx, req.(*ListEntityHistoryRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListEntityHistoryReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type LogsServiceHTTPClient interface {
+	// ArchiveLogs 归档截止时间之前的记录，生成签名检查点后删除
+	ArchiveLogs(ctx context.Context, req *ArchiveLogsRequest, opts ...http.CallOption) (rsp *ArchiveLogsReply, err error)
 	CleanLogs(ctx context.Context, req *CleanLogsRequest, opts ...http.CallOption) (rsp *CleanLogsReply, err error)
 	DeleteLogsByIds(ctx context.Context, req *DeleteLogsByIdsRequest, opts ...http.CallOption) (rsp *DeleteLogsByIdsReply, err error)
 	FindLogs(ctx context.Context, req *FindLogsRequest, opts ...http.CallOption) (rsp *FindLogsReply, err error)
+	// GetLogsWriterStats 操作记录异步写入的统计，用于监控积压、丢弃和写入失败
+	GetLogsWriterStats(ctx context.Context, req *GetLogsWriterStatsRequest, opts ...http.CallOption) (rsp *GetLogsWriterStatsReply, err error)
+	// ListEntityHistory 实体的字段变更历史，entity 可选 user、role、dept、menu、dict_type、dict_data
+	ListEntityHistory(ctx context.Context, req *ListEntityHistoryRequest, opts ...http.CallOption) (rsp *ListEntityHistoryReply, err error)
+	// ListLogCheckpoints 归档检查点列表
+	ListLogCheckpoints(ctx context.Context, req *ListLogCheckpointsRequest, opts ...http.CallOption) (rsp *ListLogCheckpointsReply, err error)
 	ListLogs(ctx context.Context, req *ListLogsRequest, opts ...http.CallOption) (rsp *ListLogsReply, err error)
+	// VerifyLogs 校验审计哈希链，发现删除或修改的记录
+	VerifyLogs(ctx context.Context, req *VerifyLogsRequest, opts ...http.CallOption) (rsp *VerifyLogsReply, err error)
 }
 
 type LogsServiceHTTPClientImpl struct {
@@ -133,6 +264,20 @@ func NewLogsServiceHTTPClient(client *http.Client) LogsServiceHTTPClient {
 	return &LogsServiceHTTPClientImpl{client}
 }
 
+// ArchiveLogs 归档截止时间之前的记录，生成签名检查点后删除
+func (c *LogsServiceHTTPClientImpl) ArchiveLogs(ctx context.Context, in *ArchiveLogsRequest, opts ...http.CallOption) (*ArchiveLogsReply, error) {
+	var out ArchiveLogsReply
+	pattern := "/system/logs/chain/archive"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationLogsServiceArchiveLogs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *LogsServiceHTTPClientImpl) CleanLogs(ctx context.Context, in *CleanLogsRequest, opts ...http.CallOption) (*CleanLogsReply, error) {
 	var out CleanLogsReply
 	pattern := "/system/logs/clean"
@@ -172,6 +317,48 @@ func (c *LogsServiceHTTPClientImpl) FindLogs(ctx context.Context, in *FindLogsRe
 	return &out, nil
 }
 
+// GetLogsWriterStats 操作记录异步写入的统计，用于监控积压、丢弃和写入失败
+func (c *LogsServiceHTTPClientImpl) GetLogsWriterStats(ctx context.Context, in *GetLogsWriterStatsRequest, opts ...http.CallOption) (*GetLogsWriterStatsReply, error) {
+	var out GetLogsWriterStatsReply
+	pattern := "/system/logs/writer/stats"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLogsServiceGetLogsWriterStats))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListEntityHistory 实体的字段变更历史，entity 可选 user、role、dept、menu、dict_type、dict_data
+func (c *LogsServiceHTTPClientImpl) ListEntityHistory(ctx context.Context, in *ListEntityHistoryRequest, opts ...http.CallOption) (*ListEntityHistoryReply, error) {
+	var out ListEntityHistoryReply
+	pattern := "/system/logs/history/{entity}/{entity_id}"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLogsServiceListEntityHistory))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ListLogCheckpoints 归档检查点列表
+func (c *LogsServiceHTTPClientImpl) ListLogCheckpoints(ctx context.Context, in *ListLogCheckpointsRequest, opts ...http.CallOption) (*ListLogCheckpointsReply, error) {
+	var out ListLogCheckpointsReply
+	pattern := "/system/logs/chain/checkpoints"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLogsServiceListLogCheckpoints))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 func (c *LogsServiceHTTPClientImpl) ListLogs(ctx context.Context, in *ListLogsRequest, opts ...http.CallOption) (*ListLogsReply, error) {
 	var out ListLogsReply
 	pattern := "/system/logs/list"
@@ -184,3 +371,17 @@ func (c *LogsServiceHTTPClientImpl) ListLogs(ctx context.Context, in *ListLogsRe
 	}
 	return &out, nil
 }
+
+// VerifyLogs 校验审计哈希链，发现删除或修改的记录
+func (c *LogsServiceHTTPClientImpl) VerifyLogs(ctx context.Context, in *VerifyLogsRequest, opts ...http.CallOption) (*VerifyLogsReply, error) {
+	var out VerifyLogsReply
+	pattern := "/system/logs/chain/verify"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationLogsServiceVerifyLogs))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
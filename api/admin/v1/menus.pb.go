@@ -620,8 +620,10 @@ func (x *UpdateMenusReply) GetPermissions() []string {
 }
 
 type DeleteMenusRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 同时删除全部子菜单并解除角色的菜单和按钮关联，否则存在子菜单或关联时拒绝删除
+	Cascade       bool `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -663,6 +665,13 @@ func (x *DeleteMenusRequest) GetId() int64 {
 	return 0
 }
 
+func (x *DeleteMenusRequest) GetCascade() bool {
+	if x != nil {
+		return x.Cascade
+	}
+	return false
+}
+
 type DeleteMenusReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1184,9 +1193,10 @@ const file_menus_proto_rawDesc = "" +
 	"\x06remark\x18\x13 \x01(\tR\x06remark\"b\n" +
 	"\x10UpdateMenusReply\x12,\n" +
 	"\x05menus\x18\x01 \x03(\v2\x16.api.admin.v1.MenuTreeR\x05menus\x12 \n" +
-	"\vpermissions\x18\x02 \x03(\tR\vpermissions\"$\n" +
+	"\vpermissions\x18\x02 \x03(\tR\vpermissions\">\n" +
 	"\x12DeleteMenusRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"\x12\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x18\n" +
+	"\acascade\x18\x02 \x01(\bR\acascade\"\x12\n" +
 	"\x10DeleteMenusReply\"\"\n" +
 	"\x10FindMenusRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\xf0\x04\n" +
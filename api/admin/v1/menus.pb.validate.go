@@ -974,6 +974,8 @@ func (m *DeleteMenusRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for Cascade
+
 	if len(errors) > 0 {
 		return DeleteMenusRequestMultiError(errors)
 	}
@@ -399,10 +399,12 @@ func (*UpdatePostReply) Descriptor() ([]byte, []int) {
 }
 
 type DeletePostRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 将被删除岗位的用户转移到该岗位，为 0 时存在用户则拒绝删除
+	ReassignPostId int64 `protobuf:"varint,2,opt,name=reassignPostId,proto3" json:"reassignPostId,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DeletePostRequest) Reset() {
@@ -442,6 +444,13 @@ func (x *DeletePostRequest) GetId() string {
 	return ""
 }
 
+func (x *DeletePostRequest) GetReassignPostId() int64 {
+	if x != nil {
+		return x.ReassignPostId
+	}
+	return 0
+}
+
 type DeletePostReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -510,9 +519,10 @@ const file_post_proto_rawDesc = "" +
 	"\x04sort\x18\x05 \x01(\x05R\x04sort\x12\x16\n" +
 	"\x06status\x18\x06 \x01(\x05R\x06status\x12\x16\n" +
 	"\x06remark\x18\a \x01(\tR\x06remark\"\x11\n" +
-	"\x0fUpdatePostReply\"#\n" +
+	"\x0fUpdatePostReply\"K\n" +
 	"\x11DeletePostRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x11\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x0ereassignPostId\x18\x02 \x01(\x03R\x0ereassignPostId\"\x11\n" +
 	"\x0fDeletePostReply2\xa3\x03\n" +
 	"\aSysPost\x12a\n" +
 	"\bListPost\x12\x1d.api.admin.v1.ListPostRequest\x1a\x1b.api.admin.v1.ListPostReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/system/post/list\x12e\n" +
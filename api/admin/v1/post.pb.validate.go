@@ -735,6 +735,8 @@ func (m *DeletePostRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for ReassignPostId
+
 	if len(errors) > 0 {
 		return DeletePostRequestMultiError(errors)
 	}
@@ -7,9 +7,11 @@
 package v1
 
 import (
+	_ "github.com/envoyproxy/protoc-gen-validate/validate"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -335,10 +337,12 @@ func (*UpdateRolesReply) Descriptor() ([]byte, []int) {
 }
 
 type DeleteRolesRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state protoimpl.MessageState `protogen:"open.v1"`
+	Id    string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// 将被删除角色的用户转移到该角色，为 0 时存在用户则拒绝删除
+	ReassignRoleId int64 `protobuf:"varint,2,opt,name=reassignRoleId,proto3" json:"reassignRoleId,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *DeleteRolesRequest) Reset() {
@@ -378,6 +382,13 @@ func (x *DeleteRolesRequest) GetId() string {
 	return ""
 }
 
+func (x *DeleteRolesRequest) GetReassignRoleId() int64 {
+	if x != nil {
+		return x.ReassignRoleId
+	}
+	return 0
+}
+
 type DeleteRolesReply struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -822,71 +833,972 @@ func (*DataScopeReply) Descriptor() ([]byte, []int) {
 	return file_roles_proto_rawDescGZIP(), []int{13}
 }
 
-var File_roles_proto protoreflect.FileDescriptor
+type ExportRolesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 逗号分隔的角色代码，为空时导出全部角色
+	RoleKeys string `protobuf:"bytes,1,opt,name=roleKeys,proto3" json:"roleKeys,omitempty"`
+	// yaml 或 json，默认 yaml
+	Format        string `protobuf:"bytes,2,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_roles_proto_rawDesc = "" +
-	"\n" +
-	"\vroles.proto\x12\fapi.admin.v1\x1a\x1cgoogle/api/annotations.proto\x1a\n" +
-	"base.proto\"\xb7\x02\n" +
-	"\x12CreateRolesRequest\x12\x1a\n" +
-	"\broleName\x18\x01 \x01(\tR\broleName\x12\x18\n" +
-	"\aroleKey\x18\x02 \x01(\tR\aroleKey\x12\x12\n" +
-	"\x04sort\x18\x03 \x01(\x05R\x04sort\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x16\n" +
-	"\x06remark\x18\x05 \x01(\tR\x06remark\x12\x1c\n" +
-	"\tdataScope\x18\x06 \x01(\x05R\tdataScope\x12\x18\n" +
-	"\amenuIds\x18\a \x03(\x03R\amenuIds\x12-\n" +
-	"\x06apiIds\x18\b \x03(\v2\x15.api.admin.v1.ApiBaseR\x06apiIds\x12\x1a\n" +
-	"\bparentId\x18\t \x01(\x03R\bparentId\x12$\n" +
-	"\rdefaultRouter\x18\n" +
-	" \x01(\tR\rdefaultRouter\"\x12\n" +
-	"\x10CreateRolesReply\"\xcf\x02\n" +
-	"\x12UpdateRolesRequest\x12\x1a\n" +
-	"\broleName\x18\x01 \x01(\tR\broleName\x12\x18\n" +
-	"\aroleKey\x18\x02 \x01(\tR\aroleKey\x12\x12\n" +
-	"\x04sort\x18\x03 \x01(\x05R\x04sort\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x16\n" +
-	"\x06remark\x18\x05 \x01(\tR\x06remark\x12\x1c\n" +
-	"\tdataScope\x18\x06 \x01(\x05R\tdataScope\x12\x18\n" +
-	"\amenuIds\x18\a \x03(\x03R\amenuIds\x12-\n" +
-	"\x06apiIds\x18\b \x03(\v2\x15.api.admin.v1.ApiBaseR\x06apiIds\x12\x1a\n" +
-	"\bparentId\x18\t \x01(\x03R\bparentId\x12$\n" +
-	"\rdefaultRouter\x18\n" +
-	" \x01(\tR\rdefaultRouter\x12\x16\n" +
-	"\x06roleId\x18\v \x01(\x03R\x06roleId\"\x12\n" +
-	"\x10UpdateRolesReply\"$\n" +
-	"\x12DeleteRolesRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\tR\x02id\"\x12\n" +
-	"\x10DeleteRolesReply\"\"\n" +
-	"\x10FindRolesRequest\x12\x0e\n" +
-	"\x02id\x18\x01 \x01(\x03R\x02id\"<\n" +
-	"\x0eFindRolesReply\x12*\n" +
-	"\x04role\x18\x01 \x01(\v2\x16.api.admin.v1.RoleDataR\x04role\"\x96\x01\n" +
-	"\x10ListRolesRequest\x12\x18\n" +
-	"\apageNum\x18\x01 \x01(\x05R\apageNum\x12\x1a\n" +
-	"\bpageSize\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
-	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x1a\n" +
-	"\broleName\x18\x04 \x01(\tR\broleName\x12\x18\n" +
-	"\aroleKey\x18\x05 \x01(\tR\aroleKey\"\x88\x01\n" +
-	"\x0eListRolesReply\x12\x14\n" +
-	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
-	"\apageNum\x18\x02 \x01(\x05R\apageNum\x12\x1a\n" +
-	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\x12*\n" +
-	"\x04data\x18\x04 \x03(\v2\x16.api.admin.v1.RoleDataR\x04data\"I\n" +
-	"\x17ChangeRoleStatusRequest\x12\x16\n" +
-	"\x06roleId\x18\x01 \x01(\x03R\x06roleId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\x05R\x06status\"\x17\n" +
-	"\x15ChangeRoleStatusReply\"H\n" +
-	"\x10DataScopeRequest\x12\x16\n" +
-	"\x06roleId\x18\x01 \x01(\x03R\x06roleId\x12\x1c\n" +
-	"\tdataScope\x18\x02 \x01(\x05R\tdataScope\"\x10\n" +
-	"\x0eDataScopeReply2\x88\x06\n" +
+func (x *ExportRolesRequest) Reset() {
+	*x = ExportRolesRequest{}
+	mi := &file_roles_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRolesRequest) ProtoMessage() {}
+
+func (x *ExportRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRolesRequest.ProtoReflect.Descriptor instead.
+func (*ExportRolesRequest) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ExportRolesRequest) GetRoleKeys() string {
+	if x != nil {
+		return x.RoleKeys
+	}
+	return ""
+}
+
+func (x *ExportRolesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportRolesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Format        string                 `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Content       string                 `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportRolesReply) Reset() {
+	*x = ExportRolesReply{}
+	mi := &file_roles_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportRolesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportRolesReply) ProtoMessage() {}
+
+func (x *ExportRolesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportRolesReply.ProtoReflect.Descriptor instead.
+func (*ExportRolesReply) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ExportRolesReply) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ExportRolesReply) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+type ImportRolesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// yaml 或 json，默认 yaml
+	Format  string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// 只返回差异，不写入数据库
+	DryRun        bool `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRolesRequest) Reset() {
+	*x = ImportRolesRequest{}
+	mi := &file_roles_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRolesRequest) ProtoMessage() {}
+
+func (x *ImportRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRolesRequest.ProtoReflect.Descriptor instead.
+func (*ImportRolesRequest) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ImportRolesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportRolesRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ImportRolesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportRolesReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []*RoleImportDiff      `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	Warnings      []string               `protobuf:"bytes,2,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRolesReply) Reset() {
+	*x = ImportRolesReply{}
+	mi := &file_roles_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRolesReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRolesReply) ProtoMessage() {}
+
+func (x *ImportRolesReply) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRolesReply.ProtoReflect.Descriptor instead.
+func (*ImportRolesReply) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ImportRolesReply) GetRoles() []*RoleImportDiff {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+func (x *ImportRolesReply) GetWarnings() []string {
+	if x != nil {
+		return x.Warnings
+	}
+	return nil
+}
+
+// 角色导入差异
+type RoleImportDiff struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	RoleKey string                 `protobuf:"bytes,1,opt,name=roleKey,proto3" json:"roleKey,omitempty"`
+	// create、update、unchanged
+	Action        string     `protobuf:"bytes,2,opt,name=action,proto3" json:"action,omitempty"`
+	ChangedFields []string   `protobuf:"bytes,3,rep,name=changedFields,proto3" json:"changedFields,omitempty"`
+	AddedMenus    []string   `protobuf:"bytes,4,rep,name=addedMenus,proto3" json:"addedMenus,omitempty"`
+	RemovedMenus  []string   `protobuf:"bytes,5,rep,name=removedMenus,proto3" json:"removedMenus,omitempty"`
+	AddedApis     []*ApiBase `protobuf:"bytes,6,rep,name=addedApis,proto3" json:"addedApis,omitempty"`
+	RemovedApis   []*ApiBase `protobuf:"bytes,7,rep,name=removedApis,proto3" json:"removedApis,omitempty"`
+	AddedBtns     []string   `protobuf:"bytes,8,rep,name=addedBtns,proto3" json:"addedBtns,omitempty"`
+	RemovedBtns   []string   `protobuf:"bytes,9,rep,name=removedBtns,proto3" json:"removedBtns,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoleImportDiff) Reset() {
+	*x = RoleImportDiff{}
+	mi := &file_roles_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoleImportDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoleImportDiff) ProtoMessage() {}
+
+func (x *RoleImportDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoleImportDiff.ProtoReflect.Descriptor instead.
+func (*RoleImportDiff) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *RoleImportDiff) GetRoleKey() string {
+	if x != nil {
+		return x.RoleKey
+	}
+	return ""
+}
+
+func (x *RoleImportDiff) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *RoleImportDiff) GetChangedFields() []string {
+	if x != nil {
+		return x.ChangedFields
+	}
+	return nil
+}
+
+func (x *RoleImportDiff) GetAddedMenus() []string {
+	if x != nil {
+		return x.AddedMenus
+	}
+	return nil
+}
+
+func (x *RoleImportDiff) GetRemovedMenus() []string {
+	if x != nil {
+		return x.RemovedMenus
+	}
+	return nil
+}
+
+func (x *RoleImportDiff) GetAddedApis() []*ApiBase {
+	if x != nil {
+		return x.AddedApis
+	}
+	return nil
+}
+
+func (x *RoleImportDiff) GetRemovedApis() []*ApiBase {
+	if x != nil {
+		return x.RemovedApis
+	}
+	return nil
+}
+
+func (x *RoleImportDiff) GetAddedBtns() []string {
+	if x != nil {
+		return x.AddedBtns
+	}
+	return nil
+}
+
+func (x *RoleImportDiff) GetRemovedBtns() []string {
+	if x != nil {
+		return x.RemovedBtns
+	}
+	return nil
+}
+
+type CreateTempGrantRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// role=授予角色 api=授予api权限
+	GrantType string     `protobuf:"bytes,2,opt,name=grantType,proto3" json:"grantType,omitempty"`
+	RoleKey   string     `protobuf:"bytes,3,opt,name=roleKey,proto3" json:"roleKey,omitempty"`
+	Apis      []*ApiBase `protobuf:"bytes,4,rep,name=apis,proto3" json:"apis,omitempty"`
+	// 为空时立即生效
+	StartTime     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTempGrantRequest) Reset() {
+	*x = CreateTempGrantRequest{}
+	mi := &file_roles_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTempGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTempGrantRequest) ProtoMessage() {}
+
+func (x *CreateTempGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTempGrantRequest.ProtoReflect.Descriptor instead.
+func (*CreateTempGrantRequest) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateTempGrantRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateTempGrantRequest) GetGrantType() string {
+	if x != nil {
+		return x.GrantType
+	}
+	return ""
+}
+
+func (x *CreateTempGrantRequest) GetRoleKey() string {
+	if x != nil {
+		return x.RoleKey
+	}
+	return ""
+}
+
+func (x *CreateTempGrantRequest) GetApis() []*ApiBase {
+	if x != nil {
+		return x.Apis
+	}
+	return nil
+}
+
+func (x *CreateTempGrantRequest) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *CreateTempGrantRequest) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *CreateTempGrantRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CreateTempGrantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Grant         *TempGrant             `protobuf:"bytes,1,opt,name=grant,proto3" json:"grant,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTempGrantReply) Reset() {
+	*x = CreateTempGrantReply{}
+	mi := &file_roles_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTempGrantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTempGrantReply) ProtoMessage() {}
+
+func (x *CreateTempGrantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTempGrantReply.ProtoReflect.Descriptor instead.
+func (*CreateTempGrantReply) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateTempGrantReply) GetGrant() *TempGrant {
+	if x != nil {
+		return x.Grant
+	}
+	return nil
+}
+
+type RevokeTempGrantRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTempGrantRequest) Reset() {
+	*x = RevokeTempGrantRequest{}
+	mi := &file_roles_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTempGrantRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTempGrantRequest) ProtoMessage() {}
+
+func (x *RevokeTempGrantRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTempGrantRequest.ProtoReflect.Descriptor instead.
+func (*RevokeTempGrantRequest) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RevokeTempGrantRequest) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+type RevokeTempGrantReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeTempGrantReply) Reset() {
+	*x = RevokeTempGrantReply{}
+	mi := &file_roles_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeTempGrantReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeTempGrantReply) ProtoMessage() {}
+
+func (x *RevokeTempGrantReply) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeTempGrantReply.ProtoReflect.Descriptor instead.
+func (*RevokeTempGrantReply) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{22}
+}
+
+type ListTempGrantsRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	PageNum  int32                  `protobuf:"varint,1,opt,name=pageNum,proto3" json:"pageNum,omitempty"`
+	PageSize int32                  `protobuf:"varint,2,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	UserId   int64                  `protobuf:"varint,3,opt,name=userId,proto3" json:"userId,omitempty"`
+	// 包含已过期和已撤销的授权
+	History       bool `protobuf:"varint,4,opt,name=history,proto3" json:"history,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTempGrantsRequest) Reset() {
+	*x = ListTempGrantsRequest{}
+	mi := &file_roles_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTempGrantsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTempGrantsRequest) ProtoMessage() {}
+
+func (x *ListTempGrantsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTempGrantsRequest.ProtoReflect.Descriptor instead.
+func (*ListTempGrantsRequest) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *ListTempGrantsRequest) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListTempGrantsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTempGrantsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ListTempGrantsRequest) GetHistory() bool {
+	if x != nil {
+		return x.History
+	}
+	return false
+}
+
+type ListTempGrantsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Total         int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	PageNum       int32                  `protobuf:"varint,2,opt,name=pageNum,proto3" json:"pageNum,omitempty"`
+	PageSize      int32                  `protobuf:"varint,3,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	Data          []*TempGrant           `protobuf:"bytes,4,rep,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTempGrantsReply) Reset() {
+	*x = ListTempGrantsReply{}
+	mi := &file_roles_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTempGrantsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTempGrantsReply) ProtoMessage() {}
+
+func (x *ListTempGrantsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTempGrantsReply.ProtoReflect.Descriptor instead.
+func (*ListTempGrantsReply) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *ListTempGrantsReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTempGrantsReply) GetPageNum() int32 {
+	if x != nil {
+		return x.PageNum
+	}
+	return 0
+}
+
+func (x *ListTempGrantsReply) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListTempGrantsReply) GetData() []*TempGrant {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+// 临时授权
+type TempGrant struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Id        int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId    int64                  `protobuf:"varint,2,opt,name=userId,proto3" json:"userId,omitempty"`
+	GrantType string                 `protobuf:"bytes,3,opt,name=grantType,proto3" json:"grantType,omitempty"`
+	RoleKey   string                 `protobuf:"bytes,4,opt,name=roleKey,proto3" json:"roleKey,omitempty"`
+	Apis      []*ApiBase             `protobuf:"bytes,5,rep,name=apis,proto3" json:"apis,omitempty"`
+	StartTime *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=startTime,proto3" json:"startTime,omitempty"`
+	EndTime   *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=endTime,proto3" json:"endTime,omitempty"`
+	// 1=待生效 2=生效中 3=已过期 4=已撤销
+	Status        int32                  `protobuf:"varint,8,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,9,opt,name=reason,proto3" json:"reason,omitempty"`
+	CreateBy      string                 `protobuf:"bytes,10,opt,name=createBy,proto3" json:"createBy,omitempty"`
+	RevokeBy      string                 `protobuf:"bytes,11,opt,name=revokeBy,proto3" json:"revokeBy,omitempty"`
+	CreateTime    *timestamppb.Timestamp `protobuf:"bytes,12,opt,name=createTime,proto3" json:"createTime,omitempty"`
+	UpdateTime    *timestamppb.Timestamp `protobuf:"bytes,13,opt,name=updateTime,proto3" json:"updateTime,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TempGrant) Reset() {
+	*x = TempGrant{}
+	mi := &file_roles_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TempGrant) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TempGrant) ProtoMessage() {}
+
+func (x *TempGrant) ProtoReflect() protoreflect.Message {
+	mi := &file_roles_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TempGrant.ProtoReflect.Descriptor instead.
+func (*TempGrant) Descriptor() ([]byte, []int) {
+	return file_roles_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *TempGrant) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *TempGrant) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *TempGrant) GetGrantType() string {
+	if x != nil {
+		return x.GrantType
+	}
+	return ""
+}
+
+func (x *TempGrant) GetRoleKey() string {
+	if x != nil {
+		return x.RoleKey
+	}
+	return ""
+}
+
+func (x *TempGrant) GetApis() []*ApiBase {
+	if x != nil {
+		return x.Apis
+	}
+	return nil
+}
+
+func (x *TempGrant) GetStartTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartTime
+	}
+	return nil
+}
+
+func (x *TempGrant) GetEndTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndTime
+	}
+	return nil
+}
+
+func (x *TempGrant) GetStatus() int32 {
+	if x != nil {
+		return x.Status
+	}
+	return 0
+}
+
+func (x *TempGrant) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TempGrant) GetCreateBy() string {
+	if x != nil {
+		return x.CreateBy
+	}
+	return ""
+}
+
+func (x *TempGrant) GetRevokeBy() string {
+	if x != nil {
+		return x.RevokeBy
+	}
+	return ""
+}
+
+func (x *TempGrant) GetCreateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreateTime
+	}
+	return nil
+}
+
+func (x *TempGrant) GetUpdateTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.UpdateTime
+	}
+	return nil
+}
+
+var File_roles_proto protoreflect.FileDescriptor
+
+const file_roles_proto_rawDesc = "" +
+	"\n" +
+	"\vroles.proto\x12\fapi.admin.v1\x1a\x1cgoogle/api/annotations.proto\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x17validate/validate.proto\x1a\n" +
+	"base.proto\"\xb7\x02\n" +
+	"\x12CreateRolesRequest\x12\x1a\n" +
+	"\broleName\x18\x01 \x01(\tR\broleName\x12\x18\n" +
+	"\aroleKey\x18\x02 \x01(\tR\aroleKey\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\x05R\x04sort\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06remark\x18\x05 \x01(\tR\x06remark\x12\x1c\n" +
+	"\tdataScope\x18\x06 \x01(\x05R\tdataScope\x12\x18\n" +
+	"\amenuIds\x18\a \x03(\x03R\amenuIds\x12-\n" +
+	"\x06apiIds\x18\b \x03(\v2\x15.api.admin.v1.ApiBaseR\x06apiIds\x12\x1a\n" +
+	"\bparentId\x18\t \x01(\x03R\bparentId\x12$\n" +
+	"\rdefaultRouter\x18\n" +
+	" \x01(\tR\rdefaultRouter\"\x12\n" +
+	"\x10CreateRolesReply\"\xcf\x02\n" +
+	"\x12UpdateRolesRequest\x12\x1a\n" +
+	"\broleName\x18\x01 \x01(\tR\broleName\x12\x18\n" +
+	"\aroleKey\x18\x02 \x01(\tR\aroleKey\x12\x12\n" +
+	"\x04sort\x18\x03 \x01(\x05R\x04sort\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\x05R\x06status\x12\x16\n" +
+	"\x06remark\x18\x05 \x01(\tR\x06remark\x12\x1c\n" +
+	"\tdataScope\x18\x06 \x01(\x05R\tdataScope\x12\x18\n" +
+	"\amenuIds\x18\a \x03(\x03R\amenuIds\x12-\n" +
+	"\x06apiIds\x18\b \x03(\v2\x15.api.admin.v1.ApiBaseR\x06apiIds\x12\x1a\n" +
+	"\bparentId\x18\t \x01(\x03R\bparentId\x12$\n" +
+	"\rdefaultRouter\x18\n" +
+	" \x01(\tR\rdefaultRouter\x12\x16\n" +
+	"\x06roleId\x18\v \x01(\x03R\x06roleId\"\x12\n" +
+	"\x10UpdateRolesReply\"L\n" +
+	"\x12DeleteRolesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12&\n" +
+	"\x0ereassignRoleId\x18\x02 \x01(\x03R\x0ereassignRoleId\"\x12\n" +
+	"\x10DeleteRolesReply\"\"\n" +
+	"\x10FindRolesRequest\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\"<\n" +
+	"\x0eFindRolesReply\x12*\n" +
+	"\x04role\x18\x01 \x01(\v2\x16.api.admin.v1.RoleDataR\x04role\"\x96\x01\n" +
+	"\x10ListRolesRequest\x12\x18\n" +
+	"\apageNum\x18\x01 \x01(\x05R\apageNum\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\x05R\x06status\x12\x1a\n" +
+	"\broleName\x18\x04 \x01(\tR\broleName\x12\x18\n" +
+	"\aroleKey\x18\x05 \x01(\tR\aroleKey\"\x88\x01\n" +
+	"\x0eListRolesReply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\apageNum\x18\x02 \x01(\x05R\apageNum\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\x12*\n" +
+	"\x04data\x18\x04 \x03(\v2\x16.api.admin.v1.RoleDataR\x04data\"I\n" +
+	"\x17ChangeRoleStatusRequest\x12\x16\n" +
+	"\x06roleId\x18\x01 \x01(\x03R\x06roleId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\x05R\x06status\"\x17\n" +
+	"\x15ChangeRoleStatusReply\"H\n" +
+	"\x10DataScopeRequest\x12\x16\n" +
+	"\x06roleId\x18\x01 \x01(\x03R\x06roleId\x12\x1c\n" +
+	"\tdataScope\x18\x02 \x01(\x05R\tdataScope\"\x10\n" +
+	"\x0eDataScopeReply\"]\n" +
+	"\x12ExportRolesRequest\x12\x1a\n" +
+	"\broleKeys\x18\x01 \x01(\tR\broleKeys\x12+\n" +
+	"\x06format\x18\x02 \x01(\tB\x13\xfaB\x10r\x0eR\x00R\x04yamlR\x04jsonR\x06format\"D\n" +
+	"\x10ExportRolesReply\x12\x16\n" +
+	"\x06format\x18\x01 \x01(\tR\x06format\x12\x18\n" +
+	"\acontent\x18\x02 \x01(\tR\acontent\"|\n" +
+	"\x12ImportRolesRequest\x12+\n" +
+	"\x06format\x18\x01 \x01(\tB\x13\xfaB\x10r\x0eR\x00R\x04yamlR\x04jsonR\x06format\x12!\n" +
+	"\acontent\x18\x02 \x01(\tB\a\xfaB\x04r\x02\x10\x01R\acontent\x12\x16\n" +
+	"\x06dryRun\x18\x03 \x01(\bR\x06dryRun\"b\n" +
+	"\x10ImportRolesReply\x122\n" +
+	"\x05roles\x18\x01 \x03(\v2\x1c.api.admin.v1.RoleImportDiffR\x05roles\x12\x1a\n" +
+	"\bwarnings\x18\x02 \x03(\tR\bwarnings\"\xda\x02\n" +
+	"\x0eRoleImportDiff\x12\x18\n" +
+	"\aroleKey\x18\x01 \x01(\tR\aroleKey\x12\x16\n" +
+	"\x06action\x18\x02 \x01(\tR\x06action\x12$\n" +
+	"\rchangedFields\x18\x03 \x03(\tR\rchangedFields\x12\x1e\n" +
+	"\n" +
+	"addedMenus\x18\x04 \x03(\tR\n" +
+	"addedMenus\x12\"\n" +
+	"\fremovedMenus\x18\x05 \x03(\tR\fremovedMenus\x123\n" +
+	"\taddedApis\x18\x06 \x03(\v2\x15.api.admin.v1.ApiBaseR\taddedApis\x127\n" +
+	"\vremovedApis\x18\a \x03(\v2\x15.api.admin.v1.ApiBaseR\vremovedApis\x12\x1c\n" +
+	"\taddedBtns\x18\b \x03(\tR\taddedBtns\x12 \n" +
+	"\vremovedBtns\x18\t \x03(\tR\vremovedBtns\"\xc0\x02\n" +
+	"\x16CreateTempGrantRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12.\n" +
+	"\tgrantType\x18\x02 \x01(\tB\x10\xfaB\rr\vR\x04roleR\x03apiR\tgrantType\x12\x18\n" +
+	"\aroleKey\x18\x03 \x01(\tR\aroleKey\x12)\n" +
+	"\x04apis\x18\x04 \x03(\v2\x15.api.admin.v1.ApiBaseR\x04apis\x128\n" +
+	"\tstartTime\x18\x05 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x124\n" +
+	"\aendTime\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12 \n" +
+	"\x06reason\x18\a \x01(\tB\b\xfaB\x05r\x03\x18\xff\x01R\x06reason\"E\n" +
+	"\x14CreateTempGrantReply\x12-\n" +
+	"\x05grant\x18\x01 \x01(\v2\x17.api.admin.v1.TempGrantR\x05grant\"1\n" +
+	"\x16RevokeTempGrantRequest\x12\x17\n" +
+	"\x02id\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x02id\"\x16\n" +
+	"\x14RevokeTempGrantReply\"\x7f\n" +
+	"\x15ListTempGrantsRequest\x12\x18\n" +
+	"\apageNum\x18\x01 \x01(\x05R\apageNum\x12\x1a\n" +
+	"\bpageSize\x18\x02 \x01(\x05R\bpageSize\x12\x16\n" +
+	"\x06userId\x18\x03 \x01(\x03R\x06userId\x12\x18\n" +
+	"\ahistory\x18\x04 \x01(\bR\ahistory\"\x8e\x01\n" +
+	"\x13ListTempGrantsReply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x18\n" +
+	"\apageNum\x18\x02 \x01(\x05R\apageNum\x12\x1a\n" +
+	"\bpageSize\x18\x03 \x01(\x05R\bpageSize\x12+\n" +
+	"\x04data\x18\x04 \x03(\v2\x17.api.admin.v1.TempGrantR\x04data\"\xe6\x03\n" +
+	"\tTempGrant\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x16\n" +
+	"\x06userId\x18\x02 \x01(\x03R\x06userId\x12\x1c\n" +
+	"\tgrantType\x18\x03 \x01(\tR\tgrantType\x12\x18\n" +
+	"\aroleKey\x18\x04 \x01(\tR\aroleKey\x12)\n" +
+	"\x04apis\x18\x05 \x03(\v2\x15.api.admin.v1.ApiBaseR\x04apis\x128\n" +
+	"\tstartTime\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tstartTime\x124\n" +
+	"\aendTime\x18\a \x01(\v2\x1a.google.protobuf.TimestampR\aendTime\x12\x16\n" +
+	"\x06status\x18\b \x01(\x05R\x06status\x12\x16\n" +
+	"\x06reason\x18\t \x01(\tR\x06reason\x12\x1a\n" +
+	"\bcreateBy\x18\n" +
+	" \x01(\tR\bcreateBy\x12\x1a\n" +
+	"\brevokeBy\x18\v \x01(\tR\brevokeBy\x12:\n" +
+	"\n" +
+	"createTime\x18\f \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"createTime\x12:\n" +
+	"\n" +
+	"updateTime\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\n" +
+	"updateTime2\xee\n" +
+	"\n" +
 	"\x05Roles\x12h\n" +
 	"\vCreateRoles\x12 .api.admin.v1.CreateRolesRequest\x1a\x1e.api.admin.v1.CreateRolesReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/system/role\x12h\n" +
 	"\vUpdateRoles\x12 .api.admin.v1.UpdateRolesRequest\x1a\x1e.api.admin.v1.UpdateRolesReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/system/role\x12d\n" +
 	"\tListRoles\x12\x1e.api.admin.v1.ListRolesRequest\x1a\x1c.api.admin.v1.ListRolesReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/system/role/list\x12\x84\x01\n" +
 	"\x10ChangeRoleStatus\x12%.api.admin.v1.ChangeRoleStatusRequest\x1a#.api.admin.v1.ChangeRoleStatusReply\"$\x82\xd3\xe4\x93\x02\x1e:\x01*\x1a\x19/system/role/changeStatus\x12l\n" +
-	"\tDataScope\x12\x1e.api.admin.v1.DataScopeRequest\x1a\x1c.api.admin.v1.DataScopeReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/system/role/dataScope\x12j\n" +
+	"\tDataScope\x12\x1e.api.admin.v1.DataScopeRequest\x1a\x1c.api.admin.v1.DataScopeReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\x1a\x16/system/role/dataScope\x12l\n" +
+	"\vExportRoles\x12 .api.admin.v1.ExportRolesRequest\x1a\x1e.api.admin.v1.ExportRolesReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/system/role/export\x12o\n" +
+	"\vImportRoles\x12 .api.admin.v1.ImportRolesRequest\x1a\x1e.api.admin.v1.ImportRolesReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/system/role/import\x12~\n" +
+	"\x0fCreateTempGrant\x12$.api.admin.v1.CreateTempGrantRequest\x1a\".api.admin.v1.CreateTempGrantReply\"!\x82\xd3\xe4\x93\x02\x1b:\x01*\"\x16/system/role/tempGrant\x12\x85\x01\n" +
+	"\x0fRevokeTempGrant\x12$.api.admin.v1.RevokeTempGrantRequest\x1a\".api.admin.v1.RevokeTempGrantReply\"(\x82\xd3\xe4\x93\x02\":\x01*\x1a\x1d/system/role/tempGrant/revoke\x12}\n" +
+	"\x0eListTempGrants\x12#.api.admin.v1.ListTempGrantsRequest\x1a!.api.admin.v1.ListTempGrantsReply\"#\x82\xd3\xe4\x93\x02\x1d\x12\x1b/system/role/tempGrant/list\x12j\n" +
 	"\vDeleteRoles\x12 .api.admin.v1.DeleteRolesRequest\x1a\x1e.api.admin.v1.DeleteRolesReply\"\x19\x82\xd3\xe4\x93\x02\x13*\x11/system/role/{id}\x12d\n" +
 	"\tFindRoles\x12\x1e.api.admin.v1.FindRolesRequest\x1a\x1c.api.admin.v1.FindRolesReply\"\x19\x82\xd3\xe4\x93\x02\x13\x12\x11/system/role/{id}B6Z4github.com/swordkee/kratos-vue-admin/api/admin/v1;v1b\x06proto3"
 
@@ -902,7 +1814,7 @@ func file_roles_proto_rawDescGZIP() []byte {
 	return file_roles_proto_rawDescData
 }
 
-var file_roles_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_roles_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_roles_proto_goTypes = []any{
 	(*CreateRolesRequest)(nil),      // 0: api.admin.v1.CreateRolesRequest
 	(*CreateRolesReply)(nil),        // 1: api.admin.v1.CreateRolesReply
@@ -918,33 +1830,69 @@ var file_roles_proto_goTypes = []any{
 	(*ChangeRoleStatusReply)(nil),   // 11: api.admin.v1.ChangeRoleStatusReply
 	(*DataScopeRequest)(nil),        // 12: api.admin.v1.DataScopeRequest
 	(*DataScopeReply)(nil),          // 13: api.admin.v1.DataScopeReply
-	(*ApiBase)(nil),                 // 14: api.admin.v1.ApiBase
-	(*RoleData)(nil),                // 15: api.admin.v1.RoleData
+	(*ExportRolesRequest)(nil),      // 14: api.admin.v1.ExportRolesRequest
+	(*ExportRolesReply)(nil),        // 15: api.admin.v1.ExportRolesReply
+	(*ImportRolesRequest)(nil),      // 16: api.admin.v1.ImportRolesRequest
+	(*ImportRolesReply)(nil),        // 17: api.admin.v1.ImportRolesReply
+	(*RoleImportDiff)(nil),          // 18: api.admin.v1.RoleImportDiff
+	(*CreateTempGrantRequest)(nil),  // 19: api.admin.v1.CreateTempGrantRequest
+	(*CreateTempGrantReply)(nil),    // 20: api.admin.v1.CreateTempGrantReply
+	(*RevokeTempGrantRequest)(nil),  // 21: api.admin.v1.RevokeTempGrantRequest
+	(*RevokeTempGrantReply)(nil),    // 22: api.admin.v1.RevokeTempGrantReply
+	(*ListTempGrantsRequest)(nil),   // 23: api.admin.v1.ListTempGrantsRequest
+	(*ListTempGrantsReply)(nil),     // 24: api.admin.v1.ListTempGrantsReply
+	(*TempGrant)(nil),               // 25: api.admin.v1.TempGrant
+	(*ApiBase)(nil),                 // 26: api.admin.v1.ApiBase
+	(*RoleData)(nil),                // 27: api.admin.v1.RoleData
+	(*timestamppb.Timestamp)(nil),   // 28: google.protobuf.Timestamp
 }
 var file_roles_proto_depIdxs = []int32{
-	14, // 0: api.admin.v1.CreateRolesRequest.apiIds:type_name -> api.admin.v1.ApiBase
-	14, // 1: api.admin.v1.UpdateRolesRequest.apiIds:type_name -> api.admin.v1.ApiBase
-	15, // 2: api.admin.v1.FindRolesReply.role:type_name -> api.admin.v1.RoleData
-	15, // 3: api.admin.v1.ListRolesReply.data:type_name -> api.admin.v1.RoleData
-	0,  // 4: api.admin.v1.Roles.CreateRoles:input_type -> api.admin.v1.CreateRolesRequest
-	2,  // 5: api.admin.v1.Roles.UpdateRoles:input_type -> api.admin.v1.UpdateRolesRequest
-	8,  // 6: api.admin.v1.Roles.ListRoles:input_type -> api.admin.v1.ListRolesRequest
-	10, // 7: api.admin.v1.Roles.ChangeRoleStatus:input_type -> api.admin.v1.ChangeRoleStatusRequest
-	12, // 8: api.admin.v1.Roles.DataScope:input_type -> api.admin.v1.DataScopeRequest
-	4,  // 9: api.admin.v1.Roles.DeleteRoles:input_type -> api.admin.v1.DeleteRolesRequest
-	6,  // 10: api.admin.v1.Roles.FindRoles:input_type -> api.admin.v1.FindRolesRequest
-	1,  // 11: api.admin.v1.Roles.CreateRoles:output_type -> api.admin.v1.CreateRolesReply
-	3,  // 12: api.admin.v1.Roles.UpdateRoles:output_type -> api.admin.v1.UpdateRolesReply
-	9,  // 13: api.admin.v1.Roles.ListRoles:output_type -> api.admin.v1.ListRolesReply
-	11, // 14: api.admin.v1.Roles.ChangeRoleStatus:output_type -> api.admin.v1.ChangeRoleStatusReply
-	13, // 15: api.admin.v1.Roles.DataScope:output_type -> api.admin.v1.DataScopeReply
-	5,  // 16: api.admin.v1.Roles.DeleteRoles:output_type -> api.admin.v1.DeleteRolesReply
-	7,  // 17: api.admin.v1.Roles.FindRoles:output_type -> api.admin.v1.FindRolesReply
-	11, // [11:18] is the sub-list for method output_type
-	4,  // [4:11] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	26, // 0: api.admin.v1.CreateRolesRequest.apiIds:type_name -> api.admin.v1.ApiBase
+	26, // 1: api.admin.v1.UpdateRolesRequest.apiIds:type_name -> api.admin.v1.ApiBase
+	27, // 2: api.admin.v1.FindRolesReply.role:type_name -> api.admin.v1.RoleData
+	27, // 3: api.admin.v1.ListRolesReply.data:type_name -> api.admin.v1.RoleData
+	18, // 4: api.admin.v1.ImportRolesReply.roles:type_name -> api.admin.v1.RoleImportDiff
+	26, // 5: api.admin.v1.RoleImportDiff.addedApis:type_name -> api.admin.v1.ApiBase
+	26, // 6: api.admin.v1.RoleImportDiff.removedApis:type_name -> api.admin.v1.ApiBase
+	26, // 7: api.admin.v1.CreateTempGrantRequest.apis:type_name -> api.admin.v1.ApiBase
+	28, // 8: api.admin.v1.CreateTempGrantRequest.startTime:type_name -> google.protobuf.Timestamp
+	28, // 9: api.admin.v1.CreateTempGrantRequest.endTime:type_name -> google.protobuf.Timestamp
+	25, // 10: api.admin.v1.CreateTempGrantReply.grant:type_name -> api.admin.v1.TempGrant
+	25, // 11: api.admin.v1.ListTempGrantsReply.data:type_name -> api.admin.v1.TempGrant
+	26, // 12: api.admin.v1.TempGrant.apis:type_name -> api.admin.v1.ApiBase
+	28, // 13: api.admin.v1.TempGrant.startTime:type_name -> google.protobuf.Timestamp
+	28, // 14: api.admin.v1.TempGrant.endTime:type_name -> google.protobuf.Timestamp
+	28, // 15: api.admin.v1.TempGrant.createTime:type_name -> google.protobuf.Timestamp
+	28, // 16: api.admin.v1.TempGrant.updateTime:type_name -> google.protobuf.Timestamp
+	0,  // 17: api.admin.v1.Roles.CreateRoles:input_type -> api.admin.v1.CreateRolesRequest
+	2,  // 18: api.admin.v1.Roles.UpdateRoles:input_type -> api.admin.v1.UpdateRolesRequest
+	8,  // 19: api.admin.v1.Roles.ListRoles:input_type -> api.admin.v1.ListRolesRequest
+	10, // 20: api.admin.v1.Roles.ChangeRoleStatus:input_type -> api.admin.v1.ChangeRoleStatusRequest
+	12, // 21: api.admin.v1.Roles.DataScope:input_type -> api.admin.v1.DataScopeRequest
+	14, // 22: api.admin.v1.Roles.ExportRoles:input_type -> api.admin.v1.ExportRolesRequest
+	16, // 23: api.admin.v1.Roles.ImportRoles:input_type -> api.admin.v1.ImportRolesRequest
+	19, // 24: api.admin.v1.Roles.CreateTempGrant:input_type -> api.admin.v1.CreateTempGrantRequest
+	21, // 25: api.admin.v1.Roles.RevokeTempGrant:input_type -> api.admin.v1.RevokeTempGrantRequest
+	23, // 26: api.admin.v1.Roles.ListTempGrants:input_type -> api.admin.v1.ListTempGrantsRequest
+	4,  // 27: api.admin.v1.Roles.DeleteRoles:input_type -> api.admin.v1.DeleteRolesRequest
+	6,  // 28: api.admin.v1.Roles.FindRoles:input_type -> api.admin.v1.FindRolesRequest
+	1,  // 29: api.admin.v1.Roles.CreateRoles:output_type -> api.admin.v1.CreateRolesReply
+	3,  // 30: api.admin.v1.Roles.UpdateRoles:output_type -> api.admin.v1.UpdateRolesReply
+	9,  // 31: api.admin.v1.Roles.ListRoles:output_type -> api.admin.v1.ListRolesReply
+	11, // 32: api.admin.v1.Roles.ChangeRoleStatus:output_type -> api.admin.v1.ChangeRoleStatusReply
+	13, // 33: api.admin.v1.Roles.DataScope:output_type -> api.admin.v1.DataScopeReply
+	15, // 34: api.admin.v1.Roles.ExportRoles:output_type -> api.admin.v1.ExportRolesReply
+	17, // 35: api.admin.v1.Roles.ImportRoles:output_type -> api.admin.v1.ImportRolesReply
+	20, // 36: api.admin.v1.Roles.CreateTempGrant:output_type -> api.admin.v1.CreateTempGrantReply
+	22, // 37: api.admin.v1.Roles.RevokeTempGrant:output_type -> api.admin.v1.RevokeTempGrantReply
+	24, // 38: api.admin.v1.Roles.ListTempGrants:output_type -> api.admin.v1.ListTempGrantsReply
+	5,  // 39: api.admin.v1.Roles.DeleteRoles:output_type -> api.admin.v1.DeleteRolesReply
+	7,  // 40: api.admin.v1.Roles.FindRoles:output_type -> api.admin.v1.FindRolesReply
+	29, // [29:41] is the sub-list for method output_type
+	17, // [17:29] is the sub-list for method input_type
+	17, // [17:17] is the sub-list for extension type_name
+	17, // [17:17] is the sub-list for extension extendee
+	0,  // [0:17] is the sub-list for field type_name
 }
 
 func init() { file_roles_proto_init() }
@@ -959,7 +1907,7 @@ func file_roles_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_roles_proto_rawDesc), len(file_roles_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -565,6 +565,8 @@ func (m *DeleteRolesRequest) validate(all bool) error {
 
 	// no validation rules for Id
 
+	// no validation rules for ReassignRoleId
+
 	if len(errors) > 0 {
 		return DeleteRolesRequestMultiError(errors)
 	}
@@ -1637,3 +1639,1771 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = DataScopeReplyValidationError{}
+
+// Validate checks the field values on ExportRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ExportRolesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportRolesRequestMultiError, or nil if none found.
+func (m *ExportRolesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportRolesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoleKeys
+
+	if _, ok := _ExportRolesRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ExportRolesRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ yaml json]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ExportRolesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportRolesRequestMultiError is an error wrapping multiple validation errors
+// returned by ExportRolesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ExportRolesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportRolesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportRolesRequestMultiError) AllErrors() []error { return m }
+
+// ExportRolesRequestValidationError is the validation error returned by
+// ExportRolesRequest.Validate if the designated constraints aren't met.
+type ExportRolesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportRolesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportRolesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportRolesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportRolesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportRolesRequestValidationError) ErrorName() string {
+	return "ExportRolesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ExportRolesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportRolesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportRolesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportRolesRequestValidationError{}
+
+var _ExportRolesRequest_Format_InLookup = map[string]struct{}{
+	"":     {},
+	"yaml": {},
+	"json": {},
+}
+
+// Validate checks the field values on ExportRolesReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ExportRolesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ExportRolesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ExportRolesReplyMultiError, or nil if none found.
+func (m *ExportRolesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ExportRolesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Format
+
+	// no validation rules for Content
+
+	if len(errors) > 0 {
+		return ExportRolesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ExportRolesReplyMultiError is an error wrapping multiple validation errors
+// returned by ExportRolesReply.ValidateAll() if the designated constraints
+// aren't met.
+type ExportRolesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ExportRolesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ExportRolesReplyMultiError) AllErrors() []error { return m }
+
+// ExportRolesReplyValidationError is the validation error returned by
+// ExportRolesReply.Validate if the designated constraints aren't met.
+type ExportRolesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ExportRolesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ExportRolesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ExportRolesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ExportRolesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ExportRolesReplyValidationError) ErrorName() string { return "ExportRolesReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ExportRolesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sExportRolesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ExportRolesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ExportRolesReplyValidationError{}
+
+// Validate checks the field values on ImportRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ImportRolesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRolesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportRolesRequestMultiError, or nil if none found.
+func (m *ImportRolesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRolesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ImportRolesRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ImportRolesRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ yaml json]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContent()) < 1 {
+		err := ImportRolesRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportRolesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRolesRequestMultiError is an error wrapping multiple validation errors
+// returned by ImportRolesRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRolesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRolesRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRolesRequestMultiError) AllErrors() []error { return m }
+
+// ImportRolesRequestValidationError is the validation error returned by
+// ImportRolesRequest.Validate if the designated constraints aren't met.
+type ImportRolesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRolesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRolesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRolesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRolesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRolesRequestValidationError) ErrorName() string {
+	return "ImportRolesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportRolesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRolesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRolesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRolesRequestValidationError{}
+
+var _ImportRolesRequest_Format_InLookup = map[string]struct{}{
+	"":     {},
+	"yaml": {},
+	"json": {},
+}
+
+// Validate checks the field values on ImportRolesReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ImportRolesReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportRolesReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportRolesReplyMultiError, or nil if none found.
+func (m *ImportRolesReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportRolesReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetRoles() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportRolesReplyValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportRolesReplyValidationError{
+						field:  fmt.Sprintf("Roles[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportRolesReplyValidationError{
+					field:  fmt.Sprintf("Roles[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ImportRolesReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportRolesReplyMultiError is an error wrapping multiple validation errors
+// returned by ImportRolesReply.ValidateAll() if the designated constraints
+// aren't met.
+type ImportRolesReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportRolesReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportRolesReplyMultiError) AllErrors() []error { return m }
+
+// ImportRolesReplyValidationError is the validation error returned by
+// ImportRolesReply.Validate if the designated constraints aren't met.
+type ImportRolesReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportRolesReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportRolesReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportRolesReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportRolesReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportRolesReplyValidationError) ErrorName() string { return "ImportRolesReplyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ImportRolesReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportRolesReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportRolesReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportRolesReplyValidationError{}
+
+// Validate checks the field values on RoleImportDiff with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *RoleImportDiff) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RoleImportDiff with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in RoleImportDiffMultiError,
+// or nil if none found.
+func (m *RoleImportDiff) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RoleImportDiff) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoleKey
+
+	// no validation rules for Action
+
+	for idx, item := range m.GetAddedApis() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RoleImportDiffValidationError{
+						field:  fmt.Sprintf("AddedApis[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RoleImportDiffValidationError{
+						field:  fmt.Sprintf("AddedApis[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RoleImportDiffValidationError{
+					field:  fmt.Sprintf("AddedApis[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetRemovedApis() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, RoleImportDiffValidationError{
+						field:  fmt.Sprintf("RemovedApis[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, RoleImportDiffValidationError{
+						field:  fmt.Sprintf("RemovedApis[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return RoleImportDiffValidationError{
+					field:  fmt.Sprintf("RemovedApis[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return RoleImportDiffMultiError(errors)
+	}
+
+	return nil
+}
+
+// RoleImportDiffMultiError is an error wrapping multiple validation errors
+// returned by RoleImportDiff.ValidateAll() if the designated constraints
+// aren't met.
+type RoleImportDiffMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RoleImportDiffMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RoleImportDiffMultiError) AllErrors() []error { return m }
+
+// RoleImportDiffValidationError is the validation error returned by
+// RoleImportDiff.Validate if the designated constraints aren't met.
+type RoleImportDiffValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RoleImportDiffValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RoleImportDiffValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RoleImportDiffValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RoleImportDiffValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RoleImportDiffValidationError) ErrorName() string { return "RoleImportDiffValidationError" }
+
+// Error satisfies the builtin error interface
+func (e RoleImportDiffValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRoleImportDiff.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RoleImportDiffValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RoleImportDiffValidationError{}
+
+// Validate checks the field values on CreateTempGrantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CreateTempGrantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTempGrantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTempGrantRequestMultiError, or nil if none found.
+func (m *CreateTempGrantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTempGrantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := CreateTempGrantRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _CreateTempGrantRequest_GrantType_InLookup[m.GetGrantType()]; !ok {
+		err := CreateTempGrantRequestValidationError{
+			field:  "GrantType",
+			reason: "value must be in list [role api]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for RoleKey
+
+	for idx, item := range m.GetApis() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateTempGrantRequestValidationError{
+						field:  fmt.Sprintf("Apis[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateTempGrantRequestValidationError{
+						field:  fmt.Sprintf("Apis[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateTempGrantRequestValidationError{
+					field:  fmt.Sprintf("Apis[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTempGrantRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTempGrantRequestValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTempGrantRequestValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTempGrantRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTempGrantRequestValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTempGrantRequestValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if utf8.RuneCountInString(m.GetReason()) > 255 {
+		err := CreateTempGrantRequestValidationError{
+			field:  "Reason",
+			reason: "value length must be at most 255 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateTempGrantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTempGrantRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateTempGrantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTempGrantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTempGrantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTempGrantRequestMultiError) AllErrors() []error { return m }
+
+// CreateTempGrantRequestValidationError is the validation error returned by
+// CreateTempGrantRequest.Validate if the designated constraints aren't met.
+type CreateTempGrantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTempGrantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTempGrantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTempGrantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTempGrantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTempGrantRequestValidationError) ErrorName() string {
+	return "CreateTempGrantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTempGrantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTempGrantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTempGrantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTempGrantRequestValidationError{}
+
+var _CreateTempGrantRequest_GrantType_InLookup = map[string]struct{}{
+	"role": {},
+	"api":  {},
+}
+
+// Validate checks the field values on CreateTempGrantReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *CreateTempGrantReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTempGrantReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateTempGrantReplyMultiError, or nil if none found.
+func (m *CreateTempGrantReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTempGrantReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetGrant()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateTempGrantReplyValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateTempGrantReplyValidationError{
+					field:  "Grant",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetGrant()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateTempGrantReplyValidationError{
+				field:  "Grant",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateTempGrantReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTempGrantReplyMultiError is an error wrapping multiple validation
+// errors returned by CreateTempGrantReply.ValidateAll() if the designated
+// constraints aren't met.
+type CreateTempGrantReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTempGrantReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTempGrantReplyMultiError) AllErrors() []error { return m }
+
+// CreateTempGrantReplyValidationError is the validation error returned by
+// CreateTempGrantReply.Validate if the designated constraints aren't met.
+type CreateTempGrantReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTempGrantReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTempGrantReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTempGrantReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTempGrantReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTempGrantReplyValidationError) ErrorName() string {
+	return "CreateTempGrantReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTempGrantReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTempGrantReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTempGrantReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTempGrantReplyValidationError{}
+
+// Validate checks the field values on RevokeTempGrantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RevokeTempGrantRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeTempGrantRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeTempGrantRequestMultiError, or nil if none found.
+func (m *RevokeTempGrantRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeTempGrantRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetId() <= 0 {
+		err := RevokeTempGrantRequestValidationError{
+			field:  "Id",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeTempGrantRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeTempGrantRequestMultiError is an error wrapping multiple validation
+// errors returned by RevokeTempGrantRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeTempGrantRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeTempGrantRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeTempGrantRequestMultiError) AllErrors() []error { return m }
+
+// RevokeTempGrantRequestValidationError is the validation error returned by
+// RevokeTempGrantRequest.Validate if the designated constraints aren't met.
+type RevokeTempGrantRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeTempGrantRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeTempGrantRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeTempGrantRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeTempGrantRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeTempGrantRequestValidationError) ErrorName() string {
+	return "RevokeTempGrantRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeTempGrantRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeTempGrantRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeTempGrantRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeTempGrantRequestValidationError{}
+
+// Validate checks the field values on RevokeTempGrantReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RevokeTempGrantReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeTempGrantReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeTempGrantReplyMultiError, or nil if none found.
+func (m *RevokeTempGrantReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeTempGrantReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeTempGrantReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeTempGrantReplyMultiError is an error wrapping multiple validation
+// errors returned by RevokeTempGrantReply.ValidateAll() if the designated
+// constraints aren't met.
+type RevokeTempGrantReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeTempGrantReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeTempGrantReplyMultiError) AllErrors() []error { return m }
+
+// RevokeTempGrantReplyValidationError is the validation error returned by
+// RevokeTempGrantReply.Validate if the designated constraints aren't met.
+type RevokeTempGrantReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeTempGrantReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeTempGrantReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeTempGrantReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeTempGrantReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeTempGrantReplyValidationError) ErrorName() string {
+	return "RevokeTempGrantReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeTempGrantReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeTempGrantReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeTempGrantReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeTempGrantReplyValidationError{}
+
+// Validate checks the field values on ListTempGrantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListTempGrantsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTempGrantsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTempGrantsRequestMultiError, or nil if none found.
+func (m *ListTempGrantsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTempGrantsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	// no validation rules for UserId
+
+	// no validation rules for History
+
+	if len(errors) > 0 {
+		return ListTempGrantsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTempGrantsRequestMultiError is an error wrapping multiple validation
+// errors returned by ListTempGrantsRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListTempGrantsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTempGrantsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTempGrantsRequestMultiError) AllErrors() []error { return m }
+
+// ListTempGrantsRequestValidationError is the validation error returned by
+// ListTempGrantsRequest.Validate if the designated constraints aren't met.
+type ListTempGrantsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTempGrantsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTempGrantsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTempGrantsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTempGrantsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTempGrantsRequestValidationError) ErrorName() string {
+	return "ListTempGrantsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTempGrantsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTempGrantsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTempGrantsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTempGrantsRequestValidationError{}
+
+// Validate checks the field values on ListTempGrantsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ListTempGrantsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListTempGrantsReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListTempGrantsReplyMultiError, or nil if none found.
+func (m *ListTempGrantsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListTempGrantsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for PageNum
+
+	// no validation rules for PageSize
+
+	for idx, item := range m.GetData() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListTempGrantsReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListTempGrantsReplyValidationError{
+						field:  fmt.Sprintf("Data[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListTempGrantsReplyValidationError{
+					field:  fmt.Sprintf("Data[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListTempGrantsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListTempGrantsReplyMultiError is an error wrapping multiple validation
+// errors returned by ListTempGrantsReply.ValidateAll() if the designated
+// constraints aren't met.
+type ListTempGrantsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListTempGrantsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListTempGrantsReplyMultiError) AllErrors() []error { return m }
+
+// ListTempGrantsReplyValidationError is the validation error returned by
+// ListTempGrantsReply.Validate if the designated constraints aren't met.
+type ListTempGrantsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListTempGrantsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListTempGrantsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListTempGrantsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListTempGrantsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListTempGrantsReplyValidationError) ErrorName() string {
+	return "ListTempGrantsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListTempGrantsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListTempGrantsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListTempGrantsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListTempGrantsReplyValidationError{}
+
+// Validate checks the field values on TempGrant with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *TempGrant) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TempGrant with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TempGrantMultiError, or nil
+// if none found.
+func (m *TempGrant) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TempGrant) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for UserId
+
+	// no validation rules for GrantType
+
+	// no validation rules for RoleKey
+
+	for idx, item := range m.GetApis() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TempGrantValidationError{
+						field:  fmt.Sprintf("Apis[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TempGrantValidationError{
+						field:  fmt.Sprintf("Apis[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TempGrantValidationError{
+					field:  fmt.Sprintf("Apis[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetStartTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TempGrantValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TempGrantValidationError{
+					field:  "StartTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetStartTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TempGrantValidationError{
+				field:  "StartTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetEndTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TempGrantValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TempGrantValidationError{
+					field:  "EndTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetEndTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TempGrantValidationError{
+				field:  "EndTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Status
+
+	// no validation rules for Reason
+
+	// no validation rules for CreateBy
+
+	// no validation rules for RevokeBy
+
+	if all {
+		switch v := interface{}(m.GetCreateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TempGrantValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TempGrantValidationError{
+					field:  "CreateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TempGrantValidationError{
+				field:  "CreateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdateTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TempGrantValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TempGrantValidationError{
+					field:  "UpdateTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdateTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TempGrantValidationError{
+				field:  "UpdateTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TempGrantMultiError(errors)
+	}
+
+	return nil
+}
+
+// TempGrantMultiError is an error wrapping multiple validation errors returned
+// by TempGrant.ValidateAll() if the designated constraints aren't met.
+type TempGrantMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TempGrantMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TempGrantMultiError) AllErrors() []error { return m }
+
+// TempGrantValidationError is the validation error returned by
+// TempGrant.Validate if the designated constraints aren't met.
+type TempGrantValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TempGrantValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TempGrantValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TempGrantValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TempGrantValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TempGrantValidationError) ErrorName() string { return "TempGrantValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TempGrantValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTempGrant.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TempGrantValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TempGrantValidationError{}
@@ -24,6 +24,11 @@ const (
 	Roles_ListRoles_FullMethodName        = "/api.admin.v1.Roles/ListRoles"
 	Roles_ChangeRoleStatus_FullMethodName = "/api.admin.v1.Roles/ChangeRoleStatus"
 	Roles_DataScope_FullMethodName        = "/api.admin.v1.Roles/DataScope"
+	Roles_ExportRoles_FullMethodName      = "/api.admin.v1.Roles/ExportRoles"
+	Roles_ImportRoles_FullMethodName      = "/api.admin.v1.Roles/ImportRoles"
+	Roles_CreateTempGrant_FullMethodName  = "/api.admin.v1.Roles/CreateTempGrant"
+	Roles_RevokeTempGrant_FullMethodName  = "/api.admin.v1.Roles/RevokeTempGrant"
+	Roles_ListTempGrants_FullMethodName   = "/api.admin.v1.Roles/ListTempGrants"
 	Roles_DeleteRoles_FullMethodName      = "/api.admin.v1.Roles/DeleteRoles"
 	Roles_FindRoles_FullMethodName        = "/api.admin.v1.Roles/FindRoles"
 )
@@ -44,6 +49,16 @@ type RolesClient interface {
 	ChangeRoleStatus(ctx context.Context, in *ChangeRoleStatusRequest, opts ...grpc.CallOption) (*ChangeRoleStatusReply, error)
 	// 更改角色数据范围
 	DataScope(ctx context.Context, in *DataScopeRequest, opts ...grpc.CallOption) (*DataScopeReply, error)
+	// 导出角色（含菜单、api权限、按钮）
+	ExportRoles(ctx context.Context, in *ExportRolesRequest, opts ...grpc.CallOption) (*ExportRolesReply, error)
+	// 导入角色
+	ImportRoles(ctx context.Context, in *ImportRolesRequest, opts ...grpc.CallOption) (*ImportRolesReply, error)
+	// 临时授权（角色或api权限，到期自动撤销）
+	CreateTempGrant(ctx context.Context, in *CreateTempGrantRequest, opts ...grpc.CallOption) (*CreateTempGrantReply, error)
+	// 撤销临时授权
+	RevokeTempGrant(ctx context.Context, in *RevokeTempGrantRequest, opts ...grpc.CallOption) (*RevokeTempGrantReply, error)
+	// 临时授权列表
+	ListTempGrants(ctx context.Context, in *ListTempGrantsRequest, opts ...grpc.CallOption) (*ListTempGrantsReply, error)
 	// 删除角色
 	DeleteRoles(ctx context.Context, in *DeleteRolesRequest, opts ...grpc.CallOption) (*DeleteRolesReply, error)
 	// 获取角色
@@ -108,6 +123,56 @@ func (c *rolesClient) DataScope(ctx context.Context, in *DataScopeRequest, opts
 	return out, nil
 }
 
+func (c *rolesClient) ExportRoles(ctx context.Context, in *ExportRolesRequest, opts ...grpc.CallOption) (*ExportRolesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportRolesReply)
+	err := c.cc.Invoke(ctx, Roles_ExportRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolesClient) ImportRoles(ctx context.Context, in *ImportRolesRequest, opts ...grpc.CallOption) (*ImportRolesReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportRolesReply)
+	err := c.cc.Invoke(ctx, Roles_ImportRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolesClient) CreateTempGrant(ctx context.Context, in *CreateTempGrantRequest, opts ...grpc.CallOption) (*CreateTempGrantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateTempGrantReply)
+	err := c.cc.Invoke(ctx, Roles_CreateTempGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolesClient) RevokeTempGrant(ctx context.Context, in *RevokeTempGrantRequest, opts ...grpc.CallOption) (*RevokeTempGrantReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeTempGrantReply)
+	err := c.cc.Invoke(ctx, Roles_RevokeTempGrant_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *rolesClient) ListTempGrants(ctx context.Context, in *ListTempGrantsRequest, opts ...grpc.CallOption) (*ListTempGrantsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTempGrantsReply)
+	err := c.cc.Invoke(ctx, Roles_ListTempGrants_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *rolesClient) DeleteRoles(ctx context.Context, in *DeleteRolesRequest, opts ...grpc.CallOption) (*DeleteRolesReply, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(DeleteRolesReply)
@@ -144,6 +209,16 @@ type RolesServer interface {
 	ChangeRoleStatus(context.Context, *ChangeRoleStatusRequest) (*ChangeRoleStatusReply, error)
 	// 更改角色数据范围
 	DataScope(context.Context, *DataScopeRequest) (*DataScopeReply, error)
+	// 导出角色（含菜单、api权限、按钮）
+	ExportRoles(context.Context, *ExportRolesRequest) (*ExportRolesReply, error)
+	// 导入角色
+	ImportRoles(context.Context, *ImportRolesRequest) (*ImportRolesReply, error)
+	// 临时授权（角色或api权限，到期自动撤销）
+	CreateTempGrant(context.Context, *CreateTempGrantRequest) (*CreateTempGrantReply, error)
+	// 撤销临时授权
+	RevokeTempGrant(context.Context, *RevokeTempGrantRequest) (*RevokeTempGrantReply, error)
+	// 临时授权列表
+	ListTempGrants(context.Context, *ListTempGrantsRequest) (*ListTempGrantsReply, error)
 	// 删除角色
 	DeleteRoles(context.Context, *DeleteRolesRequest) (*DeleteRolesReply, error)
 	// 获取角色
@@ -173,6 +248,21 @@ func (UnimplementedRolesServer) ChangeRoleStatus(context.Context, *ChangeRoleSta
 func (UnimplementedRolesServer) DataScope(context.Context, *DataScopeRequest) (*DataScopeReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method DataScope not implemented")
 }
+func (UnimplementedRolesServer) ExportRoles(context.Context, *ExportRolesRequest) (*ExportRolesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ExportRoles not implemented")
+}
+func (UnimplementedRolesServer) ImportRoles(context.Context, *ImportRolesRequest) (*ImportRolesReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportRoles not implemented")
+}
+func (UnimplementedRolesServer) CreateTempGrant(context.Context, *CreateTempGrantRequest) (*CreateTempGrantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method CreateTempGrant not implemented")
+}
+func (UnimplementedRolesServer) RevokeTempGrant(context.Context, *RevokeTempGrantRequest) (*RevokeTempGrantReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeTempGrant not implemented")
+}
+func (UnimplementedRolesServer) ListTempGrants(context.Context, *ListTempGrantsRequest) (*ListTempGrantsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ListTempGrants not implemented")
+}
 func (UnimplementedRolesServer) DeleteRoles(context.Context, *DeleteRolesRequest) (*DeleteRolesReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method DeleteRoles not implemented")
 }
@@ -290,6 +380,96 @@ func _Roles_DataScope_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Roles_ExportRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolesServer).ExportRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Roles_ExportRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolesServer).ExportRoles(ctx, req.(*ExportRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Roles_ImportRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolesServer).ImportRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Roles_ImportRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolesServer).ImportRoles(ctx, req.(*ImportRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Roles_CreateTempGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTempGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolesServer).CreateTempGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Roles_CreateTempGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolesServer).CreateTempGrant(ctx, req.(*CreateTempGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Roles_RevokeTempGrant_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeTempGrantRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolesServer).RevokeTempGrant(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Roles_RevokeTempGrant_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolesServer).RevokeTempGrant(ctx, req.(*RevokeTempGrantRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Roles_ListTempGrants_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTempGrantsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RolesServer).ListTempGrants(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Roles_ListTempGrants_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RolesServer).ListTempGrants(ctx, req.(*ListTempGrantsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Roles_DeleteRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteRolesRequest)
 	if err := dec(in); err != nil {
@@ -353,6 +533,26 @@ var Roles_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DataScope",
 			Handler:    _Roles_DataScope_Handler,
 		},
+		{
+			MethodName: "ExportRoles",
+			Handler:    _Roles_ExportRoles_Handler,
+		},
+		{
+			MethodName: "ImportRoles",
+			Handler:    _Roles_ImportRoles_Handler,
+		},
+		{
+			MethodName: "CreateTempGrant",
+			Handler:    _Roles_CreateTempGrant_Handler,
+		},
+		{
+			MethodName: "RevokeTempGrant",
+			Handler:    _Roles_RevokeTempGrant_Handler,
+		},
+		{
+			MethodName: "ListTempGrants",
+			Handler:    _Roles_ListTempGrants_Handler,
+		},
 		{
 			MethodName: "DeleteRoles",
 			Handler:    _Roles_DeleteRoles_Handler,
@@ -21,10 +21,15 @@ const _ = http.SupportPackageIsVersion1
 
 const OperationRolesChangeRoleStatus = "/api.admin.v1.Roles/ChangeRoleStatus"
 const OperationRolesCreateRoles = "/api.admin.v1.Roles/CreateRoles"
+const OperationRolesCreateTempGrant = "/api.admin.v1.Roles/CreateTempGrant"
 const OperationRolesDataScope = "/api.admin.v1.Roles/DataScope"
 const OperationRolesDeleteRoles = "/api.admin.v1.Roles/DeleteRoles"
+const OperationRolesExportRoles = "/api.admin.v1.Roles/ExportRoles"
 const OperationRolesFindRoles = "/api.admin.v1.Roles/FindRoles"
+const OperationRolesImportRoles = "/api.admin.v1.Roles/ImportRoles"
 const OperationRolesListRoles = "/api.admin.v1.Roles/ListRoles"
+const OperationRolesListTempGrants = "/api.admin.v1.Roles/ListTempGrants"
+const OperationRolesRevokeTempGrant = "/api.admin.v1.Roles/RevokeTempGrant"
 const OperationRolesUpdateRoles = "/api.admin.v1.Roles/UpdateRoles"
 
 type RolesHTTPServer interface {
@@ -32,14 +37,24 @@ type RolesHTTPServer interface {
 	ChangeRoleStatus(context.Context, *ChangeRoleStatusRequest) (*ChangeRoleStatusReply, error)
 	// CreateRoles 创建角色
 	CreateRoles(context.Context, *CreateRolesRequest) (*CreateRolesReply, error)
+	// CreateTempGrant 临时授权（角色或api权限，到期自动撤销）
+	CreateTempGrant(context.Context, *CreateTempGrantRequest) (*CreateTempGrantReply, error)
 	// DataScope 更改角色数据范围
 	DataScope(context.Context, *DataScopeRequest) (*DataScopeReply, error)
 	// DeleteRoles 删除角色
 	DeleteRoles(context.Context, *DeleteRolesRequest) (*DeleteRolesReply, error)
+	// ExportRoles 导出角色（含菜单、api权限、按钮）
+	ExportRoles(context.Context, *ExportRolesRequest) (*ExportRolesReply, error)
 	// FindRoles 获取角色
 	FindRoles(context.Context, *FindRolesRequest) (*FindRolesReply, error)
+	// ImportRoles 导入角色
+	ImportRoles(context.Context, *ImportRolesRequest) (*ImportRolesReply, error)
 	// ListRoles 角色列表
 	ListRoles(context.Context, *ListRolesRequest) (*ListRolesReply, error)
+	// ListTempGrants 临时授权列表
+	ListTempGrants(context.Context, *ListTempGrantsRequest) (*ListTempGrantsReply, error)
+	// RevokeTempGrant 撤销临时授权
+	RevokeTempGrant(context.Context, *RevokeTempGrantRequest) (*RevokeTempGrantReply, error)
 	// UpdateRoles 更新角色
 	UpdateRoles(context.Context, *UpdateRolesRequest) (*UpdateRolesReply, error)
 }
@@ -51,6 +66,11 @@ func RegisterRolesHTTPServer(s *http.Server, srv RolesHTTPServer) {
 	r.GET("/system/role/list", _Roles_ListRoles0_HTTP_Handler(srv))
 	r.PUT("/system/role/changeStatus", _Roles_ChangeRoleStatus0_HTTP_Handler(srv))
 	r.PUT("/system/role/dataScope", _Roles_DataScope0_HTTP_Handler(srv))
+	r.GET("/system/role/export", _Roles_ExportRoles0_HTTP_Handler(srv))
+	r.POST("/system/role/import", _Roles_ImportRoles0_HTTP_Handler(srv))
+	r.POST("/system/role/tempGrant", _Roles_CreateTempGrant0_HTTP_Handler(srv))
+	r.PUT("/system/role/tempGrant/revoke", _Roles_RevokeTempGrant0_HTTP_Handler(srv))
+	r.GET("/system/role/tempGrant/list", _Roles_ListTempGrants0_HTTP_Handler(srv))
 	r.DELETE("/system/role/{id}", _Roles_DeleteRoles0_HTTP_Handler(srv))
 	r.GET("/system/role/{id}", _Roles_FindRoles0_HTTP_Handler(srv))
 }
@@ -162,6 +182,110 @@ func _Roles_DataScope0_HTTP_Handler(srv RolesHTTPServer) func(ctx http.Context)
 	}
 }
 
+func _Roles_ExportRoles0_HTTP_Handler(srv RolesHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ExportRolesRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRolesExportRoles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ExportRoles(ctx, req.(*ExportRolesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ExportRolesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Roles_ImportRoles0_HTTP_Handler(srv RolesHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportRolesRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRolesImportRoles)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportRoles(ctx, req.(*ImportRolesRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportRolesReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Roles_CreateTempGrant0_HTTP_Handler(srv RolesHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in CreateTempGrantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRolesCreateTempGrant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.CreateTempGrant(ctx, req.(*CreateTempGrantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*CreateTempGrantReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Roles_RevokeTempGrant0_HTTP_Handler(srv RolesHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeTempGrantRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRolesRevokeTempGrant)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeTempGrant(ctx, req.(*RevokeTempGrantRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeTempGrantReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _Roles_ListTempGrants0_HTTP_Handler(srv RolesHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ListTempGrantsRequest
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationRolesListTempGrants)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ListTempGrants(ctx, req.(*ListTempGrantsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ListTempGrantsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 func _Roles_DeleteRoles0_HTTP_Handler(srv RolesHTTPServer) func(ctx http.Context) error {
 	return func(ctx http.Context) error {
 		var in DeleteRolesRequest
@@ -211,14 +335,24 @@ type RolesHTTPClient interface {
 	ChangeRoleStatus(ctx context.Context, req *ChangeRoleStatusRequest, opts ...http.CallOption) (rsp *ChangeRoleStatusReply, err error)
 	// CreateRoles 创建角色
 	CreateRoles(ctx context.Context, req *CreateRolesRequest, opts ...http.CallOption) (rsp *CreateRolesReply, err error)
+	// CreateTempGrant 临时授权（角色或api权限，到期自动撤销）
+	CreateTempGrant(ctx context.Context, req *CreateTempGrantRequest, opts ...http.CallOption) (rsp *CreateTempGrantReply, err error)
 	// DataScope 更改角色数据范围
 	DataScope(ctx context.Context, req *DataScopeRequest, opts ...http.CallOption) (rsp *DataScopeReply, err error)
 	// DeleteRoles 删除角色
 	DeleteRoles(ctx context.Context, req *DeleteRolesRequest, opts ...http.CallOption) (rsp *DeleteRolesReply, err error)
+	// ExportRoles 导出角色（含菜单、api权限、按钮）
+	ExportRoles(ctx context.Context, req *ExportRolesRequest, opts ...http.CallOption) (rsp *ExportRolesReply, err error)
 	// FindRoles 获取角色
 	FindRoles(ctx context.Context, req *FindRolesRequest, opts ...http.CallOption) (rsp *FindRolesReply, err error)
+	// ImportRoles 导入角色
+	ImportRoles(ctx context.Context, req *ImportRolesRequest, opts ...http.CallOption) (rsp *ImportRolesReply, err error)
 	// ListRoles 角色列表
 	ListRoles(ctx context.Context, req *ListRolesRequest, opts ...http.CallOption) (rsp *ListRolesReply, err error)
+	// ListTempGrants 临时授权列表
+	ListTempGrants(ctx context.Context, req *ListTempGrantsRequest, opts ...http.CallOption) (rsp *ListTempGrantsReply, err error)
+	// RevokeTempGrant 撤销临时授权
+	RevokeTempGrant(ctx context.Context, req *RevokeTempGrantRequest, opts ...http.CallOption) (rsp *RevokeTempGrantReply, err error)
 	// UpdateRoles 更新角色
 	UpdateRoles(ctx context.Context, req *UpdateRolesRequest, opts ...http.CallOption) (rsp *UpdateRolesReply, err error)
 }
@@ -259,6 +393,20 @@ func (c *RolesHTTPClientImpl) CreateRoles(ctx context.Context, in *CreateRolesRe
 	return &out, nil
 }
 
+// CreateTempGrant 临时授权（角色或api权限，到期自动撤销）
+func (c *RolesHTTPClientImpl) CreateTempGrant(ctx context.Context, in *CreateTempGrantRequest, opts ...http.CallOption) (*CreateTempGrantReply, error) {
+	var out CreateTempGrantReply
+	pattern := "/system/role/tempGrant"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRolesCreateTempGrant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // DataScope 更改角色数据范围
 func (c *RolesHTTPClientImpl) DataScope(ctx context.Context, in *DataScopeRequest, opts ...http.CallOption) (*DataScopeReply, error) {
 	var out DataScopeReply
@@ -287,6 +435,20 @@ func (c *RolesHTTPClientImpl) DeleteRoles(ctx context.Context, in *DeleteRolesRe
 	return &out, nil
 }
 
+// ExportRoles 导出角色（含菜单、api权限、按钮）
+func (c *RolesHTTPClientImpl) ExportRoles(ctx context.Context, in *ExportRolesRequest, opts ...http.CallOption) (*ExportRolesReply, error) {
+	var out ExportRolesReply
+	pattern := "/system/role/export"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRolesExportRoles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // FindRoles 获取角色
 func (c *RolesHTTPClientImpl) FindRoles(ctx context.Context, in *FindRolesRequest, opts ...http.CallOption) (*FindRolesReply, error) {
 	var out FindRolesReply
@@ -301,6 +463,20 @@ func (c *RolesHTTPClientImpl) FindRoles(ctx context.Context, in *FindRolesReques
 	return &out, nil
 }
 
+// ImportRoles 导入角色
+func (c *RolesHTTPClientImpl) ImportRoles(ctx context.Context, in *ImportRolesRequest, opts ...http.CallOption) (*ImportRolesReply, error) {
+	var out ImportRolesReply
+	pattern := "/system/role/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRolesImportRoles))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListRoles 角色列表
 func (c *RolesHTTPClientImpl) ListRoles(ctx context.Context, in *ListRolesRequest, opts ...http.CallOption) (*ListRolesReply, error) {
 	var out ListRolesReply
@@ -315,6 +491,34 @@ func (c *RolesHTTPClientImpl) ListRoles(ctx context.Context, in *ListRolesReques
 	return &out, nil
 }
 
+// ListTempGrants 临时授权列表
+func (c *RolesHTTPClientImpl) ListTempGrants(ctx context.Context, in *ListTempGrantsRequest, opts ...http.CallOption) (*ListTempGrantsReply, error) {
+	var out ListTempGrantsReply
+	pattern := "/system/role/tempGrant/list"
+	path := binding.EncodeURL(pattern, in, true)
+	opts = append(opts, http.Operation(OperationRolesListTempGrants))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "GET", path, nil, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeTempGrant 撤销临时授权
+func (c *RolesHTTPClientImpl) RevokeTempGrant(ctx context.Context, in *RevokeTempGrantRequest, opts ...http.CallOption) (*RevokeTempGrantReply, error) {
+	var out RevokeTempGrantReply
+	pattern := "/system/role/tempGrant/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationRolesRevokeTempGrant))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdateRoles 更新角色
 func (c *RolesHTTPClientImpl) UpdateRoles(ctx context.Context, in *UpdateRolesRequest, opts ...http.CallOption) (*UpdateRolesReply, error) {
 	var out UpdateRolesReply
@@ -974,11 +974,13 @@ func (x *LoginRequest) GetCode() string {
 }
 
 type LoginReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	Expire        int64                  `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	Token  string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Expire int64                  `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`
+	// 密码已被管理员重置，需要先修改密码才能使用其他接口
+	MustChangePassword bool `protobuf:"varint,3,opt,name=mustChangePassword,proto3" json:"mustChangePassword,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
 }
 
 func (x *LoginReply) Reset() {
@@ -1025,6 +1027,13 @@ func (x *LoginReply) GetExpire() int64 {
 	return 0
 }
 
+func (x *LoginReply) GetMustChangePassword() bool {
+	if x != nil {
+		return x.MustChangePassword
+	}
+	return false
+}
+
 type LogoutRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
@@ -1142,11 +1151,14 @@ func (x *AuthRequest) GetUsername() string {
 }
 
 type AuthReply struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	User          *AuthReply_User        `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	Role          *AuthReply_Role        `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
-	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
-	Menus         []*MenuTreeAuth        `protobuf:"bytes,4,rep,name=menus,proto3" json:"menus,omitempty"`
+	state        protoimpl.MessageState  `protogen:"open.v1"`
+	User         *AuthReply_User         `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	Role         *AuthReply_Role         `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	Permissions  []string                `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	Menus        []*MenuTreeAuth         `protobuf:"bytes,4,rep,name=menus,proto3" json:"menus,omitempty"`
+	Impersonator *AuthReply_Impersonator `protobuf:"bytes,5,opt,name=impersonator,proto3" json:"impersonator,omitempty"`
+	// 本次请求实际使用的语言
+	Locale        string `protobuf:"bytes,6,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1209,6 +1221,20 @@ func (x *AuthReply) GetMenus() []*MenuTreeAuth {
 	return nil
 }
 
+func (x *AuthReply) GetImpersonator() *AuthReply_Impersonator {
+	if x != nil {
+		return x.Impersonator
+	}
+	return nil
+}
+
+func (x *AuthReply) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type ChangeStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
@@ -1649,36 +1675,628 @@ func (x *FindUserGoogleSecretReply) GetQrcode() string {
 	return ""
 }
 
-type AuthReply_User struct {
+type ImpersonateSysUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
-	NickName      string                 `protobuf:"bytes,2,opt,name=nickName,proto3" json:"nickName,omitempty"`
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	RoleId        int64                  `protobuf:"varint,4,opt,name=roleId,proto3" json:"roleId,omitempty"`
-	Salt          string                 `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
-	Avatar        string                 `protobuf:"bytes,6,opt,name=avatar,proto3" json:"avatar,omitempty"`
-	Sex           int32                  `protobuf:"varint,7,opt,name=sex,proto3" json:"sex,omitempty"`
-	Email         string                 `protobuf:"bytes,8,opt,name=email,proto3" json:"email,omitempty"`
-	DeptId        int64                  `protobuf:"varint,9,opt,name=deptId,proto3" json:"deptId,omitempty"`
-	PostId        int64                  `protobuf:"varint,10,opt,name=postId,proto3" json:"postId,omitempty"`
-	RoleIds       string                 `protobuf:"bytes,11,opt,name=roleIds,proto3" json:"roleIds,omitempty"`
-	PostIds       string                 `protobuf:"bytes,12,opt,name=postIds,proto3" json:"postIds,omitempty"`
-	CreateBy      string                 `protobuf:"bytes,13,opt,name=createBy,proto3" json:"createBy,omitempty"`
-	UpdateBy      string                 `protobuf:"bytes,14,opt,name=updateBy,proto3" json:"updateBy,omitempty"`
-	Remark        string                 `protobuf:"bytes,15,opt,name=remark,proto3" json:"remark,omitempty"`
-	Status        int32                  `protobuf:"varint,16,opt,name=status,proto3" json:"status,omitempty"`
-	Username      string                 `protobuf:"bytes,17,opt,name=username,proto3" json:"username,omitempty"`
-	Password      string                 `protobuf:"bytes,18,opt,name=password,proto3" json:"password,omitempty"`
-	RoleName      string                 `protobuf:"bytes,19,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
-	UpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateSysUserRequest) Reset() {
+	*x = ImpersonateSysUserRequest{}
+	mi := &file_sys_user_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateSysUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateSysUserRequest) ProtoMessage() {}
+
+func (x *ImpersonateSysUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateSysUserRequest.ProtoReflect.Descriptor instead.
+func (*ImpersonateSysUserRequest) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *ImpersonateSysUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ImpersonateSysUserReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Expire        int64                  `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImpersonateSysUserReply) Reset() {
+	*x = ImpersonateSysUserReply{}
+	mi := &file_sys_user_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImpersonateSysUserReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImpersonateSysUserReply) ProtoMessage() {}
+
+func (x *ImpersonateSysUserReply) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImpersonateSysUserReply.ProtoReflect.Descriptor instead.
+func (*ImpersonateSysUserReply) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *ImpersonateSysUserReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ImpersonateSysUserReply) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type UpdateLocaleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// zh-CN、en-US，传空表示跟随浏览器
+	Locale        string `protobuf:"bytes,1,opt,name=locale,proto3" json:"locale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocaleRequest) Reset() {
+	*x = UpdateLocaleRequest{}
+	mi := &file_sys_user_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocaleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocaleRequest) ProtoMessage() {}
+
+func (x *UpdateLocaleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocaleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLocaleRequest) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *UpdateLocaleRequest) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
+type UpdateLocaleReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Token         string                 `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	Expire        int64                  `protobuf:"varint,2,opt,name=expire,proto3" json:"expire,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateLocaleReply) Reset() {
+	*x = UpdateLocaleReply{}
+	mi := &file_sys_user_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateLocaleReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateLocaleReply) ProtoMessage() {}
+
+func (x *UpdateLocaleReply) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateLocaleReply.ProtoReflect.Descriptor instead.
+func (*UpdateLocaleReply) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *UpdateLocaleReply) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *UpdateLocaleReply) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
+type ImportSysUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// xlsx 或 csv，默认 xlsx
+	Format string `protobuf:"bytes,1,opt,name=format,proto3" json:"format,omitempty"`
+	// xlsx 格式时为 base64 编码的文件内容，csv 格式时为文本；可能包含密码，操作记录中脱敏
+	Content string `protobuf:"bytes,2,opt,name=content,proto3" json:"content,omitempty"`
+	// 只校验并返回结果，不创建用户
+	DryRun        bool `protobuf:"varint,3,opt,name=dryRun,proto3" json:"dryRun,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSysUsersRequest) Reset() {
+	*x = ImportSysUsersRequest{}
+	mi := &file_sys_user_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSysUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSysUsersRequest) ProtoMessage() {}
+
+func (x *ImportSysUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSysUsersRequest.ProtoReflect.Descriptor instead.
+func (*ImportSysUsersRequest) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *ImportSysUsersRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+func (x *ImportSysUsersRequest) GetContent() string {
+	if x != nil {
+		return x.Content
+	}
+	return ""
+}
+
+func (x *ImportSysUsersRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportSysUsersReply struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	Total   int32                  `protobuf:"varint,1,opt,name=total,proto3" json:"total,omitempty"`
+	Valid   int32                  `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Invalid int32                  `protobuf:"varint,3,opt,name=invalid,proto3" json:"invalid,omitempty"`
+	Created int32                  `protobuf:"varint,4,opt,name=created,proto3" json:"created,omitempty"`
+	Rows    []*UserImportResult    `protobuf:"bytes,5,rep,name=rows,proto3" json:"rows,omitempty"`
+	// 有随机生成的初始密码时，通过 GET /system/user/import/passwords/{passwordToken} 下载，
+	// 只能由导入人下载一次，10 分钟内有效
+	PasswordToken string `protobuf:"bytes,6,opt,name=passwordToken,proto3" json:"passwordToken,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSysUsersReply) Reset() {
+	*x = ImportSysUsersReply{}
+	mi := &file_sys_user_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSysUsersReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSysUsersReply) ProtoMessage() {}
+
+func (x *ImportSysUsersReply) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSysUsersReply.ProtoReflect.Descriptor instead.
+func (*ImportSysUsersReply) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ImportSysUsersReply) GetTotal() int32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ImportSysUsersReply) GetValid() int32 {
+	if x != nil {
+		return x.Valid
+	}
+	return 0
+}
+
+func (x *ImportSysUsersReply) GetInvalid() int32 {
+	if x != nil {
+		return x.Invalid
+	}
+	return 0
+}
+
+func (x *ImportSysUsersReply) GetCreated() int32 {
+	if x != nil {
+		return x.Created
+	}
+	return 0
+}
+
+func (x *ImportSysUsersReply) GetRows() []*UserImportResult {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportSysUsersReply) GetPasswordToken() string {
+	if x != nil {
+		return x.PasswordToken
+	}
+	return ""
+}
+
+// 单行用户的导入结果
+type UserImportResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 在文件中的行号，表头为第 1 行
+	Line          int32    `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Username      string   `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	Errors        []string `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	Created       bool     `protobuf:"varint,5,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UserImportResult) Reset() {
+	*x = UserImportResult{}
+	mi := &file_sys_user_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UserImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UserImportResult) ProtoMessage() {}
+
+func (x *UserImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UserImportResult.ProtoReflect.Descriptor instead.
+func (*UserImportResult) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *UserImportResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *UserImportResult) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UserImportResult) GetErrors() []string {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+func (x *UserImportResult) GetCreated() bool {
+	if x != nil {
+		return x.Created
+	}
+	return false
+}
+
+type ResetSysUserPasswordRequest struct {
+	state  protoimpl.MessageState `protogen:"open.v1"`
+	UserId int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	// 新密码，为空时随机生成
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSysUserPasswordRequest) Reset() {
+	*x = ResetSysUserPasswordRequest{}
+	mi := &file_sys_user_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSysUserPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSysUserPasswordRequest) ProtoMessage() {}
+
+func (x *ResetSysUserPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSysUserPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ResetSysUserPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ResetSysUserPasswordRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ResetSysUserPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ResetSysUserPasswordReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// 随机生成的密码，指定密码时为空
+	Password      string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResetSysUserPasswordReply) Reset() {
+	*x = ResetSysUserPasswordReply{}
+	mi := &file_sys_user_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ResetSysUserPasswordReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResetSysUserPasswordReply) ProtoMessage() {}
+
+func (x *ResetSysUserPasswordReply) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResetSysUserPasswordReply.ProtoReflect.Descriptor instead.
+func (*ResetSysUserPasswordReply) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *ResetSysUserPasswordReply) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type RevokeSysUserSessionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSysUserSessionsRequest) Reset() {
+	*x = RevokeSysUserSessionsRequest{}
+	mi := &file_sys_user_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSysUserSessionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSysUserSessionsRequest) ProtoMessage() {}
+
+func (x *RevokeSysUserSessionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSysUserSessionsRequest.ProtoReflect.Descriptor instead.
+func (*RevokeSysUserSessionsRequest) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *RevokeSysUserSessionsRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type RevokeSysUserSessionsReply struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RevokeSysUserSessionsReply) Reset() {
+	*x = RevokeSysUserSessionsReply{}
+	mi := &file_sys_user_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RevokeSysUserSessionsReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeSysUserSessionsReply) ProtoMessage() {}
+
+func (x *RevokeSysUserSessionsReply) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeSysUserSessionsReply.ProtoReflect.Descriptor instead.
+func (*RevokeSysUserSessionsReply) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{38}
+}
+
+type AuthReply_User struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	UserId    int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	NickName  string                 `protobuf:"bytes,2,opt,name=nickName,proto3" json:"nickName,omitempty"`
+	Phone     string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	RoleId    int64                  `protobuf:"varint,4,opt,name=roleId,proto3" json:"roleId,omitempty"`
+	Salt      string                 `protobuf:"bytes,5,opt,name=salt,proto3" json:"salt,omitempty"`
+	Avatar    string                 `protobuf:"bytes,6,opt,name=avatar,proto3" json:"avatar,omitempty"`
+	Sex       int32                  `protobuf:"varint,7,opt,name=sex,proto3" json:"sex,omitempty"`
+	Email     string                 `protobuf:"bytes,8,opt,name=email,proto3" json:"email,omitempty"`
+	DeptId    int64                  `protobuf:"varint,9,opt,name=deptId,proto3" json:"deptId,omitempty"`
+	PostId    int64                  `protobuf:"varint,10,opt,name=postId,proto3" json:"postId,omitempty"`
+	RoleIds   string                 `protobuf:"bytes,11,opt,name=roleIds,proto3" json:"roleIds,omitempty"`
+	PostIds   string                 `protobuf:"bytes,12,opt,name=postIds,proto3" json:"postIds,omitempty"`
+	CreateBy  string                 `protobuf:"bytes,13,opt,name=createBy,proto3" json:"createBy,omitempty"`
+	UpdateBy  string                 `protobuf:"bytes,14,opt,name=updateBy,proto3" json:"updateBy,omitempty"`
+	Remark    string                 `protobuf:"bytes,15,opt,name=remark,proto3" json:"remark,omitempty"`
+	Status    int32                  `protobuf:"varint,16,opt,name=status,proto3" json:"status,omitempty"`
+	Username  string                 `protobuf:"bytes,17,opt,name=username,proto3" json:"username,omitempty"`
+	Password  string                 `protobuf:"bytes,18,opt,name=password,proto3" json:"password,omitempty"`
+	RoleName  string                 `protobuf:"bytes,19,opt,name=role_name,json=roleName,proto3" json:"role_name,omitempty"`
+	CreatedAt *timestamppb.Timestamp `protobuf:"bytes,20,opt,name=createdAt,proto3" json:"createdAt,omitempty"`
+	UpdatedAt *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=updatedAt,proto3" json:"updatedAt,omitempty"`
+	// 语言偏好，为空表示跟随浏览器
+	Locale        string `protobuf:"bytes,22,opt,name=locale,proto3" json:"locale,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *AuthReply_User) Reset() {
 	*x = AuthReply_User{}
-	mi := &file_sys_user_proto_msgTypes[28]
+	mi := &file_sys_user_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1690,7 +2308,7 @@ func (x *AuthReply_User) String() string {
 func (*AuthReply_User) ProtoMessage() {}
 
 func (x *AuthReply_User) ProtoReflect() protoreflect.Message {
-	mi := &file_sys_user_proto_msgTypes[28]
+	mi := &file_sys_user_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1853,6 +2471,13 @@ func (x *AuthReply_User) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+func (x *AuthReply_User) GetLocale() string {
+	if x != nil {
+		return x.Locale
+	}
+	return ""
+}
+
 type AuthReply_Role struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	RoleId        int64                  `protobuf:"varint,1,opt,name=roleId,proto3" json:"roleId,omitempty"`
@@ -1875,7 +2500,7 @@ type AuthReply_Role struct {
 
 func (x *AuthReply_Role) Reset() {
 	*x = AuthReply_Role{}
-	mi := &file_sys_user_proto_msgTypes[29]
+	mi := &file_sys_user_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1887,7 +2512,7 @@ func (x *AuthReply_Role) String() string {
 func (*AuthReply_Role) ProtoMessage() {}
 
 func (x *AuthReply_Role) ProtoReflect() protoreflect.Message {
-	mi := &file_sys_user_proto_msgTypes[29]
+	mi := &file_sys_user_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2001,16 +2626,85 @@ func (x *AuthReply_Role) GetUpdatedAt() *timestamppb.Timestamp {
 	return nil
 }
 
+// 模拟登录时的真实操作人
+type AuthReply_Impersonator struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=userId,proto3" json:"userId,omitempty"`
+	Username      string                 `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	NickName      string                 `protobuf:"bytes,3,opt,name=nickName,proto3" json:"nickName,omitempty"`
+	Expire        int64                  `protobuf:"varint,4,opt,name=expire,proto3" json:"expire,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AuthReply_Impersonator) Reset() {
+	*x = AuthReply_Impersonator{}
+	mi := &file_sys_user_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AuthReply_Impersonator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AuthReply_Impersonator) ProtoMessage() {}
+
+func (x *AuthReply_Impersonator) ProtoReflect() protoreflect.Message {
+	mi := &file_sys_user_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AuthReply_Impersonator.ProtoReflect.Descriptor instead.
+func (*AuthReply_Impersonator) Descriptor() ([]byte, []int) {
+	return file_sys_user_proto_rawDescGZIP(), []int{17, 2}
+}
+
+func (x *AuthReply_Impersonator) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *AuthReply_Impersonator) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AuthReply_Impersonator) GetNickName() string {
+	if x != nil {
+		return x.NickName
+	}
+	return ""
+}
+
+func (x *AuthReply_Impersonator) GetExpire() int64 {
+	if x != nil {
+		return x.Expire
+	}
+	return 0
+}
+
 var File_sys_user_proto protoreflect.FileDescriptor
 
 const file_sys_user_proto_rawDesc = "" +
 	"\n" +
 	"\x0esys_user.proto\x12\fapi.admin.v1\x1a\x1fgoogle/protobuf/timestamp.proto\x1a\x19google/protobuf/any.proto\x1a\x1cgoogle/api/annotations.proto\x1a\x17validate/validate.proto\x1a\n" +
-	"base.proto\"\xaf\x03\n" +
+	"base.proto\x1a\roptions.proto\"\xb7\x03\n" +
 	"\x14CreateSysUserRequest\x12%\n" +
 	"\bnickName\x18\x01 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\x1eR\bnickName\x12%\n" +
-	"\busername\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\x1eR\busername\x12%\n" +
-	"\bpassword\x18\x03 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\x1eR\bpassword\x12\x14\n" +
+	"\busername\x18\x02 \x01(\tB\t\xfaB\x06r\x04\x10\x01\x18\x1eR\busername\x12)\n" +
+	"\bpassword\x18\x03 \x01(\tB\r\xfaB\x06r\x04\x10\x01\x18\x1e\x88\xb2\x19\x01R\bpassword\x12\x14\n" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x14\n" +
 	"\x05email\x18\x05 \x01(\tR\x05email\x12\x10\n" +
 	"\x03sex\x18\x06 \x01(\x05R\x03sex\x12\x16\n" +
@@ -2022,15 +2716,15 @@ const file_sys_user_proto_rawDesc = "" +
 	"\x06roleId\x18\v \x01(\x03R\x06roleId\x12\x18\n" +
 	"\apostIds\x18\f \x01(\tR\apostIds\x12\x18\n" +
 	"\aroleIds\x18\r \x01(\tR\aroleIds\x12\x16\n" +
-	"\x06avatar\x18\x0e \x01(\tR\x06avatar\x12 \n" +
-	"\x06secret\x18\x0f \x01(\tB\b\xfaB\x05r\x03\x98\x01 R\x06secret\"\x14\n" +
-	"\x12CreateSysUserReply\"\xf9\x04\n" +
+	"\x06avatar\x18\x0e \x01(\tR\x06avatar\x12$\n" +
+	"\x06secret\x18\x0f \x01(\tB\f\xfaB\x05r\x03\x98\x01 \x88\xb2\x19\x01R\x06secret\"\x14\n" +
+	"\x12CreateSysUserReply\"\x8b\x05\n" +
 	"\x14UpdateSysUserRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\bnickName\x18\x03 \x01(\tR\bnickName\x12\x14\n" +
 	"\x05phone\x18\x04 \x01(\tR\x05phone\x12\x16\n" +
-	"\x06roleId\x18\x05 \x01(\x03R\x06roleId\x12\x12\n" +
-	"\x04salt\x18\x06 \x01(\tR\x04salt\x12\x16\n" +
+	"\x06roleId\x18\x05 \x01(\x03R\x06roleId\x12\x18\n" +
+	"\x04salt\x18\x06 \x01(\tB\x04\x88\xb2\x19\x01R\x04salt\x12\x16\n" +
 	"\x06avatar\x18\a \x01(\tR\x06avatar\x12\x10\n" +
 	"\x03sex\x18\b \x01(\x05R\x03sex\x12\x14\n" +
 	"\x05email\x18\t \x01(\tR\x05email\x12\x16\n" +
@@ -2045,10 +2739,10 @@ const file_sys_user_proto_rawDesc = "" +
 	"\x06status\x18\x11 \x01(\x05R\x06status\x128\n" +
 	"\tcreatedAt\x18\x13 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
 	"\tupdatedAt\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x1a\n" +
-	"\busername\x18\x15 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x16 \x01(\tR\bpassword\x12\x1b\n" +
-	"\trole_name\x18\x17 \x01(\tR\broleName\x12\x16\n" +
-	"\x06secret\x18\x18 \x01(\tR\x06secret\"\x14\n" +
+	"\busername\x18\x15 \x01(\tR\busername\x12 \n" +
+	"\bpassword\x18\x16 \x01(\tB\x04\x88\xb2\x19\x01R\bpassword\x12\x1b\n" +
+	"\trole_name\x18\x17 \x01(\tR\broleName\x12\x1c\n" +
+	"\x06secret\x18\x18 \x01(\tB\x04\x88\xb2\x19\x01R\x06secret\"\x14\n" +
 	"\x12UpdateSysUserReply\"&\n" +
 	"\x14DeleteSysUserRequest\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\"\x14\n" +
@@ -2077,31 +2771,33 @@ const file_sys_user_proto_rawDesc = "" +
 	"\x10FindCaptchaReply\x12$\n" +
 	"\rbase64Captcha\x18\x01 \x01(\tR\rbase64Captcha\x12\x1c\n" +
 	"\tcaptchaId\x18\x02 \x01(\tR\tcaptchaId\x12\x18\n" +
-	"\acontent\x18\x03 \x01(\tR\acontent\"Z\n" +
+	"\acontent\x18\x03 \x01(\tR\acontent\"`\n" +
 	"\fLoginRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x02 \x01(\tR\bpassword\x12\x12\n" +
-	"\x04code\x18\x03 \x01(\tR\x04code\":\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12 \n" +
+	"\bpassword\x18\x02 \x01(\tB\x04\x88\xb2\x19\x01R\bpassword\x12\x12\n" +
+	"\x04code\x18\x03 \x01(\tR\x04code\"p\n" +
 	"\n" +
-	"LoginReply\x12\x14\n" +
-	"\x05token\x18\x01 \x01(\tR\x05token\x12\x16\n" +
-	"\x06expire\x18\x02 \x01(\x03R\x06expire\"\x0f\n" +
+	"LoginReply\x12\x1a\n" +
+	"\x05token\x18\x01 \x01(\tB\x04\x88\xb2\x19\x01R\x05token\x12\x16\n" +
+	"\x06expire\x18\x02 \x01(\x03R\x06expire\x12.\n" +
+	"\x12mustChangePassword\x18\x03 \x01(\bR\x12mustChangePassword\"\x0f\n" +
 	"\rLogoutRequest\"\r\n" +
 	"\vLogoutReply\")\n" +
 	"\vAuthRequest\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\"\x92\n" +
-	"\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"\x90\f\n" +
 	"\tAuthReply\x120\n" +
 	"\x04user\x18\x01 \x01(\v2\x1c.api.admin.v1.AuthReply.UserR\x04user\x120\n" +
 	"\x04role\x18\x02 \x01(\v2\x1c.api.admin.v1.AuthReply.RoleR\x04role\x12 \n" +
 	"\vpermissions\x18\x03 \x03(\tR\vpermissions\x120\n" +
-	"\x05menus\x18\x04 \x03(\v2\x1a.api.admin.v1.MenuTreeAuthR\x05menus\x1a\xd1\x04\n" +
+	"\x05menus\x18\x04 \x03(\v2\x1a.api.admin.v1.MenuTreeAuthR\x05menus\x12H\n" +
+	"\fimpersonator\x18\x05 \x01(\v2$.api.admin.v1.AuthReply.ImpersonatorR\fimpersonator\x12\x16\n" +
+	"\x06locale\x18\x06 \x01(\tR\x06locale\x1a\xf5\x04\n" +
 	"\x04User\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
 	"\bnickName\x18\x02 \x01(\tR\bnickName\x12\x14\n" +
 	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x16\n" +
-	"\x06roleId\x18\x04 \x01(\x03R\x06roleId\x12\x12\n" +
-	"\x04salt\x18\x05 \x01(\tR\x04salt\x12\x16\n" +
+	"\x06roleId\x18\x04 \x01(\x03R\x06roleId\x12\x18\n" +
+	"\x04salt\x18\x05 \x01(\tB\x04\x88\xb2\x19\x01R\x04salt\x12\x16\n" +
 	"\x06avatar\x18\x06 \x01(\tR\x06avatar\x12\x10\n" +
 	"\x03sex\x18\a \x01(\x05R\x03sex\x12\x14\n" +
 	"\x05email\x18\b \x01(\tR\x05email\x12\x16\n" +
@@ -2114,11 +2810,12 @@ const file_sys_user_proto_rawDesc = "" +
 	"\bupdateBy\x18\x0e \x01(\tR\bupdateBy\x12\x16\n" +
 	"\x06remark\x18\x0f \x01(\tR\x06remark\x12\x16\n" +
 	"\x06status\x18\x10 \x01(\x05R\x06status\x12\x1a\n" +
-	"\busername\x18\x11 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x12 \x01(\tR\bpassword\x12\x1b\n" +
+	"\busername\x18\x11 \x01(\tR\busername\x12 \n" +
+	"\bpassword\x18\x12 \x01(\tB\x04\x88\xb2\x19\x01R\bpassword\x12\x1b\n" +
 	"\trole_name\x18\x13 \x01(\tR\broleName\x128\n" +
 	"\tcreatedAt\x18\x14 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
-	"\tupdatedAt\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1a\xf8\x03\n" +
+	"\tupdatedAt\x18\x15 \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x12\x16\n" +
+	"\x06locale\x18\x16 \x01(\tR\x06locale\x1a\xf8\x03\n" +
 	"\x04Role\x12\x16\n" +
 	"\x06roleId\x18\x01 \x01(\x03R\x06roleId\x12\x1a\n" +
 	"\broleName\x18\x02 \x01(\tR\broleName\x12\x16\n" +
@@ -2134,14 +2831,19 @@ const file_sys_user_proto_rawDesc = "" +
 	"\amenuIds\x18\v \x01(\v2\x14.google.protobuf.AnyR\amenuIds\x12.\n" +
 	"\adeptIds\x18\f \x01(\v2\x14.google.protobuf.AnyR\adeptIds\x128\n" +
 	"\tcreatedAt\x18\r \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x128\n" +
-	"\tupdatedAt\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\"E\n" +
+	"\tupdatedAt\x18\x0e \x01(\v2\x1a.google.protobuf.TimestampR\tupdatedAt\x1av\n" +
+	"\fImpersonator\x12\x16\n" +
+	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bnickName\x18\x03 \x01(\tR\bnickName\x12\x16\n" +
+	"\x06expire\x18\x04 \x01(\x03R\x06expire\"E\n" +
 	"\x13ChangeStatusRequest\x12\x16\n" +
 	"\x06userId\x18\x01 \x01(\x03R\x06userId\x12\x16\n" +
 	"\x06status\x18\x02 \x01(\x05R\x06status\"\x13\n" +
-	"\x11ChangeStatusReply\"[\n" +
-	"\x15UpdatePasswordRequest\x12 \n" +
-	"\vnewPassword\x18\x01 \x01(\tR\vnewPassword\x12 \n" +
-	"\voldPassword\x18\x02 \x01(\tR\voldPassword\"\x15\n" +
+	"\x11ChangeStatusReply\"g\n" +
+	"\x15UpdatePasswordRequest\x12&\n" +
+	"\vnewPassword\x18\x01 \x01(\tB\x04\x88\xb2\x19\x01R\vnewPassword\x12&\n" +
+	"\voldPassword\x18\x02 \x01(\tB\x04\x88\xb2\x19\x01R\voldPassword\"\x15\n" +
 	"\x13UpdatePasswordReply\"\x15\n" +
 	"\x13FindPostInitRequest\"o\n" +
 	"\x11FindPostInitReply\x12,\n" +
@@ -2151,10 +2853,44 @@ const file_sys_user_proto_rawDesc = "" +
 	"\x15FindUserRolePostReply\x12,\n" +
 	"\x05roles\x18\x01 \x03(\v2\x16.api.admin.v1.RoleDataR\x05roles\x12,\n" +
 	"\x05posts\x18\x02 \x03(\v2\x16.api.admin.v1.PostDataR\x05posts\"\x1d\n" +
-	"\x1bFindUserGoogleSecretRequest\"K\n" +
-	"\x19FindUserGoogleSecretReply\x12\x16\n" +
-	"\x06secret\x18\x01 \x01(\tR\x06secret\x12\x16\n" +
-	"\x06qrcode\x18\x02 \x01(\tR\x06qrcode2\xaf\f\n" +
+	"\x1bFindUserGoogleSecretRequest\"W\n" +
+	"\x19FindUserGoogleSecretReply\x12\x1c\n" +
+	"\x06secret\x18\x01 \x01(\tB\x04\x88\xb2\x19\x01R\x06secret\x12\x1c\n" +
+	"\x06qrcode\x18\x02 \x01(\tB\x04\x88\xb2\x19\x01R\x06qrcode\"<\n" +
+	"\x19ImpersonateSysUserRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\"M\n" +
+	"\x17ImpersonateSysUserReply\x12\x1a\n" +
+	"\x05token\x18\x01 \x01(\tB\x04\x88\xb2\x19\x01R\x05token\x12\x16\n" +
+	"\x06expire\x18\x02 \x01(\x03R\x06expire\"D\n" +
+	"\x13UpdateLocaleRequest\x12-\n" +
+	"\x06locale\x18\x01 \x01(\tB\x15\xfaB\x12r\x10R\x00R\x05zh-CNR\x05en-USR\x06locale\"G\n" +
+	"\x11UpdateLocaleReply\x12\x1a\n" +
+	"\x05token\x18\x01 \x01(\tB\x04\x88\xb2\x19\x01R\x05token\x12\x16\n" +
+	"\x06expire\x18\x02 \x01(\x03R\x06expire\"\x82\x01\n" +
+	"\x15ImportSysUsersRequest\x12*\n" +
+	"\x06format\x18\x01 \x01(\tB\x12\xfaB\x0fr\rR\x00R\x04xlsxR\x03csvR\x06format\x12%\n" +
+	"\acontent\x18\x02 \x01(\tB\v\xfaB\x04r\x02\x10\x01\x88\xb2\x19\x01R\acontent\x12\x16\n" +
+	"\x06dryRun\x18\x03 \x01(\bR\x06dryRun\"\xcf\x01\n" +
+	"\x13ImportSysUsersReply\x12\x14\n" +
+	"\x05total\x18\x01 \x01(\x05R\x05total\x12\x14\n" +
+	"\x05valid\x18\x02 \x01(\x05R\x05valid\x12\x18\n" +
+	"\ainvalid\x18\x03 \x01(\x05R\ainvalid\x12\x18\n" +
+	"\acreated\x18\x04 \x01(\x05R\acreated\x122\n" +
+	"\x04rows\x18\x05 \x03(\v2\x1e.api.admin.v1.UserImportResultR\x04rows\x12$\n" +
+	"\rpasswordToken\x18\x06 \x01(\tR\rpasswordToken\"\x84\x01\n" +
+	"\x10UserImportResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x16\n" +
+	"\x06errors\x18\x03 \x03(\tR\x06errors\x12\x18\n" +
+	"\acreated\x18\x05 \x01(\bR\acreatedJ\x04\b\x04\x10\x05R\bpassword\"g\n" +
+	"\x1bResetSysUserPasswordRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\x12'\n" +
+	"\bpassword\x18\x02 \x01(\tB\v\xfaB\x04r\x02\x18\x1e\x88\xb2\x19\x01R\bpassword\"=\n" +
+	"\x19ResetSysUserPasswordReply\x12 \n" +
+	"\bpassword\x18\x01 \x01(\tB\x04\x88\xb2\x19\x01R\bpassword\"?\n" +
+	"\x1cRevokeSysUserSessionsRequest\x12\x1f\n" +
+	"\x06userId\x18\x01 \x01(\x03B\a\xfaB\x04\"\x02 \x00R\x06userId\"\x1c\n" +
+	"\x1aRevokeSysUserSessionsReply2\xd7\x11\n" +
 	"\aSysUser\x12n\n" +
 	"\rCreateSysUser\x12\".api.admin.v1.CreateSysUserRequest\x1a .api.admin.v1.CreateSysUserReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\"\f/system/user\x12n\n" +
 	"\rUpdateSysUser\x12\".api.admin.v1.UpdateSysUserRequest\x1a .api.admin.v1.UpdateSysUserReply\"\x17\x82\xd3\xe4\x93\x02\x11:\x01*\x1a\f/system/user\x12p\n" +
@@ -2169,7 +2905,12 @@ const file_sys_user_proto_rawDesc = "" +
 	"\x0eUpdatePassword\x12#.api.admin.v1.UpdatePasswordRequest\x1a!.api.admin.v1.UpdatePasswordReply\"\x1b\x82\xd3\xe4\x93\x02\x15:\x01*\x1a\x10/system/user/pwd\x12p\n" +
 	"\fFindPostInit\x12!.api.admin.v1.FindPostInitRequest\x1a\x1f.api.admin.v1.FindPostInitReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/system/user/getInit\x12|\n" +
 	"\x10FindUserRolePost\x12%.api.admin.v1.FindUserRolePostRequest\x1a#.api.admin.v1.FindUserRolePostReply\"\x1c\x82\xd3\xe4\x93\x02\x16\x12\x14/system/user/getRoPo\x12\x87\x01\n" +
-	"\x14FindUserGoogleSecret\x12).api.admin.v1.FindUserGoogleSecretRequest\x1a'.api.admin.v1.FindUserGoogleSecretReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/system/user/secretB6Z4github.com/swordkee/kratos-vue-admin/api/admin/v1;v1b\x06proto3"
+	"\x14FindUserGoogleSecret\x12).api.admin.v1.FindUserGoogleSecretRequest\x1a'.api.admin.v1.FindUserGoogleSecretReply\"\x1b\x82\xd3\xe4\x93\x02\x15\x12\x13/system/user/secret\x12\x89\x01\n" +
+	"\x12ImpersonateSysUser\x12'.api.admin.v1.ImpersonateSysUserRequest\x1a%.api.admin.v1.ImpersonateSysUserReply\"#\x82\xd3\xe4\x93\x02\x1d:\x01*\"\x18/system/user/impersonate\x12r\n" +
+	"\fUpdateLocale\x12!.api.admin.v1.UpdateLocaleRequest\x1a\x1f.api.admin.v1.UpdateLocaleReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\x1a\x13/system/user/locale\x12x\n" +
+	"\x0eImportSysUsers\x12#.api.admin.v1.ImportSysUsersRequest\x1a!.api.admin.v1.ImportSysUsersReply\"\x1e\x82\xd3\xe4\x93\x02\x18:\x01*\"\x13/system/user/import\x12\x92\x01\n" +
+	"\x14ResetSysUserPassword\x12).api.admin.v1.ResetSysUserPasswordRequest\x1a'.api.admin.v1.ResetSysUserPasswordReply\"&\x82\xd3\xe4\x93\x02 :\x01*\x1a\x1b/system/user/password/reset\x12\x96\x01\n" +
+	"\x15RevokeSysUserSessions\x12*.api.admin.v1.RevokeSysUserSessionsRequest\x1a(.api.admin.v1.RevokeSysUserSessionsReply\"'\x82\xd3\xe4\x93\x02!:\x01*\"\x1c/system/user/sessions/revokeB6Z4github.com/swordkee/kratos-vue-admin/api/admin/v1;v1b\x06proto3"
 
 var (
 	file_sys_user_proto_rawDescOnce sync.Once
@@ -2183,101 +2924,125 @@ func file_sys_user_proto_rawDescGZIP() []byte {
 	return file_sys_user_proto_rawDescData
 }
 
-var file_sys_user_proto_msgTypes = make([]protoimpl.MessageInfo, 30)
+var file_sys_user_proto_msgTypes = make([]protoimpl.MessageInfo, 42)
 var file_sys_user_proto_goTypes = []any{
-	(*CreateSysUserRequest)(nil),        // 0: api.admin.v1.CreateSysUserRequest
-	(*CreateSysUserReply)(nil),          // 1: api.admin.v1.CreateSysUserReply
-	(*UpdateSysUserRequest)(nil),        // 2: api.admin.v1.UpdateSysUserRequest
-	(*UpdateSysUserReply)(nil),          // 3: api.admin.v1.UpdateSysUserReply
-	(*DeleteSysUserRequest)(nil),        // 4: api.admin.v1.DeleteSysUserRequest
-	(*DeleteSysUserReply)(nil),          // 5: api.admin.v1.DeleteSysUserReply
-	(*FindSysUserRequest)(nil),          // 6: api.admin.v1.FindSysUserRequest
-	(*FindSysUserReply)(nil),            // 7: api.admin.v1.FindSysUserReply
-	(*ListSysUserRequest)(nil),          // 8: api.admin.v1.ListSysUserRequest
-	(*ListSysUserReply)(nil),            // 9: api.admin.v1.ListSysUserReply
-	(*FindCaptchaRequest)(nil),          // 10: api.admin.v1.FindCaptchaRequest
-	(*FindCaptchaReply)(nil),            // 11: api.admin.v1.FindCaptchaReply
-	(*LoginRequest)(nil),                // 12: api.admin.v1.LoginRequest
-	(*LoginReply)(nil),                  // 13: api.admin.v1.LoginReply
-	(*LogoutRequest)(nil),               // 14: api.admin.v1.LogoutRequest
-	(*LogoutReply)(nil),                 // 15: api.admin.v1.LogoutReply
-	(*AuthRequest)(nil),                 // 16: api.admin.v1.AuthRequest
-	(*AuthReply)(nil),                   // 17: api.admin.v1.AuthReply
-	(*ChangeStatusRequest)(nil),         // 18: api.admin.v1.ChangeStatusRequest
-	(*ChangeStatusReply)(nil),           // 19: api.admin.v1.ChangeStatusReply
-	(*UpdatePasswordRequest)(nil),       // 20: api.admin.v1.UpdatePasswordRequest
-	(*UpdatePasswordReply)(nil),         // 21: api.admin.v1.UpdatePasswordReply
-	(*FindPostInitRequest)(nil),         // 22: api.admin.v1.FindPostInitRequest
-	(*FindPostInitReply)(nil),           // 23: api.admin.v1.FindPostInitReply
-	(*FindUserRolePostRequest)(nil),     // 24: api.admin.v1.FindUserRolePostRequest
-	(*FindUserRolePostReply)(nil),       // 25: api.admin.v1.FindUserRolePostReply
-	(*FindUserGoogleSecretRequest)(nil), // 26: api.admin.v1.FindUserGoogleSecretRequest
-	(*FindUserGoogleSecretReply)(nil),   // 27: api.admin.v1.FindUserGoogleSecretReply
-	(*AuthReply_User)(nil),              // 28: api.admin.v1.AuthReply.User
-	(*AuthReply_Role)(nil),              // 29: api.admin.v1.AuthReply.Role
-	(*timestamppb.Timestamp)(nil),       // 30: google.protobuf.Timestamp
-	(*UserData)(nil),                    // 31: api.admin.v1.UserData
-	(*RoleData)(nil),                    // 32: api.admin.v1.RoleData
-	(*PostData)(nil),                    // 33: api.admin.v1.PostData
-	(*DeptTree)(nil),                    // 34: api.admin.v1.DeptTree
-	(*MenuTreeAuth)(nil),                // 35: api.admin.v1.MenuTreeAuth
-	(*anypb.Any)(nil),                   // 36: google.protobuf.Any
+	(*CreateSysUserRequest)(nil),         // 0: api.admin.v1.CreateSysUserRequest
+	(*CreateSysUserReply)(nil),           // 1: api.admin.v1.CreateSysUserReply
+	(*UpdateSysUserRequest)(nil),         // 2: api.admin.v1.UpdateSysUserRequest
+	(*UpdateSysUserReply)(nil),           // 3: api.admin.v1.UpdateSysUserReply
+	(*DeleteSysUserRequest)(nil),         // 4: api.admin.v1.DeleteSysUserRequest
+	(*DeleteSysUserReply)(nil),           // 5: api.admin.v1.DeleteSysUserReply
+	(*FindSysUserRequest)(nil),           // 6: api.admin.v1.FindSysUserRequest
+	(*FindSysUserReply)(nil),             // 7: api.admin.v1.FindSysUserReply
+	(*ListSysUserRequest)(nil),           // 8: api.admin.v1.ListSysUserRequest
+	(*ListSysUserReply)(nil),             // 9: api.admin.v1.ListSysUserReply
+	(*FindCaptchaRequest)(nil),           // 10: api.admin.v1.FindCaptchaRequest
+	(*FindCaptchaReply)(nil),             // 11: api.admin.v1.FindCaptchaReply
+	(*LoginRequest)(nil),                 // 12: api.admin.v1.LoginRequest
+	(*LoginReply)(nil),                   // 13: api.admin.v1.LoginReply
+	(*LogoutRequest)(nil),                // 14: api.admin.v1.LogoutRequest
+	(*LogoutReply)(nil),                  // 15: api.admin.v1.LogoutReply
+	(*AuthRequest)(nil),                  // 16: api.admin.v1.AuthRequest
+	(*AuthReply)(nil),                    // 17: api.admin.v1.AuthReply
+	(*ChangeStatusRequest)(nil),          // 18: api.admin.v1.ChangeStatusRequest
+	(*ChangeStatusReply)(nil),            // 19: api.admin.v1.ChangeStatusReply
+	(*UpdatePasswordRequest)(nil),        // 20: api.admin.v1.UpdatePasswordRequest
+	(*UpdatePasswordReply)(nil),          // 21: api.admin.v1.UpdatePasswordReply
+	(*FindPostInitRequest)(nil),          // 22: api.admin.v1.FindPostInitRequest
+	(*FindPostInitReply)(nil),            // 23: api.admin.v1.FindPostInitReply
+	(*FindUserRolePostRequest)(nil),      // 24: api.admin.v1.FindUserRolePostRequest
+	(*FindUserRolePostReply)(nil),        // 25: api.admin.v1.FindUserRolePostReply
+	(*FindUserGoogleSecretRequest)(nil),  // 26: api.admin.v1.FindUserGoogleSecretRequest
+	(*FindUserGoogleSecretReply)(nil),    // 27: api.admin.v1.FindUserGoogleSecretReply
+	(*ImpersonateSysUserRequest)(nil),    // 28: api.admin.v1.ImpersonateSysUserRequest
+	(*ImpersonateSysUserReply)(nil),      // 29: api.admin.v1.ImpersonateSysUserReply
+	(*UpdateLocaleRequest)(nil),          // 30: api.admin.v1.UpdateLocaleRequest
+	(*UpdateLocaleReply)(nil),            // 31: api.admin.v1.UpdateLocaleReply
+	(*ImportSysUsersRequest)(nil),        // 32: api.admin.v1.ImportSysUsersRequest
+	(*ImportSysUsersReply)(nil),          // 33: api.admin.v1.ImportSysUsersReply
+	(*UserImportResult)(nil),             // 34: api.admin.v1.UserImportResult
+	(*ResetSysUserPasswordRequest)(nil),  // 35: api.admin.v1.ResetSysUserPasswordRequest
+	(*ResetSysUserPasswordReply)(nil),    // 36: api.admin.v1.ResetSysUserPasswordReply
+	(*RevokeSysUserSessionsRequest)(nil), // 37: api.admin.v1.RevokeSysUserSessionsRequest
+	(*RevokeSysUserSessionsReply)(nil),   // 38: api.admin.v1.RevokeSysUserSessionsReply
+	(*AuthReply_User)(nil),               // 39: api.admin.v1.AuthReply.User
+	(*AuthReply_Role)(nil),               // 40: api.admin.v1.AuthReply.Role
+	(*AuthReply_Impersonator)(nil),       // 41: api.admin.v1.AuthReply.Impersonator
+	(*timestamppb.Timestamp)(nil),        // 42: google.protobuf.Timestamp
+	(*UserData)(nil),                     // 43: api.admin.v1.UserData
+	(*RoleData)(nil),                     // 44: api.admin.v1.RoleData
+	(*PostData)(nil),                     // 45: api.admin.v1.PostData
+	(*DeptTree)(nil),                     // 46: api.admin.v1.DeptTree
+	(*MenuTreeAuth)(nil),                 // 47: api.admin.v1.MenuTreeAuth
+	(*anypb.Any)(nil),                    // 48: google.protobuf.Any
 }
 var file_sys_user_proto_depIdxs = []int32{
-	30, // 0: api.admin.v1.UpdateSysUserRequest.createdAt:type_name -> google.protobuf.Timestamp
-	30, // 1: api.admin.v1.UpdateSysUserRequest.updatedAt:type_name -> google.protobuf.Timestamp
-	31, // 2: api.admin.v1.FindSysUserReply.user:type_name -> api.admin.v1.UserData
-	32, // 3: api.admin.v1.FindSysUserReply.roles:type_name -> api.admin.v1.RoleData
-	33, // 4: api.admin.v1.FindSysUserReply.posts:type_name -> api.admin.v1.PostData
-	34, // 5: api.admin.v1.FindSysUserReply.depts:type_name -> api.admin.v1.DeptTree
-	31, // 6: api.admin.v1.ListSysUserReply.data:type_name -> api.admin.v1.UserData
-	28, // 7: api.admin.v1.AuthReply.user:type_name -> api.admin.v1.AuthReply.User
-	29, // 8: api.admin.v1.AuthReply.role:type_name -> api.admin.v1.AuthReply.Role
-	35, // 9: api.admin.v1.AuthReply.menus:type_name -> api.admin.v1.MenuTreeAuth
-	32, // 10: api.admin.v1.FindPostInitReply.roles:type_name -> api.admin.v1.RoleData
-	33, // 11: api.admin.v1.FindPostInitReply.posts:type_name -> api.admin.v1.PostData
-	32, // 12: api.admin.v1.FindUserRolePostReply.roles:type_name -> api.admin.v1.RoleData
-	33, // 13: api.admin.v1.FindUserRolePostReply.posts:type_name -> api.admin.v1.PostData
-	30, // 14: api.admin.v1.AuthReply.User.createdAt:type_name -> google.protobuf.Timestamp
-	30, // 15: api.admin.v1.AuthReply.User.updatedAt:type_name -> google.protobuf.Timestamp
-	36, // 16: api.admin.v1.AuthReply.Role.apiIds:type_name -> google.protobuf.Any
-	36, // 17: api.admin.v1.AuthReply.Role.menuIds:type_name -> google.protobuf.Any
-	36, // 18: api.admin.v1.AuthReply.Role.deptIds:type_name -> google.protobuf.Any
-	30, // 19: api.admin.v1.AuthReply.Role.createdAt:type_name -> google.protobuf.Timestamp
-	30, // 20: api.admin.v1.AuthReply.Role.updatedAt:type_name -> google.protobuf.Timestamp
-	0,  // 21: api.admin.v1.SysUser.CreateSysUser:input_type -> api.admin.v1.CreateSysUserRequest
-	2,  // 22: api.admin.v1.SysUser.UpdateSysUser:input_type -> api.admin.v1.UpdateSysUserRequest
-	4,  // 23: api.admin.v1.SysUser.DeleteSysUser:input_type -> api.admin.v1.DeleteSysUserRequest
-	6,  // 24: api.admin.v1.SysUser.FindSysUser:input_type -> api.admin.v1.FindSysUserRequest
-	8,  // 25: api.admin.v1.SysUser.ListSysUser:input_type -> api.admin.v1.ListSysUserRequest
-	10, // 26: api.admin.v1.SysUser.FindCaptcha:input_type -> api.admin.v1.FindCaptchaRequest
-	12, // 27: api.admin.v1.SysUser.Login:input_type -> api.admin.v1.LoginRequest
-	14, // 28: api.admin.v1.SysUser.Logout:input_type -> api.admin.v1.LogoutRequest
-	16, // 29: api.admin.v1.SysUser.Auth:input_type -> api.admin.v1.AuthRequest
-	18, // 30: api.admin.v1.SysUser.ChangeStatus:input_type -> api.admin.v1.ChangeStatusRequest
-	20, // 31: api.admin.v1.SysUser.UpdatePassword:input_type -> api.admin.v1.UpdatePasswordRequest
-	22, // 32: api.admin.v1.SysUser.FindPostInit:input_type -> api.admin.v1.FindPostInitRequest
-	24, // 33: api.admin.v1.SysUser.FindUserRolePost:input_type -> api.admin.v1.FindUserRolePostRequest
-	26, // 34: api.admin.v1.SysUser.FindUserGoogleSecret:input_type -> api.admin.v1.FindUserGoogleSecretRequest
-	1,  // 35: api.admin.v1.SysUser.CreateSysUser:output_type -> api.admin.v1.CreateSysUserReply
-	3,  // 36: api.admin.v1.SysUser.UpdateSysUser:output_type -> api.admin.v1.UpdateSysUserReply
-	5,  // 37: api.admin.v1.SysUser.DeleteSysUser:output_type -> api.admin.v1.DeleteSysUserReply
-	7,  // 38: api.admin.v1.SysUser.FindSysUser:output_type -> api.admin.v1.FindSysUserReply
-	9,  // 39: api.admin.v1.SysUser.ListSysUser:output_type -> api.admin.v1.ListSysUserReply
-	11, // 40: api.admin.v1.SysUser.FindCaptcha:output_type -> api.admin.v1.FindCaptchaReply
-	13, // 41: api.admin.v1.SysUser.Login:output_type -> api.admin.v1.LoginReply
-	15, // 42: api.admin.v1.SysUser.Logout:output_type -> api.admin.v1.LogoutReply
-	17, // 43: api.admin.v1.SysUser.Auth:output_type -> api.admin.v1.AuthReply
-	19, // 44: api.admin.v1.SysUser.ChangeStatus:output_type -> api.admin.v1.ChangeStatusReply
-	21, // 45: api.admin.v1.SysUser.UpdatePassword:output_type -> api.admin.v1.UpdatePasswordReply
-	23, // 46: api.admin.v1.SysUser.FindPostInit:output_type -> api.admin.v1.FindPostInitReply
-	25, // 47: api.admin.v1.SysUser.FindUserRolePost:output_type -> api.admin.v1.FindUserRolePostReply
-	27, // 48: api.admin.v1.SysUser.FindUserGoogleSecret:output_type -> api.admin.v1.FindUserGoogleSecretReply
-	35, // [35:49] is the sub-list for method output_type
-	21, // [21:35] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	42, // 0: api.admin.v1.UpdateSysUserRequest.createdAt:type_name -> google.protobuf.Timestamp
+	42, // 1: api.admin.v1.UpdateSysUserRequest.updatedAt:type_name -> google.protobuf.Timestamp
+	43, // 2: api.admin.v1.FindSysUserReply.user:type_name -> api.admin.v1.UserData
+	44, // 3: api.admin.v1.FindSysUserReply.roles:type_name -> api.admin.v1.RoleData
+	45, // 4: api.admin.v1.FindSysUserReply.posts:type_name -> api.admin.v1.PostData
+	46, // 5: api.admin.v1.FindSysUserReply.depts:type_name -> api.admin.v1.DeptTree
+	43, // 6: api.admin.v1.ListSysUserReply.data:type_name -> api.admin.v1.UserData
+	39, // 7: api.admin.v1.AuthReply.user:type_name -> api.admin.v1.AuthReply.User
+	40, // 8: api.admin.v1.AuthReply.role:type_name -> api.admin.v1.AuthReply.Role
+	47, // 9: api.admin.v1.AuthReply.menus:type_name -> api.admin.v1.MenuTreeAuth
+	41, // 10: api.admin.v1.AuthReply.impersonator:type_name -> api.admin.v1.AuthReply.Impersonator
+	44, // 11: api.admin.v1.FindPostInitReply.roles:type_name -> api.admin.v1.RoleData
+	45, // 12: api.admin.v1.FindPostInitReply.posts:type_name -> api.admin.v1.PostData
+	44, // 13: api.admin.v1.FindUserRolePostReply.roles:type_name -> api.admin.v1.RoleData
+	45, // 14: api.admin.v1.FindUserRolePostReply.posts:type_name -> api.admin.v1.PostData
+	34, // 15: api.admin.v1.ImportSysUsersReply.rows:type_name -> api.admin.v1.UserImportResult
+	42, // 16: api.admin.v1.AuthReply.User.createdAt:type_name -> google.protobuf.Timestamp
+	42, // 17: api.admin.v1.AuthReply.User.updatedAt:type_name -> google.protobuf.Timestamp
+	48, // 18: api.admin.v1.AuthReply.Role.apiIds:type_name -> google.protobuf.Any
+	48, // 19: api.admin.v1.AuthReply.Role.menuIds:type_name -> google.protobuf.Any
+	48, // 20: api.admin.v1.AuthReply.Role.deptIds:type_name -> google.protobuf.Any
+	42, // 21: api.admin.v1.AuthReply.Role.createdAt:type_name -> google.protobuf.Timestamp
+	42, // 22: api.admin.v1.AuthReply.Role.updatedAt:type_name -> google.protobuf.Timestamp
+	0,  // 23: api.admin.v1.SysUser.CreateSysUser:input_type -> api.admin.v1.CreateSysUserRequest
+	2,  // 24: api.admin.v1.SysUser.UpdateSysUser:input_type -> api.admin.v1.UpdateSysUserRequest
+	4,  // 25: api.admin.v1.SysUser.DeleteSysUser:input_type -> api.admin.v1.DeleteSysUserRequest
+	6,  // 26: api.admin.v1.SysUser.FindSysUser:input_type -> api.admin.v1.FindSysUserRequest
+	8,  // 27: api.admin.v1.SysUser.ListSysUser:input_type -> api.admin.v1.ListSysUserRequest
+	10, // 28: api.admin.v1.SysUser.FindCaptcha:input_type -> api.admin.v1.FindCaptchaRequest
+	12, // 29: api.admin.v1.SysUser.Login:input_type -> api.admin.v1.LoginRequest
+	14, // 30: api.admin.v1.SysUser.Logout:input_type -> api.admin.v1.LogoutRequest
+	16, // 31: api.admin.v1.SysUser.Auth:input_type -> api.admin.v1.AuthRequest
+	18, // 32: api.admin.v1.SysUser.ChangeStatus:input_type -> api.admin.v1.ChangeStatusRequest
+	20, // 33: api.admin.v1.SysUser.UpdatePassword:input_type -> api.admin.v1.UpdatePasswordRequest
+	22, // 34: api.admin.v1.SysUser.FindPostInit:input_type -> api.admin.v1.FindPostInitRequest
+	24, // 35: api.admin.v1.SysUser.FindUserRolePost:input_type -> api.admin.v1.FindUserRolePostRequest
+	26, // 36: api.admin.v1.SysUser.FindUserGoogleSecret:input_type -> api.admin.v1.FindUserGoogleSecretRequest
+	28, // 37: api.admin.v1.SysUser.ImpersonateSysUser:input_type -> api.admin.v1.ImpersonateSysUserRequest
+	30, // 38: api.admin.v1.SysUser.UpdateLocale:input_type -> api.admin.v1.UpdateLocaleRequest
+	32, // 39: api.admin.v1.SysUser.ImportSysUsers:input_type -> api.admin.v1.ImportSysUsersRequest
+	35, // 40: api.admin.v1.SysUser.ResetSysUserPassword:input_type -> api.admin.v1.ResetSysUserPasswordRequest
+	37, // 41: api.admin.v1.SysUser.RevokeSysUserSessions:input_type -> api.admin.v1.RevokeSysUserSessionsRequest
+	1,  // 42: api.admin.v1.SysUser.CreateSysUser:output_type -> api.admin.v1.CreateSysUserReply
+	3,  // 43: api.admin.v1.SysUser.UpdateSysUser:output_type -> api.admin.v1.UpdateSysUserReply
+	5,  // 44: api.admin.v1.SysUser.DeleteSysUser:output_type -> api.admin.v1.DeleteSysUserReply
+	7,  // 45: api.admin.v1.SysUser.FindSysUser:output_type -> api.admin.v1.FindSysUserReply
+	9,  // 46: api.admin.v1.SysUser.ListSysUser:output_type -> api.admin.v1.ListSysUserReply
+	11, // 47: api.admin.v1.SysUser.FindCaptcha:output_type -> api.admin.v1.FindCaptchaReply
+	13, // 48: api.admin.v1.SysUser.Login:output_type -> api.admin.v1.LoginReply
+	15, // 49: api.admin.v1.SysUser.Logout:output_type -> api.admin.v1.LogoutReply
+	17, // 50: api.admin.v1.SysUser.Auth:output_type -> api.admin.v1.AuthReply
+	19, // 51: api.admin.v1.SysUser.ChangeStatus:output_type -> api.admin.v1.ChangeStatusReply
+	21, // 52: api.admin.v1.SysUser.UpdatePassword:output_type -> api.admin.v1.UpdatePasswordReply
+	23, // 53: api.admin.v1.SysUser.FindPostInit:output_type -> api.admin.v1.FindPostInitReply
+	25, // 54: api.admin.v1.SysUser.FindUserRolePost:output_type -> api.admin.v1.FindUserRolePostReply
+	27, // 55: api.admin.v1.SysUser.FindUserGoogleSecret:output_type -> api.admin.v1.FindUserGoogleSecretReply
+	29, // 56: api.admin.v1.SysUser.ImpersonateSysUser:output_type -> api.admin.v1.ImpersonateSysUserReply
+	31, // 57: api.admin.v1.SysUser.UpdateLocale:output_type -> api.admin.v1.UpdateLocaleReply
+	33, // 58: api.admin.v1.SysUser.ImportSysUsers:output_type -> api.admin.v1.ImportSysUsersReply
+	36, // 59: api.admin.v1.SysUser.ResetSysUserPassword:output_type -> api.admin.v1.ResetSysUserPasswordReply
+	38, // 60: api.admin.v1.SysUser.RevokeSysUserSessions:output_type -> api.admin.v1.RevokeSysUserSessionsReply
+	42, // [42:61] is the sub-list for method output_type
+	23, // [23:42] is the sub-list for method input_type
+	23, // [23:23] is the sub-list for extension type_name
+	23, // [23:23] is the sub-list for extension extendee
+	0,  // [0:23] is the sub-list for field type_name
 }
 
 func init() { file_sys_user_proto_init() }
@@ -2286,13 +3051,14 @@ func file_sys_user_proto_init() {
 		return
 	}
 	file_base_proto_init()
+	file_options_proto_init()
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_sys_user_proto_rawDesc), len(file_sys_user_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   30,
+			NumMessages:   42,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
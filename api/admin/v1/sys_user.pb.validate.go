@@ -1744,6 +1744,8 @@ func (m *LoginReply) validate(all bool) error {
 
 	// no validation rules for Expire
 
+	// no validation rules for MustChangePassword
+
 	if len(errors) > 0 {
 		return LoginReplyMultiError(errors)
 	}
@@ -2235,6 +2237,37 @@ func (m *AuthReply) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetImpersonator()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthReplyValidationError{
+					field:  "Impersonator",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthReplyValidationError{
+					field:  "Impersonator",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetImpersonator()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthReplyValidationError{
+				field:  "Impersonator",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Locale
+
 	if len(errors) > 0 {
 		return AuthReplyMultiError(errors)
 	}
@@ -3481,138 +3514,161 @@ var _ interface {
 	ErrorName() string
 } = FindUserGoogleSecretReplyValidationError{}
 
-// Validate checks the field values on AuthReply_User with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AuthReply_User) Validate() error {
+// Validate checks the field values on ImpersonateSysUserRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ImpersonateSysUserRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthReply_User with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AuthReply_UserMultiError,
-// or nil if none found.
-func (m *AuthReply_User) ValidateAll() error {
+// ValidateAll checks the field values on ImpersonateSysUserRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImpersonateSysUserRequestMultiError, or nil if none found.
+func (m *ImpersonateSysUserRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthReply_User) validate(all bool) error {
+func (m *ImpersonateSysUserRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for UserId
+	if m.GetUserId() <= 0 {
+		err := ImpersonateSysUserRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for NickName
+	if len(errors) > 0 {
+		return ImpersonateSysUserRequestMultiError(errors)
+	}
 
-	// no validation rules for Phone
+	return nil
+}
 
-	// no validation rules for RoleId
+// ImpersonateSysUserRequestMultiError is an error wrapping multiple validation
+// errors returned by ImpersonateSysUserRequest.ValidateAll() if the
+// designated constraints aren't met.
+type ImpersonateSysUserRequestMultiError []error
 
-	// no validation rules for Salt
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImpersonateSysUserRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for Avatar
+// AllErrors returns a list of validation violation errors.
+func (m ImpersonateSysUserRequestMultiError) AllErrors() []error { return m }
 
-	// no validation rules for Sex
+// ImpersonateSysUserRequestValidationError is the validation error returned by
+// ImpersonateSysUserRequest.Validate if the designated constraints aren't met.
+type ImpersonateSysUserRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for Email
+// Field function returns field value.
+func (e ImpersonateSysUserRequestValidationError) Field() string { return e.field }
 
-	// no validation rules for DeptId
+// Reason function returns reason value.
+func (e ImpersonateSysUserRequestValidationError) Reason() string { return e.reason }
 
-	// no validation rules for PostId
+// Cause function returns cause value.
+func (e ImpersonateSysUserRequestValidationError) Cause() error { return e.cause }
 
-	// no validation rules for RoleIds
+// Key function returns key value.
+func (e ImpersonateSysUserRequestValidationError) Key() bool { return e.key }
 
-	// no validation rules for PostIds
+// ErrorName returns error name.
+func (e ImpersonateSysUserRequestValidationError) ErrorName() string {
+	return "ImpersonateSysUserRequestValidationError"
+}
 
-	// no validation rules for CreateBy
+// Error satisfies the builtin error interface
+func (e ImpersonateSysUserRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
 
-	// no validation rules for UpdateBy
+	key := ""
+	if e.key {
+		key = "key for "
+	}
 
-	// no validation rules for Remark
+	return fmt.Sprintf(
+		"invalid %sImpersonateSysUserRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
 
-	// no validation rules for Status
+var _ error = ImpersonateSysUserRequestValidationError{}
 
-	// no validation rules for Username
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImpersonateSysUserRequestValidationError{}
 
-	// no validation rules for Password
+// Validate checks the field values on ImpersonateSysUserReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ImpersonateSysUserReply) Validate() error {
+	return m.validate(false)
+}
 
-	// no validation rules for RoleName
+// ValidateAll checks the field values on ImpersonateSysUserReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImpersonateSysUserReplyMultiError, or nil if none found.
+func (m *ImpersonateSysUserReply) ValidateAll() error {
+	return m.validate(true)
+}
 
-	if all {
-		switch v := interface{}(m.GetCreatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthReply_UserValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthReply_UserValidationError{
-					field:  "CreatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AuthReply_UserValidationError{
-				field:  "CreatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
+func (m *ImpersonateSysUserReply) validate(all bool) error {
+	if m == nil {
+		return nil
 	}
 
-	if all {
-		switch v := interface{}(m.GetUpdatedAt()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthReply_UserValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		case interface{ Validate() error }:
-			if err := v.Validate(); err != nil {
-				errors = append(errors, AuthReply_UserValidationError{
-					field:  "UpdatedAt",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
-			}
-		}
-	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
-		if err := v.Validate(); err != nil {
-			return AuthReply_UserValidationError{
-				field:  "UpdatedAt",
-				reason: "embedded message failed validation",
-				cause:  err,
-			}
-		}
-	}
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for Expire
 
 	if len(errors) > 0 {
-		return AuthReply_UserMultiError(errors)
+		return ImpersonateSysUserReplyMultiError(errors)
 	}
 
 	return nil
 }
 
-// AuthReply_UserMultiError is an error wrapping multiple validation errors
-// returned by AuthReply_User.ValidateAll() if the designated constraints
-// aren't met.
-type AuthReply_UserMultiError []error
+// ImpersonateSysUserReplyMultiError is an error wrapping multiple validation
+// errors returned by ImpersonateSysUserReply.ValidateAll() if the designated
+// constraints aren't met.
+type ImpersonateSysUserReplyMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m AuthReply_UserMultiError) Error() string {
+func (m ImpersonateSysUserReplyMultiError) Error() string {
 	msgs := make([]string, 0, len(m))
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -3621,11 +3677,11 @@ func (m AuthReply_UserMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m AuthReply_UserMultiError) AllErrors() []error { return m }
+func (m ImpersonateSysUserReplyMultiError) AllErrors() []error { return m }
 
-// AuthReply_UserValidationError is the validation error returned by
-// AuthReply_User.Validate if the designated constraints aren't met.
-type AuthReply_UserValidationError struct {
+// ImpersonateSysUserReplyValidationError is the validation error returned by
+// ImpersonateSysUserReply.Validate if the designated constraints aren't met.
+type ImpersonateSysUserReplyValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -3633,22 +3689,24 @@ type AuthReply_UserValidationError struct {
 }
 
 // Field function returns field value.
-func (e AuthReply_UserValidationError) Field() string { return e.field }
+func (e ImpersonateSysUserReplyValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e AuthReply_UserValidationError) Reason() string { return e.reason }
+func (e ImpersonateSysUserReplyValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e AuthReply_UserValidationError) Cause() error { return e.cause }
+func (e ImpersonateSysUserReplyValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e AuthReply_UserValidationError) Key() bool { return e.key }
+func (e ImpersonateSysUserReplyValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e AuthReply_UserValidationError) ErrorName() string { return "AuthReply_UserValidationError" }
+func (e ImpersonateSysUserReplyValidationError) ErrorName() string {
+	return "ImpersonateSysUserReplyValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e AuthReply_UserValidationError) Error() string {
+func (e ImpersonateSysUserReplyValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -3660,14 +3718,14 @@ func (e AuthReply_UserValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sAuthReply_User.%s: %s%s",
+		"invalid %sImpersonateSysUserReply.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = AuthReply_UserValidationError{}
+var _ error = ImpersonateSysUserReplyValidationError{}
 
 var _ interface {
 	Field() string
@@ -3675,57 +3733,1316 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = AuthReply_UserValidationError{}
+} = ImpersonateSysUserReplyValidationError{}
 
-// Validate checks the field values on AuthReply_Role with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *AuthReply_Role) Validate() error {
+// Validate checks the field values on UpdateLocaleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *UpdateLocaleRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on AuthReply_Role with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in AuthReply_RoleMultiError,
-// or nil if none found.
-func (m *AuthReply_Role) ValidateAll() error {
+// ValidateAll checks the field values on UpdateLocaleRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateLocaleRequestMultiError, or nil if none found.
+func (m *UpdateLocaleRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *AuthReply_Role) validate(all bool) error {
+func (m *UpdateLocaleRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for RoleId
+	if _, ok := _UpdateLocaleRequest_Locale_InLookup[m.GetLocale()]; !ok {
+		err := UpdateLocaleRequestValidationError{
+			field:  "Locale",
+			reason: "value must be in list [ zh-CN en-US]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for RoleName
+	if len(errors) > 0 {
+		return UpdateLocaleRequestMultiError(errors)
+	}
 
-	// no validation rules for Status
+	return nil
+}
 
-	// no validation rules for RoleKey
+// UpdateLocaleRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateLocaleRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateLocaleRequestMultiError []error
 
-	// no validation rules for RoleSort
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateLocaleRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
 
-	// no validation rules for DataScope
+// AllErrors returns a list of validation violation errors.
+func (m UpdateLocaleRequestMultiError) AllErrors() []error { return m }
 
-	// no validation rules for CreateBy
+// UpdateLocaleRequestValidationError is the validation error returned by
+// UpdateLocaleRequest.Validate if the designated constraints aren't met.
+type UpdateLocaleRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
 
-	// no validation rules for UpdateBy
+// Field function returns field value.
+func (e UpdateLocaleRequestValidationError) Field() string { return e.field }
 
-	// no validation rules for Remark
+// Reason function returns reason value.
+func (e UpdateLocaleRequestValidationError) Reason() string { return e.reason }
 
-	if all {
-		switch v := interface{}(m.GetApiIds()).(type) {
-		case interface{ ValidateAll() error }:
-			if err := v.ValidateAll(); err != nil {
-				errors = append(errors, AuthReply_RoleValidationError{
-					field:  "ApiIds",
-					reason: "embedded message failed validation",
-					cause:  err,
-				})
+// Cause function returns cause value.
+func (e UpdateLocaleRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateLocaleRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateLocaleRequestValidationError) ErrorName() string {
+	return "UpdateLocaleRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateLocaleRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateLocaleRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateLocaleRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateLocaleRequestValidationError{}
+
+var _UpdateLocaleRequest_Locale_InLookup = map[string]struct{}{
+	"":      {},
+	"zh-CN": {},
+	"en-US": {},
+}
+
+// Validate checks the field values on UpdateLocaleReply with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UpdateLocaleReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateLocaleReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateLocaleReplyMultiError, or nil if none found.
+func (m *UpdateLocaleReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateLocaleReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Token
+
+	// no validation rules for Expire
+
+	if len(errors) > 0 {
+		return UpdateLocaleReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateLocaleReplyMultiError is an error wrapping multiple validation errors
+// returned by UpdateLocaleReply.ValidateAll() if the designated constraints
+// aren't met.
+type UpdateLocaleReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateLocaleReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateLocaleReplyMultiError) AllErrors() []error { return m }
+
+// UpdateLocaleReplyValidationError is the validation error returned by
+// UpdateLocaleReply.Validate if the designated constraints aren't met.
+type UpdateLocaleReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateLocaleReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateLocaleReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateLocaleReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateLocaleReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateLocaleReplyValidationError) ErrorName() string {
+	return "UpdateLocaleReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateLocaleReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateLocaleReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateLocaleReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateLocaleReplyValidationError{}
+
+// Validate checks the field values on ImportSysUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ImportSysUsersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportSysUsersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportSysUsersRequestMultiError, or nil if none found.
+func (m *ImportSysUsersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportSysUsersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ImportSysUsersRequest_Format_InLookup[m.GetFormat()]; !ok {
+		err := ImportSysUsersRequestValidationError{
+			field:  "Format",
+			reason: "value must be in list [ xlsx csv]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetContent()) < 1 {
+		err := ImportSysUsersRequestValidationError{
+			field:  "Content",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for DryRun
+
+	if len(errors) > 0 {
+		return ImportSysUsersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportSysUsersRequestMultiError is an error wrapping multiple validation
+// errors returned by ImportSysUsersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ImportSysUsersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportSysUsersRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportSysUsersRequestMultiError) AllErrors() []error { return m }
+
+// ImportSysUsersRequestValidationError is the validation error returned by
+// ImportSysUsersRequest.Validate if the designated constraints aren't met.
+type ImportSysUsersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportSysUsersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportSysUsersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportSysUsersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportSysUsersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportSysUsersRequestValidationError) ErrorName() string {
+	return "ImportSysUsersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportSysUsersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportSysUsersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportSysUsersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportSysUsersRequestValidationError{}
+
+var _ImportSysUsersRequest_Format_InLookup = map[string]struct{}{
+	"":     {},
+	"xlsx": {},
+	"csv":  {},
+}
+
+// Validate checks the field values on ImportSysUsersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ImportSysUsersReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ImportSysUsersReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ImportSysUsersReplyMultiError, or nil if none found.
+func (m *ImportSysUsersReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ImportSysUsersReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Total
+
+	// no validation rules for Valid
+
+	// no validation rules for Invalid
+
+	// no validation rules for Created
+
+	for idx, item := range m.GetRows() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ImportSysUsersReplyValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ImportSysUsersReplyValidationError{
+						field:  fmt.Sprintf("Rows[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ImportSysUsersReplyValidationError{
+					field:  fmt.Sprintf("Rows[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for PasswordToken
+
+	if len(errors) > 0 {
+		return ImportSysUsersReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ImportSysUsersReplyMultiError is an error wrapping multiple validation
+// errors returned by ImportSysUsersReply.ValidateAll() if the designated
+// constraints aren't met.
+type ImportSysUsersReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ImportSysUsersReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ImportSysUsersReplyMultiError) AllErrors() []error { return m }
+
+// ImportSysUsersReplyValidationError is the validation error returned by
+// ImportSysUsersReply.Validate if the designated constraints aren't met.
+type ImportSysUsersReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ImportSysUsersReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ImportSysUsersReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ImportSysUsersReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ImportSysUsersReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ImportSysUsersReplyValidationError) ErrorName() string {
+	return "ImportSysUsersReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ImportSysUsersReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sImportSysUsersReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ImportSysUsersReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ImportSysUsersReplyValidationError{}
+
+// Validate checks the field values on UserImportResult with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *UserImportResult) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserImportResult with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserImportResultMultiError, or nil if none found.
+func (m *UserImportResult) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserImportResult) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Line
+
+	// no validation rules for Username
+
+	// no validation rules for Created
+
+	if len(errors) > 0 {
+		return UserImportResultMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserImportResultMultiError is an error wrapping multiple validation errors
+// returned by UserImportResult.ValidateAll() if the designated constraints
+// aren't met.
+type UserImportResultMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserImportResultMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserImportResultMultiError) AllErrors() []error { return m }
+
+// UserImportResultValidationError is the validation error returned by
+// UserImportResult.Validate if the designated constraints aren't met.
+type UserImportResultValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserImportResultValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserImportResultValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserImportResultValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserImportResultValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserImportResultValidationError) ErrorName() string { return "UserImportResultValidationError" }
+
+// Error satisfies the builtin error interface
+func (e UserImportResultValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserImportResult.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserImportResultValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserImportResultValidationError{}
+
+// Validate checks the field values on ResetSysUserPasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ResetSysUserPasswordRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetSysUserPasswordRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetSysUserPasswordRequestMultiError, or nil if none found.
+func (m *ResetSysUserPasswordRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetSysUserPasswordRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := ResetSysUserPasswordRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPassword()) > 30 {
+		err := ResetSysUserPasswordRequestValidationError{
+			field:  "Password",
+			reason: "value length must be at most 30 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ResetSysUserPasswordRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetSysUserPasswordRequestMultiError is an error wrapping multiple
+// validation errors returned by ResetSysUserPasswordRequest.ValidateAll() if
+// the designated constraints aren't met.
+type ResetSysUserPasswordRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetSysUserPasswordRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetSysUserPasswordRequestMultiError) AllErrors() []error { return m }
+
+// ResetSysUserPasswordRequestValidationError is the validation error returned
+// by ResetSysUserPasswordRequest.Validate if the designated constraints
+// aren't met.
+type ResetSysUserPasswordRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetSysUserPasswordRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetSysUserPasswordRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetSysUserPasswordRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetSysUserPasswordRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetSysUserPasswordRequestValidationError) ErrorName() string {
+	return "ResetSysUserPasswordRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetSysUserPasswordRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetSysUserPasswordRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetSysUserPasswordRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetSysUserPasswordRequestValidationError{}
+
+// Validate checks the field values on ResetSysUserPasswordReply with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *ResetSysUserPasswordReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ResetSysUserPasswordReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ResetSysUserPasswordReplyMultiError, or nil if none found.
+func (m *ResetSysUserPasswordReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ResetSysUserPasswordReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Password
+
+	if len(errors) > 0 {
+		return ResetSysUserPasswordReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// ResetSysUserPasswordReplyMultiError is an error wrapping multiple validation
+// errors returned by ResetSysUserPasswordReply.ValidateAll() if the
+// designated constraints aren't met.
+type ResetSysUserPasswordReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ResetSysUserPasswordReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ResetSysUserPasswordReplyMultiError) AllErrors() []error { return m }
+
+// ResetSysUserPasswordReplyValidationError is the validation error returned by
+// ResetSysUserPasswordReply.Validate if the designated constraints aren't met.
+type ResetSysUserPasswordReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ResetSysUserPasswordReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ResetSysUserPasswordReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ResetSysUserPasswordReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ResetSysUserPasswordReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ResetSysUserPasswordReplyValidationError) ErrorName() string {
+	return "ResetSysUserPasswordReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ResetSysUserPasswordReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sResetSysUserPasswordReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ResetSysUserPasswordReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ResetSysUserPasswordReplyValidationError{}
+
+// Validate checks the field values on RevokeSysUserSessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RevokeSysUserSessionsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSysUserSessionsRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSysUserSessionsRequestMultiError, or nil if none found.
+func (m *RevokeSysUserSessionsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSysUserSessionsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUserId() <= 0 {
+		err := RevokeSysUserSessionsRequestValidationError{
+			field:  "UserId",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RevokeSysUserSessionsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSysUserSessionsRequestMultiError is an error wrapping multiple
+// validation errors returned by RevokeSysUserSessionsRequest.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeSysUserSessionsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSysUserSessionsRequestMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSysUserSessionsRequestMultiError) AllErrors() []error { return m }
+
+// RevokeSysUserSessionsRequestValidationError is the validation error returned
+// by RevokeSysUserSessionsRequest.Validate if the designated constraints
+// aren't met.
+type RevokeSysUserSessionsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSysUserSessionsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSysUserSessionsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSysUserSessionsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSysUserSessionsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSysUserSessionsRequestValidationError) ErrorName() string {
+	return "RevokeSysUserSessionsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSysUserSessionsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSysUserSessionsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSysUserSessionsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSysUserSessionsRequestValidationError{}
+
+// Validate checks the field values on RevokeSysUserSessionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *RevokeSysUserSessionsReply) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RevokeSysUserSessionsReply with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RevokeSysUserSessionsReplyMultiError, or nil if none found.
+func (m *RevokeSysUserSessionsReply) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RevokeSysUserSessionsReply) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return RevokeSysUserSessionsReplyMultiError(errors)
+	}
+
+	return nil
+}
+
+// RevokeSysUserSessionsReplyMultiError is an error wrapping multiple
+// validation errors returned by RevokeSysUserSessionsReply.ValidateAll() if
+// the designated constraints aren't met.
+type RevokeSysUserSessionsReplyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RevokeSysUserSessionsReplyMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RevokeSysUserSessionsReplyMultiError) AllErrors() []error { return m }
+
+// RevokeSysUserSessionsReplyValidationError is the validation error returned
+// by RevokeSysUserSessionsReply.Validate if the designated constraints aren't met.
+type RevokeSysUserSessionsReplyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RevokeSysUserSessionsReplyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RevokeSysUserSessionsReplyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RevokeSysUserSessionsReplyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RevokeSysUserSessionsReplyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RevokeSysUserSessionsReplyValidationError) ErrorName() string {
+	return "RevokeSysUserSessionsReplyValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RevokeSysUserSessionsReplyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRevokeSysUserSessionsReply.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RevokeSysUserSessionsReplyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RevokeSysUserSessionsReplyValidationError{}
+
+// Validate checks the field values on AuthReply_User with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthReply_User) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthReply_User with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthReply_UserMultiError,
+// or nil if none found.
+func (m *AuthReply_User) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthReply_User) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for NickName
+
+	// no validation rules for Phone
+
+	// no validation rules for RoleId
+
+	// no validation rules for Salt
+
+	// no validation rules for Avatar
+
+	// no validation rules for Sex
+
+	// no validation rules for Email
+
+	// no validation rules for DeptId
+
+	// no validation rules for PostId
+
+	// no validation rules for RoleIds
+
+	// no validation rules for PostIds
+
+	// no validation rules for CreateBy
+
+	// no validation rules for UpdateBy
+
+	// no validation rules for Remark
+
+	// no validation rules for Status
+
+	// no validation rules for Username
+
+	// no validation rules for Password
+
+	// no validation rules for RoleName
+
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthReply_UserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthReply_UserValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthReply_UserValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetUpdatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthReply_UserValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, AuthReply_UserValidationError{
+					field:  "UpdatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetUpdatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return AuthReply_UserValidationError{
+				field:  "UpdatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Locale
+
+	if len(errors) > 0 {
+		return AuthReply_UserMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthReply_UserMultiError is an error wrapping multiple validation errors
+// returned by AuthReply_User.ValidateAll() if the designated constraints
+// aren't met.
+type AuthReply_UserMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthReply_UserMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthReply_UserMultiError) AllErrors() []error { return m }
+
+// AuthReply_UserValidationError is the validation error returned by
+// AuthReply_User.Validate if the designated constraints aren't met.
+type AuthReply_UserValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthReply_UserValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthReply_UserValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthReply_UserValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthReply_UserValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthReply_UserValidationError) ErrorName() string { return "AuthReply_UserValidationError" }
+
+// Error satisfies the builtin error interface
+func (e AuthReply_UserValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthReply_User.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthReply_UserValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthReply_UserValidationError{}
+
+// Validate checks the field values on AuthReply_Role with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *AuthReply_Role) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthReply_Role with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in AuthReply_RoleMultiError,
+// or nil if none found.
+func (m *AuthReply_Role) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthReply_Role) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for RoleId
+
+	// no validation rules for RoleName
+
+	// no validation rules for Status
+
+	// no validation rules for RoleKey
+
+	// no validation rules for RoleSort
+
+	// no validation rules for DataScope
+
+	// no validation rules for CreateBy
+
+	// no validation rules for UpdateBy
+
+	// no validation rules for Remark
+
+	if all {
+		switch v := interface{}(m.GetApiIds()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, AuthReply_RoleValidationError{
+					field:  "ApiIds",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
 		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
@@ -3939,3 +5256,114 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = AuthReply_RoleValidationError{}
+
+// Validate checks the field values on AuthReply_Impersonator with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no
+// violations.
+func (m *AuthReply_Impersonator) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on AuthReply_Impersonator with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// AuthReply_ImpersonatorMultiError, or nil if none found.
+func (m *AuthReply_Impersonator) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *AuthReply_Impersonator) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for UserId
+
+	// no validation rules for Username
+
+	// no validation rules for NickName
+
+	// no validation rules for Expire
+
+	if len(errors) > 0 {
+		return AuthReply_ImpersonatorMultiError(errors)
+	}
+
+	return nil
+}
+
+// AuthReply_ImpersonatorMultiError is an error wrapping multiple validation
+// errors returned by AuthReply_Impersonator.ValidateAll() if the designated
+// constraints aren't met.
+type AuthReply_ImpersonatorMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m AuthReply_ImpersonatorMultiError) Error() string {
+	msgs := make([]string, 0, len(m))
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m AuthReply_ImpersonatorMultiError) AllErrors() []error { return m }
+
+// AuthReply_ImpersonatorValidationError is the validation error returned by
+// AuthReply_Impersonator.Validate if the designated constraints aren't met.
+type AuthReply_ImpersonatorValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e AuthReply_ImpersonatorValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e AuthReply_ImpersonatorValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e AuthReply_ImpersonatorValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e AuthReply_ImpersonatorValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e AuthReply_ImpersonatorValidationError) ErrorName() string {
+	return "AuthReply_ImpersonatorValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e AuthReply_ImpersonatorValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sAuthReply_Impersonator.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = AuthReply_ImpersonatorValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = AuthReply_ImpersonatorValidationError{}
@@ -19,20 +19,25 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	SysUser_CreateSysUser_FullMethodName        = "/api.admin.v1.SysUser/CreateSysUser"
-	SysUser_UpdateSysUser_FullMethodName        = "/api.admin.v1.SysUser/UpdateSysUser"
-	SysUser_DeleteSysUser_FullMethodName        = "/api.admin.v1.SysUser/DeleteSysUser"
-	SysUser_FindSysUser_FullMethodName          = "/api.admin.v1.SysUser/FindSysUser"
-	SysUser_ListSysUser_FullMethodName          = "/api.admin.v1.SysUser/ListSysUser"
-	SysUser_FindCaptcha_FullMethodName          = "/api.admin.v1.SysUser/FindCaptcha"
-	SysUser_Login_FullMethodName                = "/api.admin.v1.SysUser/Login"
-	SysUser_Logout_FullMethodName               = "/api.admin.v1.SysUser/Logout"
-	SysUser_Auth_FullMethodName                 = "/api.admin.v1.SysUser/Auth"
-	SysUser_ChangeStatus_FullMethodName         = "/api.admin.v1.SysUser/ChangeStatus"
-	SysUser_UpdatePassword_FullMethodName       = "/api.admin.v1.SysUser/UpdatePassword"
-	SysUser_FindPostInit_FullMethodName         = "/api.admin.v1.SysUser/FindPostInit"
-	SysUser_FindUserRolePost_FullMethodName     = "/api.admin.v1.SysUser/FindUserRolePost"
-	SysUser_FindUserGoogleSecret_FullMethodName = "/api.admin.v1.SysUser/FindUserGoogleSecret"
+	SysUser_CreateSysUser_FullMethodName         = "/api.admin.v1.SysUser/CreateSysUser"
+	SysUser_UpdateSysUser_FullMethodName         = "/api.admin.v1.SysUser/UpdateSysUser"
+	SysUser_DeleteSysUser_FullMethodName         = "/api.admin.v1.SysUser/DeleteSysUser"
+	SysUser_FindSysUser_FullMethodName           = "/api.admin.v1.SysUser/FindSysUser"
+	SysUser_ListSysUser_FullMethodName           = "/api.admin.v1.SysUser/ListSysUser"
+	SysUser_FindCaptcha_FullMethodName           = "/api.admin.v1.SysUser/FindCaptcha"
+	SysUser_Login_FullMethodName                 = "/api.admin.v1.SysUser/Login"
+	SysUser_Logout_FullMethodName                = "/api.admin.v1.SysUser/Logout"
+	SysUser_Auth_FullMethodName                  = "/api.admin.v1.SysUser/Auth"
+	SysUser_ChangeStatus_FullMethodName          = "/api.admin.v1.SysUser/ChangeStatus"
+	SysUser_UpdatePassword_FullMethodName        = "/api.admin.v1.SysUser/UpdatePassword"
+	SysUser_FindPostInit_FullMethodName          = "/api.admin.v1.SysUser/FindPostInit"
+	SysUser_FindUserRolePost_FullMethodName      = "/api.admin.v1.SysUser/FindUserRolePost"
+	SysUser_FindUserGoogleSecret_FullMethodName  = "/api.admin.v1.SysUser/FindUserGoogleSecret"
+	SysUser_ImpersonateSysUser_FullMethodName    = "/api.admin.v1.SysUser/ImpersonateSysUser"
+	SysUser_UpdateLocale_FullMethodName          = "/api.admin.v1.SysUser/UpdateLocale"
+	SysUser_ImportSysUsers_FullMethodName        = "/api.admin.v1.SysUser/ImportSysUsers"
+	SysUser_ResetSysUserPassword_FullMethodName  = "/api.admin.v1.SysUser/ResetSysUserPassword"
+	SysUser_RevokeSysUserSessions_FullMethodName = "/api.admin.v1.SysUser/RevokeSysUserSessions"
 )
 
 // SysUserClient is the client API for SysUser service.
@@ -69,6 +74,16 @@ type SysUserClient interface {
 	FindUserRolePost(ctx context.Context, in *FindUserRolePostRequest, opts ...grpc.CallOption) (*FindUserRolePostReply, error)
 	// 生成密钥和二维码
 	FindUserGoogleSecret(ctx context.Context, in *FindUserGoogleSecretRequest, opts ...grpc.CallOption) (*FindUserGoogleSecretReply, error)
+	// 模拟登录指定用户
+	ImpersonateSysUser(ctx context.Context, in *ImpersonateSysUserRequest, opts ...grpc.CallOption) (*ImpersonateSysUserReply, error)
+	// 修改当前用户的语言偏好，返回携带新偏好的token
+	UpdateLocale(ctx context.Context, in *UpdateLocaleRequest, opts ...grpc.CallOption) (*UpdateLocaleReply, error)
+	// 从 xlsx 或 csv 批量导入用户，返回逐行的校验结果
+	ImportSysUsers(ctx context.Context, in *ImportSysUsersRequest, opts ...grpc.CallOption) (*ImportSysUsersReply, error)
+	// 管理员重置用户密码，用户下次登录必须修改密码，此前的登录全部失效
+	ResetSysUserPassword(ctx context.Context, in *ResetSysUserPasswordRequest, opts ...grpc.CallOption) (*ResetSysUserPasswordReply, error)
+	// 撤销用户的全部登录，用户需要重新登录
+	RevokeSysUserSessions(ctx context.Context, in *RevokeSysUserSessionsRequest, opts ...grpc.CallOption) (*RevokeSysUserSessionsReply, error)
 }
 
 type sysUserClient struct {
@@ -219,6 +234,56 @@ func (c *sysUserClient) FindUserGoogleSecret(ctx context.Context, in *FindUserGo
 	return out, nil
 }
 
+func (c *sysUserClient) ImpersonateSysUser(ctx context.Context, in *ImpersonateSysUserRequest, opts ...grpc.CallOption) (*ImpersonateSysUserReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImpersonateSysUserReply)
+	err := c.cc.Invoke(ctx, SysUser_ImpersonateSysUser_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysUserClient) UpdateLocale(ctx context.Context, in *UpdateLocaleRequest, opts ...grpc.CallOption) (*UpdateLocaleReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateLocaleReply)
+	err := c.cc.Invoke(ctx, SysUser_UpdateLocale_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysUserClient) ImportSysUsers(ctx context.Context, in *ImportSysUsersRequest, opts ...grpc.CallOption) (*ImportSysUsersReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportSysUsersReply)
+	err := c.cc.Invoke(ctx, SysUser_ImportSysUsers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysUserClient) ResetSysUserPassword(ctx context.Context, in *ResetSysUserPasswordRequest, opts ...grpc.CallOption) (*ResetSysUserPasswordReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ResetSysUserPasswordReply)
+	err := c.cc.Invoke(ctx, SysUser_ResetSysUserPassword_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *sysUserClient) RevokeSysUserSessions(ctx context.Context, in *RevokeSysUserSessionsRequest, opts ...grpc.CallOption) (*RevokeSysUserSessionsReply, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RevokeSysUserSessionsReply)
+	err := c.cc.Invoke(ctx, SysUser_RevokeSysUserSessions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SysUserServer is the server API for SysUser service.
 // All implementations must embed UnimplementedSysUserServer
 // for forward compatibility.
@@ -253,6 +318,16 @@ type SysUserServer interface {
 	FindUserRolePost(context.Context, *FindUserRolePostRequest) (*FindUserRolePostReply, error)
 	// 生成密钥和二维码
 	FindUserGoogleSecret(context.Context, *FindUserGoogleSecretRequest) (*FindUserGoogleSecretReply, error)
+	// 模拟登录指定用户
+	ImpersonateSysUser(context.Context, *ImpersonateSysUserRequest) (*ImpersonateSysUserReply, error)
+	// 修改当前用户的语言偏好，返回携带新偏好的token
+	UpdateLocale(context.Context, *UpdateLocaleRequest) (*UpdateLocaleReply, error)
+	// 从 xlsx 或 csv 批量导入用户，返回逐行的校验结果
+	ImportSysUsers(context.Context, *ImportSysUsersRequest) (*ImportSysUsersReply, error)
+	// 管理员重置用户密码，用户下次登录必须修改密码，此前的登录全部失效
+	ResetSysUserPassword(context.Context, *ResetSysUserPasswordRequest) (*ResetSysUserPasswordReply, error)
+	// 撤销用户的全部登录，用户需要重新登录
+	RevokeSysUserSessions(context.Context, *RevokeSysUserSessionsRequest) (*RevokeSysUserSessionsReply, error)
 	mustEmbedUnimplementedSysUserServer()
 }
 
@@ -305,6 +380,21 @@ func (UnimplementedSysUserServer) FindUserRolePost(context.Context, *FindUserRol
 func (UnimplementedSysUserServer) FindUserGoogleSecret(context.Context, *FindUserGoogleSecretRequest) (*FindUserGoogleSecretReply, error) {
 	return nil, status.Error(codes.Unimplemented, "method FindUserGoogleSecret not implemented")
 }
+func (UnimplementedSysUserServer) ImpersonateSysUser(context.Context, *ImpersonateSysUserRequest) (*ImpersonateSysUserReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImpersonateSysUser not implemented")
+}
+func (UnimplementedSysUserServer) UpdateLocale(context.Context, *UpdateLocaleRequest) (*UpdateLocaleReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method UpdateLocale not implemented")
+}
+func (UnimplementedSysUserServer) ImportSysUsers(context.Context, *ImportSysUsersRequest) (*ImportSysUsersReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ImportSysUsers not implemented")
+}
+func (UnimplementedSysUserServer) ResetSysUserPassword(context.Context, *ResetSysUserPasswordRequest) (*ResetSysUserPasswordReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method ResetSysUserPassword not implemented")
+}
+func (UnimplementedSysUserServer) RevokeSysUserSessions(context.Context, *RevokeSysUserSessionsRequest) (*RevokeSysUserSessionsReply, error) {
+	return nil, status.Error(codes.Unimplemented, "method RevokeSysUserSessions not implemented")
+}
 func (UnimplementedSysUserServer) mustEmbedUnimplementedSysUserServer() {}
 func (UnimplementedSysUserServer) testEmbeddedByValue()                 {}
 
@@ -578,6 +668,96 @@ func _SysUser_FindUserGoogleSecret_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SysUser_ImpersonateSysUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImpersonateSysUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysUserServer).ImpersonateSysUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysUser_ImpersonateSysUser_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysUserServer).ImpersonateSysUser(ctx, req.(*ImpersonateSysUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysUser_UpdateLocale_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateLocaleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysUserServer).UpdateLocale(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysUser_UpdateLocale_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysUserServer).UpdateLocale(ctx, req.(*UpdateLocaleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysUser_ImportSysUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportSysUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysUserServer).ImportSysUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysUser_ImportSysUsers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysUserServer).ImportSysUsers(ctx, req.(*ImportSysUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysUser_ResetSysUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetSysUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysUserServer).ResetSysUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysUser_ResetSysUserPassword_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysUserServer).ResetSysUserPassword(ctx, req.(*ResetSysUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _SysUser_RevokeSysUserSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeSysUserSessionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SysUserServer).RevokeSysUserSessions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: SysUser_RevokeSysUserSessions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SysUserServer).RevokeSysUserSessions(ctx, req.(*RevokeSysUserSessionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // SysUser_ServiceDesc is the grpc.ServiceDesc for SysUser service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -641,6 +821,26 @@ var SysUser_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "FindUserGoogleSecret",
 			Handler:    _SysUser_FindUserGoogleSecret_Handler,
 		},
+		{
+			MethodName: "ImpersonateSysUser",
+			Handler:    _SysUser_ImpersonateSysUser_Handler,
+		},
+		{
+			MethodName: "UpdateLocale",
+			Handler:    _SysUser_UpdateLocale_Handler,
+		},
+		{
+			MethodName: "ImportSysUsers",
+			Handler:    _SysUser_ImportSysUsers_Handler,
+		},
+		{
+			MethodName: "ResetSysUserPassword",
+			Handler:    _SysUser_ResetSysUserPassword_Handler,
+		},
+		{
+			MethodName: "RevokeSysUserSessions",
+			Handler:    _SysUser_RevokeSysUserSessions_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "sys_user.proto",
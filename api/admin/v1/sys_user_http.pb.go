@@ -28,9 +28,14 @@ const OperationSysUserFindPostInit = "/api.admin.v1.SysUser/FindPostInit"
 const OperationSysUserFindSysUser = "/api.admin.v1.SysUser/FindSysUser"
 const OperationSysUserFindUserGoogleSecret = "/api.admin.v1.SysUser/FindUserGoogleSecret"
 const OperationSysUserFindUserRolePost = "/api.admin.v1.SysUser/FindUserRolePost"
+const OperationSysUserImpersonateSysUser = "/api.admin.v1.SysUser/ImpersonateSysUser"
+const OperationSysUserImportSysUsers = "/api.admin.v1.SysUser/ImportSysUsers"
 const OperationSysUserListSysUser = "/api.admin.v1.SysUser/ListSysUser"
 const OperationSysUserLogin = "/api.admin.v1.SysUser/Login"
 const OperationSysUserLogout = "/api.admin.v1.SysUser/Logout"
+const OperationSysUserResetSysUserPassword = "/api.admin.v1.SysUser/ResetSysUserPassword"
+const OperationSysUserRevokeSysUserSessions = "/api.admin.v1.SysUser/RevokeSysUserSessions"
+const OperationSysUserUpdateLocale = "/api.admin.v1.SysUser/UpdateLocale"
 const OperationSysUserUpdatePassword = "/api.admin.v1.SysUser/UpdatePassword"
 const OperationSysUserUpdateSysUser = "/api.admin.v1.SysUser/UpdateSysUser"
 
@@ -53,12 +58,22 @@ type SysUserHTTPServer interface {
 	FindUserGoogleSecret(context.Context, *FindUserGoogleSecretRequest) (*FindUserGoogleSecretReply, error)
 	// FindUserRolePost 获取RoPo
 	FindUserRolePost(context.Context, *FindUserRolePostRequest) (*FindUserRolePostReply, error)
+	// ImpersonateSysUser 模拟登录指定用户
+	ImpersonateSysUser(context.Context, *ImpersonateSysUserRequest) (*ImpersonateSysUserReply, error)
+	// ImportSysUsers 从 xlsx 或 csv 批量导入用户，返回逐行的校验结果
+	ImportSysUsers(context.Context, *ImportSysUsersRequest) (*ImportSysUsersReply, error)
 	// ListSysUser 用户列表
 	ListSysUser(context.Context, *ListSysUserRequest) (*ListSysUserReply, error)
 	// Login 登入
 	Login(context.Context, *LoginRequest) (*LoginReply, error)
 	// Logout 登出
 	Logout(context.Context, *LogoutRequest) (*LogoutReply, error)
+	// ResetSysUserPassword 管理员重置用户密码，用户下次登录必须修改密码，此前的登录全部失效
+	ResetSysUserPassword(context.Context, *ResetSysUserPasswordRequest) (*ResetSysUserPasswordReply, error)
+	// RevokeSysUserSessions 撤销用户的全部登录，用户需要重新登录
+	RevokeSysUserSessions(context.Context, *RevokeSysUserSessionsRequest) (*RevokeSysUserSessionsReply, error)
+	// UpdateLocale 修改当前用户的语言偏好，返回携带新偏好的token
+	UpdateLocale(context.Context, *UpdateLocaleRequest) (*UpdateLocaleReply, error)
 	// UpdatePassword 更新密码
 	UpdatePassword(context.Context, *UpdatePasswordRequest) (*UpdatePasswordReply, error)
 	// UpdateSysUser 更新用户
@@ -81,6 +96,11 @@ func RegisterSysUserHTTPServer(s *http.Server, srv SysUserHTTPServer) {
 	r.GET("/system/user/getInit", _SysUser_FindPostInit0_HTTP_Handler(srv))
 	r.GET("/system/user/getRoPo", _SysUser_FindUserRolePost0_HTTP_Handler(srv))
 	r.GET("/system/user/secret", _SysUser_FindUserGoogleSecret0_HTTP_Handler(srv))
+	r.POST("/system/user/impersonate", _SysUser_ImpersonateSysUser0_HTTP_Handler(srv))
+	r.PUT("/system/user/locale", _SysUser_UpdateLocale0_HTTP_Handler(srv))
+	r.POST("/system/user/import", _SysUser_ImportSysUsers0_HTTP_Handler(srv))
+	r.PUT("/system/user/password/reset", _SysUser_ResetSysUserPassword0_HTTP_Handler(srv))
+	r.POST("/system/user/sessions/revoke", _SysUser_RevokeSysUserSessions0_HTTP_Handler(srv))
 }
 
 func _SysUser_CreateSysUser0_HTTP_Handler(srv SysUserHTTPServer) func(ctx http.Context) error {
@@ -373,6 +393,116 @@ func _SysUser_FindUserGoogleSecret0_HTTP_Handler(srv SysUserHTTPServer) func(ctx
 	}
 }
 
+func _SysUser_ImpersonateSysUser0_HTTP_Handler(srv SysUserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImpersonateSysUserRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysUserImpersonateSysUser)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImpersonateSysUser(ctx, req.(*ImpersonateSysUserRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImpersonateSysUserReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysUser_UpdateLocale0_HTTP_Handler(srv SysUserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in UpdateLocaleRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysUserUpdateLocale)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.UpdateLocale(ctx, req.(*UpdateLocaleRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*UpdateLocaleReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysUser_ImportSysUsers0_HTTP_Handler(srv SysUserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ImportSysUsersRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysUserImportSysUsers)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ImportSysUsers(ctx, req.(*ImportSysUsersRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ImportSysUsersReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysUser_ResetSysUserPassword0_HTTP_Handler(srv SysUserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in ResetSysUserPasswordRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysUserResetSysUserPassword)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.ResetSysUserPassword(ctx, req.(*ResetSysUserPasswordRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*ResetSysUserPasswordReply)
+		return ctx.Result(200, reply)
+	}
+}
+
+func _SysUser_RevokeSysUserSessions0_HTTP_Handler(srv SysUserHTTPServer) func(ctx http.Context) error {
+	return func(ctx http.Context) error {
+		var in RevokeSysUserSessionsRequest
+		if err := ctx.Bind(&in); err != nil {
+			return err
+		}
+		if err := ctx.BindQuery(&in); err != nil {
+			return err
+		}
+		http.SetOperation(ctx, OperationSysUserRevokeSysUserSessions)
+		h := ctx.Middleware(func(ctx context.Context, req interface{}) (interface{}, error) {
+			return srv.RevokeSysUserSessions(ctx, req.(*RevokeSysUserSessionsRequest))
+		})
+		out, err := h(ctx, &in)
+		if err != nil {
+			return err
+		}
+		reply := out.(*RevokeSysUserSessionsReply)
+		return ctx.Result(200, reply)
+	}
+}
+
 type SysUserHTTPClient interface {
 	// Auth 获取用户权限
 	Auth(ctx context.Context, req *AuthRequest, opts ...http.CallOption) (rsp *AuthReply, err error)
@@ -392,12 +522,22 @@ type SysUserHTTPClient interface {
 	FindUserGoogleSecret(ctx context.Context, req *FindUserGoogleSecretRequest, opts ...http.CallOption) (rsp *FindUserGoogleSecretReply, err error)
 	// FindUserRolePost 获取RoPo
 	FindUserRolePost(ctx context.Context, req *FindUserRolePostRequest, opts ...http.CallOption) (rsp *FindUserRolePostReply, err error)
+	// ImpersonateSysUser 模拟登录指定用户
+	ImpersonateSysUser(ctx context.Context, req *ImpersonateSysUserRequest, opts ...http.CallOption) (rsp *ImpersonateSysUserReply, err error)
+	// ImportSysUsers 从 xlsx 或 csv 批量导入用户，返回逐行的校验结果
+	ImportSysUsers(ctx context.Context, req *ImportSysUsersRequest, opts ...http.CallOption) (rsp *ImportSysUsersReply, err error)
 	// ListSysUser 用户列表
 	ListSysUser(ctx context.Context, req *ListSysUserRequest, opts ...http.CallOption) (rsp *ListSysUserReply, err error)
 	// Login 登入
 	Login(ctx context.Context, req *LoginRequest, opts ...http.CallOption) (rsp *LoginReply, err error)
 	// Logout 登出
 	Logout(ctx context.Context, req *LogoutRequest, opts ...http.CallOption) (rsp *LogoutReply, err error)
+	// ResetSysUserPassword 管理员重置用户密码，用户下次登录必须修改密码，此前的登录全部失效
+	ResetSysUserPassword(ctx context.Context, req *ResetSysUserPasswordRequest, opts ...http.CallOption) (rsp *ResetSysUserPasswordReply, err error)
+	// RevokeSysUserSessions 撤销用户的全部登录，用户需要重新登录
+	RevokeSysUserSessions(ctx context.Context, req *RevokeSysUserSessionsRequest, opts ...http.CallOption) (rsp *RevokeSysUserSessionsReply, err error)
+	// UpdateLocale 修改当前用户的语言偏好，返回携带新偏好的token
+	UpdateLocale(ctx context.Context, req *UpdateLocaleRequest, opts ...http.CallOption) (rsp *UpdateLocaleReply, err error)
 	// UpdatePassword 更新密码
 	UpdatePassword(ctx context.Context, req *UpdatePasswordRequest, opts ...http.CallOption) (rsp *UpdatePasswordReply, err error)
 	// UpdateSysUser 更新用户
@@ -538,6 +678,34 @@ func (c *SysUserHTTPClientImpl) FindUserRolePost(ctx context.Context, in *FindUs
 	return &out, nil
 }
 
+// ImpersonateSysUser 模拟登录指定用户
+func (c *SysUserHTTPClientImpl) ImpersonateSysUser(ctx context.Context, in *ImpersonateSysUserRequest, opts ...http.CallOption) (*ImpersonateSysUserReply, error) {
+	var out ImpersonateSysUserReply
+	pattern := "/system/user/impersonate"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysUserImpersonateSysUser))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// ImportSysUsers 从 xlsx 或 csv 批量导入用户，返回逐行的校验结果
+func (c *SysUserHTTPClientImpl) ImportSysUsers(ctx context.Context, in *ImportSysUsersRequest, opts ...http.CallOption) (*ImportSysUsersReply, error) {
+	var out ImportSysUsersReply
+	pattern := "/system/user/import"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysUserImportSysUsers))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // ListSysUser 用户列表
 func (c *SysUserHTTPClientImpl) ListSysUser(ctx context.Context, in *ListSysUserRequest, opts ...http.CallOption) (*ListSysUserReply, error) {
 	var out ListSysUserReply
@@ -580,6 +748,48 @@ func (c *SysUserHTTPClientImpl) Logout(ctx context.Context, in *LogoutRequest, o
 	return &out, nil
 }
 
+// ResetSysUserPassword 管理员重置用户密码，用户下次登录必须修改密码，此前的登录全部失效
+func (c *SysUserHTTPClientImpl) ResetSysUserPassword(ctx context.Context, in *ResetSysUserPasswordRequest, opts ...http.CallOption) (*ResetSysUserPasswordReply, error) {
+	var out ResetSysUserPasswordReply
+	pattern := "/system/user/password/reset"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysUserResetSysUserPassword))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// RevokeSysUserSessions 撤销用户的全部登录，用户需要重新登录
+func (c *SysUserHTTPClientImpl) RevokeSysUserSessions(ctx context.Context, in *RevokeSysUserSessionsRequest, opts ...http.CallOption) (*RevokeSysUserSessionsReply, error) {
+	var out RevokeSysUserSessionsReply
+	pattern := "/system/user/sessions/revoke"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysUserRevokeSysUserSessions))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "POST", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
+// UpdateLocale 修改当前用户的语言偏好，返回携带新偏好的token
+func (c *SysUserHTTPClientImpl) UpdateLocale(ctx context.Context, in *UpdateLocaleRequest, opts ...http.CallOption) (*UpdateLocaleReply, error) {
+	var out UpdateLocaleReply
+	pattern := "/system/user/locale"
+	path := binding.EncodeURL(pattern, in, false)
+	opts = append(opts, http.Operation(OperationSysUserUpdateLocale))
+	opts = append(opts, http.PathTemplate(pattern))
+	err := c.cc.Invoke(ctx, "PUT", path, in, &out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &out, nil
+}
+
 // UpdatePassword 更新密码
 func (c *SysUserHTTPClientImpl) UpdatePassword(ctx context.Context, in *UpdatePasswordRequest, opts ...http.CallOption) (*UpdatePasswordReply, error) {
 	var out UpdatePasswordReply
@@ -4,7 +4,9 @@ import (
 	"flag"
 	"os"
 
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/server"
 
 	"github.com/go-kratos/kratos/v2"
 	"github.com/go-kratos/kratos/v2/config"
@@ -31,7 +33,7 @@ func init() {
 	flag.StringVar(&flagconf, "conf", "../../configs", "config path, eg: -conf config.yaml")
 }
 
-func newApp(logger log.Logger, hs *http.Server) *kratos.App {
+func newApp(logger log.Logger, hs *http.Server, js *server.JobServer, lw *biz.SysLogsWriter, ls *biz.SysLogsStream) *kratos.App {
 	return kratos.New(
 		kratos.ID(id),
 		kratos.Name(Name),
@@ -41,6 +43,9 @@ func newApp(logger log.Logger, hs *http.Server) *kratos.App {
 		kratos.Server(
 			// gs,
 			hs,
+			js,
+			lw,
+			ls,
 		),
 	)
 }
@@ -72,7 +77,7 @@ func main() {
 		panic(err)
 	}
 
-	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, bc.Casbin, bc.Oss, logger, bc.Data.Redis)
+	app, cleanup, err := wireApp(bc.Server, bc.Data, bc.Auth, bc.Casbin, bc.Oss, bc.Log, c, logger, bc.Data.Redis)
 	if err != nil {
 		panic(err)
 	}
@@ -8,6 +8,7 @@ package main
 
 import (
 	"github.com/go-kratos/kratos/v2"
+	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
 	admin2 "github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
@@ -25,51 +26,91 @@ import (
 // Injectors from wire.go:
 
 // wireApp init kratos application.
-func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, casbin *conf.Casbin, confOss *conf.Oss, logger log.Logger, data_Redis *conf.Data_Redis) (*kratos.App, func(), error) {
+func wireApp(confServer *conf.Server, confData *conf.Data, auth *conf.Auth, casbin *conf.Casbin, confOss *conf.Oss, logConfig *conf.LogConfig, configConfig config.Config, logger log.Logger, data_Redis *conf.Data_Redis) (*kratos.App, func(), error) {
 	db := data.NewDB(confData, logger)
-	casbinRuleRepo := admin.NewCasbinRuleRepo(db, logger)
 	universalClient := data.NewRedis(confData)
-	dataData, cleanup, err := data.NewData(db, logger, universalClient)
+	casbinRuleRepo, cleanup, err := admin.NewCasbinRuleRepo(db, universalClient, logger)
 	if err != nil {
 		return nil, nil, err
 	}
+	dataData, cleanup2, err := data.NewData(db, logger, universalClient)
+	if err != nil {
+		cleanup()
+		return nil, nil, err
+	}
 	query := data.NewQuery(dataData)
 	sysUserRepo := admin.NewSysUserRepo(query, db, logger)
 	ossRepo := oss.NewOssRepo(confOss, logger)
-	sysUserUseCase := admin2.NewSysUserUseCase(sysUserRepo, ossRepo, confServer, logger)
+	redisRepo := data.NewRedisRepo(dataData, logger)
+	userTokenStateUseCase := admin2.NewUserTokenStateUseCase(sysUserRepo, redisRepo, logger)
+	sysUserUseCase := admin2.NewSysUserUseCase(sysUserRepo, ossRepo, userTokenStateUseCase, confServer, logger)
 	sysRoleRepo := admin.NewSysRoleRepo(query, logger)
-	authUseCase := admin2.NewAuthUseCase(auth, sysUserRepo, sysRoleRepo, logger)
+	sysLoginLogRepo := admin.NewSysLoginLogRepo(query, logger)
+	sysLoginLogUseCase := admin2.NewSysLoginLogUseCase(sysLoginLogRepo, logger)
+	userNotifier := admin.NewUserNotifier(auth, logger)
+	authUseCase := admin2.NewAuthUseCase(auth, sysUserRepo, userTokenStateUseCase, sysRoleRepo, casbinRuleRepo, sysLoginLogUseCase, userNotifier, logger)
 	sysRoleMenuRepo := admin.NewSysRoleMenuRepo(query, logger)
-	sysRoleMenuUseCase := admin2.NewSysRoleMenuUseCase(sysRoleMenuRepo, logger)
-	casbinRuleUseCase := admin2.NewCasbinRuleUseCase(casbinRuleRepo, logger)
+	sysTranslationRepo := admin.NewSysTranslationRepo(query, logger)
+	sysTranslationUseCase := admin2.NewSysTranslationUseCase(sysTranslationRepo, logger)
+	sysRoleMenuUseCase := admin2.NewSysRoleMenuUseCase(sysRoleMenuRepo, sysTranslationUseCase, logger)
+	casbinRuleUseCase := admin2.NewCasbinRuleUseCase(casbinRuleRepo, sysUserRepo, sysRoleRepo, logger)
 	transaction := data.NewTransaction(dataData)
 	sysMenuRepo := admin.NewSysMenuRepo(query, logger)
 	sysRoleUseCase := admin2.NewSysRoleUseCase(sysRoleRepo, logger, sysRoleMenuUseCase, casbinRuleUseCase, sysUserUseCase, transaction, sysMenuRepo)
 	sysPostRepo := admin.NewSysPostRepo(query, logger)
-	sysPostUseCase := admin2.NewSysPostUseCase(sysPostRepo, logger, sysUserUseCase)
+	sysPostUseCase := admin2.NewSysPostUseCase(sysPostRepo, logger, sysUserUseCase, transaction)
 	sysDeptRepo := admin.NewSysDeptRepo(query, logger)
-	sysDeptUseCase := admin2.NewSysDeptUseCase(sysDeptRepo, logger)
-	sysUserService := admin3.NewSysUserService(confServer, sysUserUseCase, authUseCase, sysRoleUseCase, sysRoleMenuUseCase, sysPostUseCase, sysDeptUseCase, logger)
+	sysDeptUseCase := admin2.NewSysDeptUseCase(sysDeptRepo, sysUserUseCase, transaction, logger)
+	sysUserImportUseCase := admin2.NewSysUserImportUseCase(sysUserRepo, sysDeptRepo, sysPostRepo, sysRoleRepo, casbinRuleRepo, transaction, redisRepo, logger)
+	sysUserService := admin3.NewSysUserService(confServer, sysUserUseCase, authUseCase, sysRoleUseCase, sysRoleMenuUseCase, sysPostUseCase, sysDeptUseCase, sysLoginLogUseCase, sysUserImportUseCase, logger)
 	sysApiRepo := admin.NewSysApiRepo(query, logger)
 	v := admin2.NewSysApiUseCase(sysApiRepo, casbinRuleRepo, logger)
 	apiService := admin3.NewApiService(v, logger, casbinRuleUseCase)
 	deptService := admin3.NewDeptService(sysDeptUseCase, logger)
 	sysLogsRepo := admin.NewSysLogsRepo(query, logger)
-	v2 := admin2.NewSysLogsUseCase(sysLogsRepo, logger)
-	sysLogsService := admin3.NewSysLogsService(v2, logger)
-	v3 := admin2.NewSysMenusUseCase(sysMenuRepo, logger)
+	sysLogsStreamRepo := admin.NewSysLogsStreamRepo(universalClient, logger)
+	sysLogsStream := admin2.NewSysLogsStream(sysLogsStreamRepo, logger)
+	sysLogsWriter := admin2.NewSysLogsWriter(sysLogsRepo, sysLogsStream, logConfig, logger)
+	v2, err := admin2.NewSysLogsUseCase(sysLogsRepo, sysLogsWriter, logConfig, auth, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	sysLogsService := admin3.NewSysLogsService(v2, sysLogsStream, sysUserUseCase, logger)
+	v3 := admin2.NewSysMenusUseCase(sysMenuRepo, transaction, logger)
 	menusService := admin3.NewMenusService(v3, sysRoleMenuUseCase, logger)
 	postService := admin3.NewPostService(sysPostUseCase, logger)
 	sysDictTypeRepo := admin.NewSysDictTypeRepo(query, logger)
-	v4 := admin2.NewSysDictTypeUseCase(sysDictTypeRepo, logger)
-	dictTypeService := admin3.NewDictTypeService(v4, logger)
 	sysDictDataRepo := admin.NewSysDictDataRepo(query, logger)
-	v5 := admin2.NewSysDictDatumUseCase(sysDictDataRepo, logger)
+	v4 := admin2.NewSysDictTypeUseCase(sysDictTypeRepo, sysDictDataRepo, transaction, redisRepo, logger)
+	dictTypeService := admin3.NewDictTypeService(v4, logger)
+	v5 := admin2.NewSysDictDatumUseCase(sysDictDataRepo, redisRepo, sysTranslationUseCase, logger)
 	dictDataService := admin3.NewDictDataService(v5, logger)
-	rolesService := admin3.NewRolesService(sysRoleUseCase, logger, casbinRuleUseCase)
-	httpServer := server.NewHTTPServer(confServer, auth, casbinRuleRepo, sysUserRepo, logger, sysUserService, apiService, deptService, v2, sysLogsService, menusService, postService, dictTypeService, dictDataService, rolesService)
-	app := newApp(logger, httpServer)
+	sysTempGrantRepo := admin.NewSysTempGrantRepo(query, logger)
+	sysTempGrantUseCase := admin2.NewSysTempGrantUseCase(sysTempGrantRepo, sysRoleRepo, sysUserRepo, casbinRuleUseCase, v2, logger)
+	rolesService := admin3.NewRolesService(sysRoleUseCase, logger, casbinRuleUseCase, sysTempGrantUseCase)
+	sysChangeRequestRepo := admin.NewSysChangeRequestRepo(query, logger)
+	sysChangeRequestUseCase, err := admin2.NewSysChangeRequestUseCase(auth, sysChangeRequestRepo, logger)
+	if err != nil {
+		cleanup2()
+		cleanup()
+		return nil, nil, err
+	}
+	changeRequestService := admin3.NewChangeRequestService(sysChangeRequestUseCase, sysRoleUseCase, sysUserService, rolesService, sysLogsService, logger)
+	sysExportTaskRepo := admin.NewSysExportTaskRepo(query, logger)
+	sysExportUseCase := admin2.NewSysExportUseCase(confData, sysExportTaskRepo, v5, logger)
+	exportService := admin3.NewExportService(sysExportUseCase, v2, sysUserUseCase, sysRoleUseCase, sysDeptUseCase, sysLoginLogUseCase, logger)
+	loginLogService := admin3.NewLoginLogService(sysLoginLogUseCase, logger)
+	translationService := admin3.NewTranslationService(sysTranslationUseCase, logger)
+	sysRecycleRepo := admin.NewSysRecycleRepo(query, logger)
+	sysRecycleUseCase := admin2.NewSysRecycleUseCase(sysRecycleRepo, sysDeptRepo, transaction, confData, logger)
+	recycleService := admin3.NewRecycleService(sysRecycleUseCase, logger)
+	httpServer := server.NewHTTPServer(confServer, auth, logConfig, configConfig, casbinRuleRepo, sysUserRepo, userTokenStateUseCase, logger, sysUserService, apiService, deptService, v2, sysLogsService, menusService, postService, dictTypeService, dictDataService, rolesService, sysChangeRequestUseCase, changeRequestService, sysExportUseCase, exportService, loginLogService, translationService, recycleService)
+	jobServer := server.NewJobServer(sysTempGrantUseCase, sysChangeRequestUseCase, sysExportUseCase, sysRecycleUseCase, logger)
+	app := newApp(logger, httpServer, jobServer, sysLogsWriter, sysLogsStream)
 	return app, func() {
+		cleanup2()
 		cleanup()
 	}, nil
 }
@@ -9,6 +9,7 @@ import (
 
 	"gorm.io/driver/mysql"
 	"gorm.io/gen"
+	"gorm.io/gen/field"
 	"gorm.io/gorm"
 	"gorm.io/gorm/logger"
 )
@@ -150,6 +151,10 @@ func main() {
 			switch tc.TableName {
 			case "sys_users":
 				// 不太可能有金额字段，但为保持一致性而保留
+			case "sys_logs":
+				// 操作记录关联的字段变更，写入操作记录时一并写入
+				fieldOpts = append(fieldOpts, gen.FieldRelate(field.HasMany, "Changes", g.GenerateModel("sys_log_changes"),
+					&field.RelateConfig{GORMTag: field.GormTag{"foreignKey": "LogID"}}))
 			}
 
 			var model interface{}
@@ -224,11 +229,17 @@ func getAllTables() []TableConfig {
 	tables = append(tables, TableConfig{TableName: "log_logins", StructName: "log_logins", Description: "登录日志"})
 	tables = append(tables, TableConfig{TableName: "log_opers", StructName: "log_opers", Description: "操作日志"})
 	tables = append(tables, TableConfig{TableName: "sys_apis", StructName: "sys_apis", Description: "系统API"})
+	tables = append(tables, TableConfig{TableName: "sys_change_requests", StructName: "sys_change_requests", Description: "变更审批"})
 	tables = append(tables, TableConfig{TableName: "sys_depts", StructName: "sys_depts", Description: "部门"})
 	tables = append(tables, TableConfig{TableName: "sys_dict_data", StructName: "sys_dict_data", Description: "字典数据"})
 	tables = append(tables, TableConfig{TableName: "sys_dict_types", StructName: "sys_dict_types", Description: "字典类型"})
 	tables = append(tables, TableConfig{TableName: "sys_discovery", StructName: "sys_discovery", Description: "发现页"})
+	tables = append(tables, TableConfig{TableName: "sys_export_tasks", StructName: "sys_export_tasks", Description: "导出任务"})
 	tables = append(tables, TableConfig{TableName: "sys_jobs", StructName: "sys_jobs", Description: "系统任务"})
+	tables = append(tables, TableConfig{TableName: "sys_log_chains", StructName: "sys_log_chains", Description: "操作日志审计链头"})
+	tables = append(tables, TableConfig{TableName: "sys_log_changes", StructName: "sys_log_changes", Description: "实体字段变更记录"})
+	tables = append(tables, TableConfig{TableName: "sys_log_checkpoints", StructName: "sys_log_checkpoints", Description: "操作日志归档检查点"})
+	tables = append(tables, TableConfig{TableName: "sys_login_logs", StructName: "sys_login_logs", Description: "登录日志"})
 	tables = append(tables, TableConfig{TableName: "sys_logs", StructName: "sys_logs", Description: "系统日志"})
 	tables = append(tables, TableConfig{TableName: "sys_menu_btns", StructName: "sys_menu_btns", Description: "菜单按钮"})
 	tables = append(tables, TableConfig{TableName: "sys_menus", StructName: "sys_menus", Description: "菜单"})
@@ -237,6 +248,8 @@ func getAllTables() []TableConfig {
 	tables = append(tables, TableConfig{TableName: "sys_role_depts", StructName: "sys_role_depts", Description: "角色部门"})
 	tables = append(tables, TableConfig{TableName: "sys_role_menus", StructName: "sys_role_menus", Description: "角色菜单"})
 	tables = append(tables, TableConfig{TableName: "sys_roles", StructName: "sys_roles", Description: "角色"})
+	tables = append(tables, TableConfig{TableName: "sys_temp_grants", StructName: "sys_temp_grants", Description: "临时授权"})
+	tables = append(tables, TableConfig{TableName: "sys_translations", StructName: "sys_translations", Description: "字典标签和菜单标题的翻译"})
 	tables = append(tables, TableConfig{TableName: "sys_users", StructName: "sys_users", Description: "用户"})
 
 	return tables
@@ -7,44 +7,73 @@ import (
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg"
 	"github.com/swordkee/kratos-vue-admin/pkg/common/constant"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 
 	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
 	"github.com/swordkee/kratos-vue-admin/pkg/util"
 )
 
+// defaultImpersonateExpire 模拟登录token默认有效期
+const defaultImpersonateExpire = 30 * time.Minute
+
 type AuthUseCase struct {
-	key      string
-	expire   time.Duration
-	userRepo SysUserRepo
-	roleRepo SysRoleRepo
-	log      *log.Helper
+	key               string
+	expire            time.Duration
+	impersonateExpire time.Duration
+	userRepo          SysUserRepo
+	tokenState        *UserTokenStateUseCase
+	roleRepo          SysRoleRepo
+	casbinRepo        CasbinRuleRepo
+	loginLogCase      *SysLoginLogUseCase
+	notifier          UserNotifier
+	log               *log.Helper
 }
 
-func NewAuthUseCase(conf *conf.Auth, userRepo SysUserRepo, roleRepo SysRoleRepo, logger log.Logger) *AuthUseCase {
+func NewAuthUseCase(conf *conf.Auth, userRepo SysUserRepo, tokenState *UserTokenStateUseCase, roleRepo SysRoleRepo, casbinRepo CasbinRuleRepo, loginLogCase *SysLoginLogUseCase, notifier UserNotifier, logger log.Logger) *AuthUseCase {
+	impersonateExpire := conf.ImpersonateExpires.AsDuration()
+	if impersonateExpire <= 0 {
+		impersonateExpire = defaultImpersonateExpire
+	}
 	return &AuthUseCase{
-		key:      conf.JwtKey,
-		expire:   conf.Expires.AsDuration(),
-		userRepo: userRepo,
-		roleRepo: roleRepo,
-		log:      log.NewHelper(logger),
+		key:               conf.JwtKey,
+		expire:            conf.Expires.AsDuration(),
+		impersonateExpire: impersonateExpire,
+		userRepo:          userRepo,
+		tokenState:        tokenState,
+		roleRepo:          roleRepo,
+		casbinRepo:        casbinRepo,
+		loginLogCase:      loginLogCase,
+		notifier:          notifier,
+		log:               log.NewHelper(logger),
 	}
 }
 
-func (receiver *AuthUseCase) Login(ctx context.Context, req *pb.LoginRequest) (token string, expireAt int64, pErr error) {
+// Login 登录，mustChangePassword 为 true 时用户需要先修改密码才能使用其他接口
+func (receiver *AuthUseCase) Login(ctx context.Context, req *pb.LoginRequest) (token string, expireAt int64, mustChangePassword bool, pErr error) {
+	// 成功和失败都记录登录日志
+	var userID int64
+	var mfaMethod string
+	defer func() {
+		receiver.loginLogCase.Record(ctx, req.Username, userID, mfaMethod, pErr)
+	}()
+
 	// get user
 	user, err := receiver.userRepo.FindByUsername(ctx, req.Username)
 	if err != nil {
-		pErr = pb.ErrorUserNotFound("用户名或密码错误")
+		pErr = i18n.WithID(pb.ErrorUserNotFound("用户名或密码错误"), "user.loginFailed")
 		return
 	}
+	userID = user.ID
 	if user.Status == constant.StatusUserForbidden {
-		pErr = pb.ErrorAccountForbidden("账号被停用")
+		pErr = i18n.WithID(pb.ErrorAccountForbidden("账号被停用"), "user.disabled")
 		return
 	}
 
+	mfaMethod = MfaMethodTotp
 	gAuth := util.NewGoogleAuth()
 	code, err := gAuth.GetCode(user.Secret)
 
@@ -54,12 +83,12 @@ func (receiver *AuthUseCase) Login(ctx context.Context, req *pb.LoginRequest) (t
 	}
 
 	if req.Code != code {
-		pErr = pb.ErrorCodeNotMatch(pkg.ErrGoogleCode)
+		pErr = i18n.WithID(pb.ErrorCodeNotMatch(pkg.ErrGoogleCode), "user.codeNotMatch")
 		return
 	}
 
 	if !util.BcryptCheck(req.Password, user.Password) {
-		pErr = pb.ErrorLoginFail(pkg.ErrPassword)
+		pErr = i18n.WithID(pb.ErrorLoginFail(pkg.ErrPassword), "user.passwordWrong")
 		return
 	}
 
@@ -71,9 +100,110 @@ func (receiver *AuthUseCase) Login(ctx context.Context, req *pb.LoginRequest) (t
 
 	// generate token
 	expire := time.Now().Add(receiver.expire)
-	token, err = authz.NewToken(receiver.key, expire, user.ID, user.RoleID, role.RoleKey, user.NickName)
+	token, err = authz.NewToken(receiver.key, expire, user.ID, user.RoleID, role.RoleKey, user.NickName, user.Locale)
+	if err != nil {
+		pErr = i18n.WithID(pb.ErrorLoginFail("generate token failed: %s", err.Error()), "auth.tokenGenerateFailed", err.Error())
+		return
+	}
+	expireAt = expire.Unix()
+	mustChangePassword = user.MustChangePassword
+	return
+}
+
+// Impersonate 以指定用户身份签发短期token，token中同时保留真实操作人id
+func (receiver *AuthUseCase) Impersonate(ctx context.Context, userID int64) (token string, expireAt int64, pErr error) {
+	claims, err := authz.FromContext(ctx)
+	if err != nil {
+		pErr = err
+		return
+	}
+	// 不允许在模拟登录状态下再次模拟
+	if claims.IsImpersonated() {
+		pErr = i18n.WithID(errors.Forbidden("IMPERSONATE_FORBIDDEN", "模拟登录状态下不能再次模拟其他用户"), "impersonate.nested")
+		return
+	}
+	if claims.UserID == userID {
+		pErr = i18n.WithID(errors.BadRequest("IMPERSONATE_FORBIDDEN", "不能模拟自己"), "impersonate.self")
+		return
+	}
+
+	user, err := receiver.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		pErr = i18n.WithID(pb.ErrorUserNotFound("用户不存在"), "user.notFound")
+		return
+	}
+	if user.Status == constant.StatusUserForbidden {
+		pErr = i18n.WithID(pb.ErrorAccountForbidden("账号被停用"), "user.disabled")
+		return
+	}
+
+	role, err := receiver.roleRepo.FindByID(ctx, user.RoleID)
+	if err != nil {
+		pErr = err
+		return
+	}
+	if pErr = receiver.checkImpersonateTarget(claims, user.ID, role.RoleKey); pErr != nil {
+		return
+	}
+
+	expire := time.Now().Add(receiver.impersonateExpire)
+	token, err = authz.NewImpersonateToken(receiver.key, expire, user.ID, user.RoleID, role.RoleKey, user.NickName, claims.UserID, claims.Locale)
+	if err != nil {
+		pErr = i18n.WithID(pb.ErrorInternalErr("generate token failed: %s", err.Error()), "auth.tokenGenerateFailed", err.Error())
+		return
+	}
+	receiver.log.WithContext(ctx).Infof("user %d impersonate user %d until %s", claims.UserID, user.ID, expire.Format(time.DateTime))
+	expireAt = expire.Unix()
+	return
+}
+
+// checkImpersonateTarget 不能模拟超级管理员，也不能模拟拥有操作人所没有的权限的用户，
+// 目标用户的权限包括角色继承后的策略和用户的临时授权
+func (receiver *AuthUseCase) checkImpersonateTarget(claims *authz.TokenClaims, userID int64, roleKey string) error {
+	if roleKey == SuperAdminRoleKey {
+		return i18n.WithID(errors.Forbidden("IMPERSONATE_FORBIDDEN", "不能模拟超级管理员"), "impersonate.superAdmin")
+	}
+	holds, err := holdsPolicies(receiver.casbinRepo, claims, roleKey, authz.UserSubject(userID))
+	if err != nil {
+		return err
+	}
+	if !holds {
+		return i18n.WithID(errors.Forbidden("IMPERSONATE_FORBIDDEN", "不能模拟权限高于自己的用户"), "impersonate.higherPermission")
+	}
+	return nil
+}
+
+// UpdateLocale 保存当前用户的语言偏好，并签发携带新偏好的token，有效期与当前token一致
+func (receiver *AuthUseCase) UpdateLocale(ctx context.Context, locale string) (token string, expireAt int64, pErr error) {
+	claims, err := authz.FromContext(ctx)
+	if err != nil {
+		pErr = err
+		return
+	}
+	if claims.IsImpersonated() {
+		pErr = i18n.WithID(errors.Forbidden("IMPERSONATE_FORBIDDEN", "模拟登录期间不能修改语言偏好"), "impersonate.locale")
+		return
+	}
+	if locale != "" {
+		normalized, ok := i18n.Normalize(locale)
+		if !ok {
+			pErr = i18n.WithID(errors.BadRequest("LOCALE_INVALID", "不支持的语言: "+locale), "locale.unsupported", locale)
+			return
+		}
+		locale = normalized
+	}
+	if err = receiver.userRepo.UpdateLocale(ctx, claims.UserID, locale); err != nil {
+		pErr = err
+		return
+	}
+
+	expire := time.Now().Add(receiver.expire)
+	if claims.ExpiresAt != nil {
+		expire = claims.ExpiresAt.Time
+	}
+	token, err = authz.NewToken(receiver.key, expire, claims.UserID, claims.RoleID, claims.RoleKey, claims.Nickname, locale)
 	if err != nil {
-		pErr = pb.ErrorLoginFail("generate token failed: %s", err.Error())
+		pErr = i18n.WithID(pb.ErrorInternalErr("generate token failed: %s", err.Error()), "auth.tokenGenerateFailed", err.Error())
 		return
 	}
 	expireAt = expire.Unix()
@@ -2,29 +2,47 @@ package admin
 
 import (
 	"context"
+	"strings"
 
-	"github.com/casbin/casbin/v3/model"
-	"github.com/casbin/casbin/v3/persist"
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
 )
 
 type CasbinRuleRepo interface {
-	GetModel() model.Model
-	GetAdapter() persist.Adapter
-
 	UpdateCasbin(ctx context.Context, roleKey string, p [][]string) error
 	ClearCasbin(v int, p ...string) error
 	UpdateCasbinApi(ctx context.Context, oldPath string, newPath string, oldMethod string, newMethod string) error
 	GetPolicyPathByRoleId(roleKey string) [][]string
+	Enforce(sub, obj, act string) (bool, error)
+	EnforceEx(sub, obj, act string) (bool, []string, error)
+	GetImplicitPolicy(roleKey string) ([][]string, error)
+	GetImplicitRoles(roleKey string) ([]string, error)
+	GetPolicyByApi(path, method string) ([][]string, error)
+	ReplaceSubjectPolicy(subject string, roles []string, rules [][]string) error
+}
+
+// PermissionExplain 权限校验结果
+type PermissionExplain struct {
+	Allowed  bool
+	RoleKey  string
+	Matched  []string   // 命中的策略 sub, obj, act
+	Policies [][]string // 继承后的有效策略
+	Roles    []string   // 继承的角色
 }
+
 type CasbinRuleUseCase struct {
-	repo CasbinRuleRepo
-	log  *log.Helper
+	repo     CasbinRuleRepo
+	userRepo SysUserRepo
+	roleRepo SysRoleRepo
+	log      *log.Helper
 }
 
-func NewCasbinRuleUseCase(repo CasbinRuleRepo, logger log.Logger) *CasbinRuleUseCase {
-	return &CasbinRuleUseCase{repo: repo, log: log.NewHelper(logger)}
+func NewCasbinRuleUseCase(repo CasbinRuleRepo, userRepo SysUserRepo, roleRepo SysRoleRepo, logger log.Logger) *CasbinRuleUseCase {
+	return &CasbinRuleUseCase{repo: repo, userRepo: userRepo, roleRepo: roleRepo, log: log.NewHelper(logger)}
 }
 
 func (c *CasbinRuleUseCase) UpdateCasbin(ctx context.Context, roleKey string, apis []*pb.ApiBase) error {
@@ -42,3 +60,76 @@ func (c *CasbinRuleUseCase) FindPolicyPathByRoleId(roleKey string) [][]string {
 func (c *CasbinRuleUseCase) ClearCasbin(roleKey string) error {
 	return c.repo.ClearCasbin(0, roleKey)
 }
+
+// ReplaceUserGrants 将用户生效中的临时授权写入用户主体的策略
+func (c *CasbinRuleUseCase) ReplaceUserGrants(userID int64, grants []*model.SysTempGrants) error {
+	subject := authz.UserSubject(userID)
+	var roles []string
+	var rules [][]string
+	seen := make(map[string]struct{})
+	for _, grant := range grants {
+		switch grant.GrantType {
+		case TempGrantTypeRole:
+			if _, ok := seen["g#"+grant.RoleKey]; ok {
+				continue
+			}
+			seen["g#"+grant.RoleKey] = struct{}{}
+			roles = append(roles, grant.RoleKey)
+		case TempGrantTypeApi:
+			for _, api := range DecodeTempGrantApis(grant) {
+				key := "p#" + api.Path + "#" + api.Method
+				if _, ok := seen[key]; ok {
+					continue
+				}
+				seen[key] = struct{}{}
+				rules = append(rules, []string{subject, api.Path, api.Method})
+			}
+		}
+	}
+	return c.repo.ReplaceSubjectPolicy(subject, roles, rules)
+}
+
+// ExplainPermission 按鉴权中间件相同的规则校验 path/method，roleKey 为空时取 userId 对应角色
+func (c *CasbinRuleUseCase) ExplainPermission(ctx context.Context, roleKey string, userID int64, path, method string) (*PermissionExplain, error) {
+	if roleKey == "" {
+		if userID == 0 {
+			return nil, i18n.WithID(errors.BadRequest("ROLE_KEY_MISSING", "roleKey 和 userId 不能同时为空"), "role.keyOrUserRequired")
+		}
+		user, err := c.userRepo.FindByID(ctx, userID)
+		if err != nil {
+			return nil, i18n.WithID(pb.ErrorUserNotFound("用户不存在"), "user.notFound")
+		}
+		role, err := c.roleRepo.FindByID(ctx, user.RoleID)
+		if err != nil {
+			return nil, err
+		}
+		roleKey = role.RoleKey
+	}
+
+	allowed, matched, err := c.repo.EnforceEx(roleKey, path, strings.ToUpper(method))
+	if err != nil {
+		return nil, err
+	}
+	// 角色没有权限时，与鉴权中间件一致再校验用户的临时授权
+	if !allowed && userID != 0 {
+		allowed, matched, err = c.repo.EnforceEx(authz.UserSubject(userID), path, strings.ToUpper(method))
+		if err != nil {
+			return nil, err
+		}
+	}
+	policies, err := c.repo.GetImplicitPolicy(roleKey)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := c.repo.GetImplicitRoles(roleKey)
+	if err != nil {
+		return nil, err
+	}
+	return &PermissionExplain{
+		Allowed:  allowed,
+		RoleKey:  roleKey,
+		Matched:  matched,
+		Policies: policies,
+		Roles:    roles,
+	}, nil
+}
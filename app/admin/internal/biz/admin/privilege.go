@@ -0,0 +1,38 @@
+package admin
+
+import "github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+
+// holdsPolicies 操作人是否拥有 subjects 的全部权限，subjects 为角色代码或用户主体，
+// 权限包括角色继承后的策略；操作人的权限包括角色和用户的临时授权。超级管理员拥有全部权限
+func holdsPolicies(casbinRepo CasbinRuleRepo, claims *authz.TokenClaims, subjects ...string) (bool, error) {
+	if claims.RoleKey == SuperAdminRoleKey {
+		return true, nil
+	}
+	for _, sub := range subjects {
+		policies, err := casbinRepo.GetImplicitPolicy(sub)
+		if err != nil {
+			return false, err
+		}
+		for _, p := range policies {
+			if len(p) < 3 {
+				continue
+			}
+			if holds, err := holdsApi(casbinRepo, claims, p[1], p[2]); err != nil || !holds {
+				return false, err
+			}
+		}
+	}
+	return true, nil
+}
+
+// holdsApi 操作人的角色或临时授权是否允许访问 api
+func holdsApi(casbinRepo CasbinRuleRepo, claims *authz.TokenClaims, path, method string) (bool, error) {
+	if claims.RoleKey == SuperAdminRoleKey {
+		return true, nil
+	}
+	allowed, err := casbinRepo.Enforce(claims.RoleKey, path, method)
+	if err == nil && !allowed {
+		allowed, err = casbinRepo.Enforce(authz.UserSubject(claims.UserID), path, method)
+	}
+	return allowed, err
+}
@@ -0,0 +1,23 @@
+package admin
+
+import (
+	"context"
+	"time"
+)
+
+// RedisRepo Redis 操作接口
+type RedisRepo interface {
+	SetHashKey(context.Context, string, string, interface{}) error
+	QueryHashKey(context.Context, string, string) (string, error)
+	DelHashKey(ctx context.Context, key string, field string) error
+	QueryHashLen(ctx context.Context, key string) error
+	Lock(context.Context, string, interface{}, time.Duration) (bool, error)
+	IncrHashKey(context.Context, string, string, int64) error
+	QueryHashAllKeyAndVal(ctx context.Context, key string) (map[string]string, error)
+	Set(ctx context.Context, key string, value string, expire time.Duration) error
+	Get(ctx context.Context, key string) string
+	// GetDel 读取并删除 key，key 不存在时返回空字符串
+	GetDel(ctx context.Context, key string) (string, error)
+	Del(ctx context.Context, keys ...string) error
+	SRem(ctx context.Context, key string, members ...interface{}) (int64, error)
+}
@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"strings"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
@@ -18,6 +19,27 @@ type SysApiRepo interface {
 	ListPageCount(ctx context.Context) (int32, error)
 }
 
+// ApiRoute 已注册的接口路由
+type ApiRoute struct {
+	Path        string // 接口 operation，与 casbin 策略中的 obj 一致
+	Method      string
+	Group       string
+	Description string
+}
+
+// StaleApi 路由中已不存在的接口，以及仍引用它的角色
+type StaleApi struct {
+	Api      *model.SysApis
+	RoleKeys []string
+}
+
+// SyncApiResult 接口同步结果
+type SyncApiResult struct {
+	Created []*model.SysApis
+	Updated []*model.SysApis
+	Stale   []*StaleApi
+}
+
 type SysApiUseCase struct {
 	apiRepo    SysApiRepo
 	casbinRepo CasbinRuleRepo
@@ -66,3 +88,80 @@ func (a *SysApiUseCase) FindPolicyPathByRoleKey(ctx context.Context, roleKey str
 func (a *SysApiUseCase) FindApiByID(ctx context.Context, id int64) (*model.SysApis, error) {
 	return a.apiRepo.FindByID(ctx, id)
 }
+
+// SyncApis 按已注册路由同步 sys_apis：新增缺失的接口，补全空的分组和描述，
+// 并标记路由中已不存在的接口。dryRun 为 true 时只计算差异不写库
+func (a *SysApiUseCase) SyncApis(ctx context.Context, routes []*ApiRoute, dryRun bool) (*SyncApiResult, error) {
+	apis, err := a.apiRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	existing := make(map[string]*model.SysApis, len(apis))
+	for _, api := range apis {
+		existing[apiKey(api.Path, api.Method)] = api
+	}
+
+	result := &SyncApiResult{}
+	seen := make(map[string]struct{}, len(routes))
+	for _, route := range routes {
+		key := apiKey(route.Path, route.Method)
+		if _, ok := seen[key]; ok {
+			continue
+		}
+		seen[key] = struct{}{}
+
+		api, ok := existing[key]
+		if !ok {
+			api = &model.SysApis{
+				Path:        route.Path,
+				Method:      route.Method,
+				APIGroup:    route.Group,
+				Description: route.Description,
+			}
+			if !dryRun {
+				if err = a.apiRepo.Create(ctx, api); err != nil {
+					return nil, err
+				}
+			}
+			result.Created = append(result.Created, api)
+			continue
+		}
+
+		// 已维护的分组和描述不覆盖
+		if api.APIGroup != "" && api.Description != "" {
+			continue
+		}
+		if api.APIGroup == "" {
+			api.APIGroup = route.Group
+		}
+		if api.Description == "" {
+			api.Description = route.Description
+		}
+		if !dryRun {
+			if err = a.apiRepo.Save(ctx, api); err != nil {
+				return nil, err
+			}
+		}
+		result.Updated = append(result.Updated, api)
+	}
+
+	for _, api := range apis {
+		if _, ok := seen[apiKey(api.Path, api.Method)]; ok {
+			continue
+		}
+		policies, err := a.casbinRepo.GetPolicyByApi(api.Path, api.Method)
+		if err != nil {
+			return nil, err
+		}
+		roleKeys := make([]string, 0, len(policies))
+		for _, p := range policies {
+			roleKeys = append(roleKeys, p[0])
+		}
+		result.Stale = append(result.Stale, &StaleApi{Api: api, RoleKeys: roleKeys})
+	}
+	return result, nil
+}
+
+func apiKey(path, method string) string {
+	return strings.ToUpper(method) + " " + path
+}
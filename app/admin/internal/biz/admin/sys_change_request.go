@@ -0,0 +1,301 @@
+package admin
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/redact"
+	"github.com/swordkee/kratos-vue-admin/pkg/util"
+)
+
+const defaultChangeRequestExpire = 24 * time.Hour
+
+// changeRequestPayloadPrefix 加密保存的请求参数前缀，没有前缀的为旧版本保存的明文
+const changeRequestPayloadPrefix = "enc:"
+
+// 变更请求状态
+const (
+	ChangeRequestStatusPending  int32 = 1 // 待审批
+	ChangeRequestStatusExecuted int32 = 2 // 已执行
+	ChangeRequestStatusRejected int32 = 3 // 已拒绝
+	ChangeRequestStatusExpired  int32 = 4 // 已过期
+	ChangeRequestStatusFailed   int32 = 5 // 执行失败
+)
+
+// SysChangeRequestRepo 接口定义
+type SysChangeRequestRepo interface {
+	Create(ctx context.Context, cr *model.SysChangeRequests) error
+	FindByID(ctx context.Context, id int64) (*model.SysChangeRequests, error)
+	FindExpired(ctx context.Context, now time.Time) ([]*model.SysChangeRequests, error)
+	// UpdateStatus 仅当状态仍为 from 时更新，返回是否更新成功，避免重复审批
+	UpdateStatus(ctx context.Context, cr *model.SysChangeRequests, from int32) (bool, error)
+	ListPage(ctx context.Context, operation string, status int32, page, size int32) ([]*model.SysChangeRequests, error)
+	Count(ctx context.Context, operation string, status int32) (int32, error)
+}
+
+// ChangeHandler 需要审批的操作，审批通过后用保存的请求参数执行
+type ChangeHandler struct {
+	// NewRequest 创建空的请求参数用于反序列化
+	NewRequest func() proto.Message
+	// Match 判断本次请求是否需要审批，为空时总是需要审批
+	Match func(ctx context.Context, req proto.Message) (bool, error)
+	// Execute 执行操作
+	Execute func(ctx context.Context, req proto.Message) error
+}
+
+type SysChangeRequestUseCase struct {
+	repo       SysChangeRequestRepo
+	operations map[string]struct{}
+	handlers   map[string]*ChangeHandler
+	expire     time.Duration
+	payloadKey string
+	redactor   *redact.Redactor
+	log        *log.Helper
+}
+
+// NewSysChangeRequestUseCase 配置了需要审批的操作时必须配置 payloadKey，请求参数加密后保存
+func NewSysChangeRequestUseCase(s *conf.Auth, repo SysChangeRequestRepo, logger log.Logger) (*SysChangeRequestUseCase, error) {
+	expire := defaultChangeRequestExpire
+	if d := s.GetApproval().GetExpires(); d != nil && d.AsDuration() > 0 {
+		expire = d.AsDuration()
+	}
+	operations := make(map[string]struct{})
+	for _, op := range s.GetApproval().GetOperations() {
+		operations[op] = struct{}{}
+	}
+	payloadKey := s.GetApproval().GetPayloadKey()
+	if len(operations) > 0 && payloadKey == "" {
+		return nil, stderrors.New("auth.approval.payloadKey is required when approval operations are configured")
+	}
+	return &SysChangeRequestUseCase{
+		repo:       repo,
+		operations: operations,
+		handlers:   make(map[string]*ChangeHandler),
+		expire:     expire,
+		payloadKey: payloadKey,
+		redactor:   redact.New(redact.DefaultFields),
+		log:        log.NewHelper(log.With(logger, "module", "biz/changeRequest")),
+	}, nil
+}
+
+// Register 注册可审批的操作，只有同时在配置中启用的操作才需要审批
+func (c *SysChangeRequestUseCase) Register(operation string, handler *ChangeHandler) {
+	c.handlers[operation] = handler
+}
+
+// operatorOf 实际操作人，模拟登录时为真实操作人
+func operatorOf(claims *authz.TokenClaims) int64 {
+	if claims.IsImpersonated() {
+		return claims.ImpersonatorID
+	}
+	return claims.UserID
+}
+
+// Submit 操作需要审批时保存为待审批的变更请求并返回，不需要审批时返回 nil
+func (c *SysChangeRequestUseCase) Submit(ctx context.Context, operation string, req proto.Message) (*model.SysChangeRequests, error) {
+	if _, ok := c.operations[operation]; !ok {
+		return nil, nil
+	}
+	handler, ok := c.handlers[operation]
+	if !ok {
+		return nil, nil
+	}
+	if handler.Match != nil {
+		matched, err := handler.Match(ctx, req)
+		if err != nil || !matched {
+			return nil, err
+		}
+	}
+	payload, err := protojson.Marshal(req)
+	if err != nil {
+		return nil, err
+	}
+	// 请求参数可能包含密码等敏感字段，加密后保存
+	encrypted, err := util.AesGcmEncrypt(c.payloadKey, payload)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := authz.MustFromContext(ctx)
+	now := time.Now()
+	cr := &model.SysChangeRequests{
+		Operation:    operation,
+		Payload:      changeRequestPayloadPrefix + encrypted,
+		Status:       ChangeRequestStatusPending,
+		CreateUserID: operatorOf(claims),
+		CreateBy:     claims.Nickname,
+		ExpireAt:     now.Add(c.expire),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err = c.repo.Create(ctx, cr); err != nil {
+		return nil, err
+	}
+	c.log.Infof("变更请求已提交, id: %d, operation: %s, by: %s", cr.ID, cr.Operation, cr.CreateBy)
+	return cr, nil
+}
+
+// review 校验审批人并将待审批的请求更新为 status
+func (c *SysChangeRequestUseCase) review(ctx context.Context, id int64, status int32, comment string) (*model.SysChangeRequests, error) {
+	claims := authz.MustFromContext(ctx)
+	cr, err := c.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if cr.Status != ChangeRequestStatusPending {
+		return nil, i18n.WithID(errors.BadRequest("CHANGE_REQUEST_CLOSED", "变更请求已处理"), "changeRequest.processed")
+	}
+	now := time.Now()
+	if !cr.ExpireAt.After(now) {
+		if err = c.expireOne(ctx, cr, now); err != nil {
+			return nil, err
+		}
+		return nil, i18n.WithID(errors.BadRequest("CHANGE_REQUEST_CLOSED", "变更请求已过期"), "changeRequest.expired")
+	}
+	if operatorOf(claims) == cr.CreateUserID || claims.UserID == cr.CreateUserID {
+		return nil, i18n.WithID(errors.Forbidden("CHANGE_REQUEST_FORBIDDEN", "不能审批自己提交的变更请求"), "changeRequest.selfApprove")
+	}
+
+	cr.Status = status
+	cr.ReviewUserID = operatorOf(claims)
+	cr.ReviewBy = claims.Nickname
+	cr.ReviewComment = comment
+	cr.ReviewedAt = now
+	cr.UpdatedAt = now
+	ok, err := c.repo.UpdateStatus(ctx, cr, ChangeRequestStatusPending)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return nil, i18n.WithID(errors.Conflict("CHANGE_REQUEST_CHANGED", "变更请求状态已变化，请刷新后重试"), "changeRequest.changed")
+	}
+	return cr, nil
+}
+
+// Approve 通过变更请求并以审批人身份执行，执行失败时记录错误信息
+func (c *SysChangeRequestUseCase) Approve(ctx context.Context, id int64, comment string) (*model.SysChangeRequests, error) {
+	cr, err := c.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	handler, ok := c.handlers[cr.Operation]
+	if !ok {
+		return nil, i18n.WithID(errors.BadRequest("CHANGE_REQUEST_UNSUPPORTED", "不支持的操作: "+cr.Operation), "changeRequest.unsupported", cr.Operation)
+	}
+	payload, err := c.decodePayload(cr)
+	if err != nil {
+		return nil, err
+	}
+	req := handler.NewRequest()
+	if err = protojson.Unmarshal(payload, req); err != nil {
+		return nil, err
+	}
+
+	cr, err = c.review(ctx, id, ChangeRequestStatusExecuted, comment)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Infof("变更请求已通过, id: %d, operation: %s, by: %s", cr.ID, cr.Operation, cr.ReviewBy)
+
+	if execErr := handler.Execute(ctx, req); execErr != nil {
+		cr.Status = ChangeRequestStatusFailed
+		cr.ErrorMessage = execErr.Error()
+		cr.UpdatedAt = time.Now()
+		if _, err = c.repo.UpdateStatus(ctx, cr, ChangeRequestStatusExecuted); err != nil {
+			c.log.Errorf("记录变更请求执行失败状态失败, id: %d, err: %v", cr.ID, err)
+		}
+		c.log.Errorf("变更请求执行失败, id: %d, err: %v", cr.ID, execErr)
+		return cr, execErr
+	}
+	return cr, nil
+}
+
+// Reject 拒绝变更请求
+func (c *SysChangeRequestUseCase) Reject(ctx context.Context, id int64, comment string) (*model.SysChangeRequests, error) {
+	cr, err := c.review(ctx, id, ChangeRequestStatusRejected, comment)
+	if err != nil {
+		return nil, err
+	}
+	c.log.Infof("变更请求已拒绝, id: %d, operation: %s, by: %s", cr.ID, cr.Operation, cr.ReviewBy)
+	return cr, nil
+}
+
+// ExpirePending 将超过有效期的待审批请求标记为过期，由定时任务调用
+func (c *SysChangeRequestUseCase) ExpirePending(ctx context.Context) error {
+	now := time.Now()
+	list, err := c.repo.FindExpired(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, cr := range list {
+		if err = c.expireOne(ctx, cr, now); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (c *SysChangeRequestUseCase) expireOne(ctx context.Context, cr *model.SysChangeRequests, now time.Time) error {
+	cr.Status = ChangeRequestStatusExpired
+	cr.ReviewedAt = now
+	cr.UpdatedAt = now
+	ok, err := c.repo.UpdateStatus(ctx, cr, ChangeRequestStatusPending)
+	if err != nil {
+		return err
+	}
+	if ok {
+		c.log.Infof("变更请求已过期, id: %d, operation: %s", cr.ID, cr.Operation)
+	}
+	return nil
+}
+
+// decodePayload 解密保存的请求参数
+func (c *SysChangeRequestUseCase) decodePayload(cr *model.SysChangeRequests) ([]byte, error) {
+	encrypted, ok := strings.CutPrefix(cr.Payload, changeRequestPayloadPrefix)
+	if !ok {
+		return []byte(cr.Payload), nil
+	}
+	return util.AesGcmDecrypt(c.payloadKey, encrypted)
+}
+
+// DisplayPayload 返回脱敏后的请求参数，用于展示给审批人，按操作的请求消息脱敏 sensitive 字段
+func (c *SysChangeRequestUseCase) DisplayPayload(cr *model.SysChangeRequests) string {
+	payload, err := c.decodePayload(cr)
+	if err != nil {
+		c.log.Errorf("解密变更请求参数失败, id: %d, err: %v", cr.ID, err)
+		return ""
+	}
+	var msg interface{}
+	if handler, ok := c.handlers[cr.Operation]; ok {
+		msg = handler.NewRequest()
+	}
+	return string(c.redactor.Redact(payload, msg))
+}
+
+func (c *SysChangeRequestUseCase) FindByID(ctx context.Context, id int64) (*model.SysChangeRequests, error) {
+	cr, err := c.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, i18n.WithID(errors.NotFound("CHANGE_REQUEST_NOT_FOUND", "变更请求不存在"), "changeRequest.notFound")
+	}
+	return cr, nil
+}
+
+func (c *SysChangeRequestUseCase) ListPage(ctx context.Context, operation string, status int32, page, size int32) ([]*model.SysChangeRequests, int32, error) {
+	total, err := c.repo.Count(ctx, operation, status)
+	if err != nil {
+		return nil, 0, err
+	}
+	list, err := c.repo.ListPage(ctx, operation, status, page, size)
+	return list, total, err
+}
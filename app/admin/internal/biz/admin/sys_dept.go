@@ -2,9 +2,11 @@ package admin
 
 import (
 	"context"
-	"errors"
+	"slices"
 	"strconv"
 
+	"github.com/go-kratos/kratos/v2/errors"
+
 	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
 	"github.com/swordkee/kratos-vue-admin/pkg/util"
 
@@ -12,13 +14,15 @@ import (
 
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
 )
 
 // SysDeptRepo 接口定义
 type SysDeptRepo interface {
 	Save(ctx context.Context, dept *model.SysDepts) error
 	Create(ctx context.Context, dept *model.SysDepts) error
-	Delete(ctx context.Context, id int64) error
+	// Delete、DeleteByIDs 软删除部门，deleteBy 不为空时记为更新人
+	Delete(ctx context.Context, id int64, deleteBy string) error
 	UpdateByID(ctx context.Context, id int64, dept *model.SysDepts) error
 	FindByID(ctx context.Context, id int64) (*model.SysDepts, error)
 	ListByNameStatusId(ctx context.Context, deptName string, status int32, id int64) ([]*model.SysDepts, error)
@@ -26,15 +30,27 @@ type SysDeptRepo interface {
 	SelectDept(ctx context.Context) ([]*pb.DeptTree, error)
 	SelectDeptLabel(ctx context.Context) ([]*pb.DeptLabel, error)
 	GetRoleDeptId(ctx context.Context, roleId int32) ([]int32, error)
+	// ListForUpdate 加行锁读取全部部门
+	ListForUpdate(ctx context.Context) ([]*model.SysDepts, error)
+	UpdateParent(ctx context.Context, id, parentID int64, updateBy string) error
+	// UpdatePaths 批量更新部门路径，key 为部门 id
+	UpdatePaths(ctx context.Context, paths map[int64]string) error
+	ListRoleDepts(ctx context.Context) ([]*model.SysRoleDepts, error)
+	DeleteRoleDepts(ctx context.Context, roleID int64, deptIDs ...int64) error
+	CreateRoleDepts(ctx context.Context, rows ...*model.SysRoleDepts) error
+	DeleteRoleDeptsByDeptIds(ctx context.Context, deptIDs ...int64) error
+	DeleteByIDs(ctx context.Context, deleteBy string, ids ...int64) error
 }
 
 type SysDeptUseCase struct {
-	repo SysDeptRepo
-	log  *log.Helper
+	repo        SysDeptRepo
+	userUseCase *SysUserUseCase
+	tx          Transaction
+	log         *log.Helper
 }
 
-func NewSysDeptUseCase(repo SysDeptRepo, logger log.Logger) *SysDeptUseCase {
-	return &SysDeptUseCase{repo: repo, log: log.NewHelper(logger)}
+func NewSysDeptUseCase(repo SysDeptRepo, userUseCase *SysUserUseCase, tx Transaction, logger log.Logger) *SysDeptUseCase {
+	return &SysDeptUseCase{repo: repo, userUseCase: userUseCase, tx: tx, log: log.NewHelper(logger)}
 }
 
 func (d *SysDeptUseCase) ListByNameStatusId(ctx context.Context, deptName string, status int32, id int64) ([]*model.SysDepts, error) {
@@ -62,29 +78,85 @@ func (d *SysDeptUseCase) CreateDept(ctx context.Context, sysDept *model.SysDepts
 	return sysDept, nil
 }
 
-func (d *SysDeptUseCase) UpdateDept(ctx context.Context, sysDept *model.SysDepts) (*model.SysDepts, error) {
+// UpdateDept 修改部门，修改上级部门时整体移动子树并返回移动的结果，否则结果为 nil
+func (d *SysDeptUseCase) UpdateDept(ctx context.Context, sysDept *model.SysDepts) (*model.SysDepts, *DeptMoveResult, error) {
 	oldDept, err := d.repo.FindByID(ctx, sysDept.ID)
 	if err != nil {
-		return nil, err
-	}
-	if oldDept.ParentID != sysDept.ParentID {
-		return nil, errors.New("上级部门不允许修改")
+		return nil, nil, err
 	}
 
 	claims := authz.MustFromContext(ctx)
 	sysDept.UpdateBy = claims.Nickname
 
-	deptPath, err := d.buildDeptPath(ctx, sysDept)
+	var moved *DeptMoveResult
+	err = d.tx.Transaction(ctx, func(ctx context.Context) error {
+		// 修改上级部门时整体移动子树
+		if oldDept.ParentID != sysDept.ParentID {
+			if moved, err = d.moveSubtree(ctx, sysDept.ID, sysDept.ParentID); err != nil {
+				return err
+			}
+		}
+		deptPath, err := d.buildDeptPath(ctx, sysDept)
+		if err != nil {
+			return err
+		}
+		sysDept.DeptPath = deptPath
+		return d.repo.UpdateByID(ctx, sysDept.ID, sysDept)
+	})
 	if err != nil {
-		return nil, err
+		return sysDept, nil, err
 	}
-	sysDept.DeptPath = deptPath
-	err = d.repo.UpdateByID(ctx, sysDept.ID, sysDept)
-	return sysDept, err
+	if newDept, err := d.repo.FindByID(ctx, sysDept.ID); err == nil {
+		RecordChange(ctx, ChangeEntityDept, sysDept.ID, oldDept, newDept)
+	}
+	return sysDept, moved, nil
 }
 
-func (d *SysDeptUseCase) DeleteDept(ctx context.Context, id int64) error {
-	return d.repo.Delete(ctx, id)
+// DeleteDept 删除部门，存在下级部门或用户时按 opts 级联删除或转移用户，
+// 否则拒绝删除；同时删除角色对这些部门的数据权限
+func (d *SysDeptUseCase) DeleteDept(ctx context.Context, id int64, opts DeleteOptions) error {
+	return d.tx.Transaction(ctx, func(ctx context.Context) error {
+		depts, err := d.repo.ListForUpdate(ctx)
+		if err != nil {
+			return err
+		}
+		exists := false
+		for _, dept := range depts {
+			exists = exists || dept.ID == id
+		}
+		if !exists {
+			return i18n.WithID(errors.NotFound("DEPT_NOT_FOUND", "部门不存在"), "dept.notFound")
+		}
+		ids := deptSubtree(depts, id)
+
+		var blockers DeleteBlockers
+		if !opts.Cascade {
+			blockers.Children = len(ids) - 1
+			ids = ids[:1]
+		}
+		users, err := d.userUseCase.findReferencing(ctx, userRefDept, ids)
+		if err != nil {
+			return err
+		}
+		if opts.ReassignTo == 0 {
+			blockers.Users = len(users)
+		} else if slices.Contains(ids, opts.ReassignTo) || !slices.ContainsFunc(depts, func(dept *model.SysDepts) bool {
+			return dept.ID == opts.ReassignTo
+		}) {
+			return errReassignInvalid
+		}
+		if err = blockers.Err(); err != nil {
+			return err
+		}
+
+		if err = d.userUseCase.reassign(ctx, userRefDept, users, ids, opts.ReassignTo); err != nil {
+			return err
+		}
+		if err = d.repo.DeleteRoleDeptsByDeptIds(ctx, ids...); err != nil {
+			return err
+		}
+		return d.repo.DeleteByIDs(ctx, operatorName(ctx), ids...)
+	})
 }
 
 func (d *SysDeptUseCase) buildDeptPath(ctx context.Context, dept *model.SysDepts) (string, error) {
@@ -0,0 +1,267 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// DeptMoveResult 移动部门子树的结果
+type DeptMoveResult struct {
+	// DeptIDs 路径被重写的部门，含被移动的部门本身
+	DeptIDs []int64
+	// RoleIDs 数据权限发生变化的角色
+	RoleIDs []int64
+	// RoleChanges 各角色数据权限的变化，按角色 id 排序
+	RoleChanges []*RoleDeptChange
+}
+
+// RoleDeptChange 移动部门后角色数据权限的变化
+type RoleDeptChange struct {
+	RoleID int64
+	// Granted 移动后新覆盖的部门
+	Granted []int64
+	// Revoked 移动后不再覆盖的部门
+	Revoked []int64
+}
+
+// roleDeptSnapshot 角色的数据权限部门，用于记录移动部门引起的角色变更历史
+type roleDeptSnapshot struct {
+	DeptIds []int64 `json:"dept_ids"`
+}
+
+// deptMovePlan 移动部门子树需要执行的变更
+type deptMovePlan struct {
+	subtree []int64
+	paths   map[int64]string
+	// grants 角色 -> 移动后新覆盖的部门
+	grants map[int64][]int64
+	// revokes 角色 -> 移动后不再覆盖的部门
+	revokes map[int64][]int64
+}
+
+// deptSubtree 按 parent_id 查找部门及其全部下级，自身在第一个
+func deptSubtree(depts []*model.SysDepts, id int64) []int64 {
+	children := make(map[int64][]int64, len(depts))
+	for _, dept := range depts {
+		children[dept.ParentID] = append(children[dept.ParentID], dept.ID)
+	}
+	subtree := []int64{id}
+	visited := map[int64]bool{id: true}
+	for i := 0; i < len(subtree); i++ {
+		for _, child := range children[subtree[i]] {
+			if !visited[child] {
+				visited[child] = true
+				subtree = append(subtree, child)
+			}
+		}
+	}
+	return subtree
+}
+
+// deptPathOf 按 parent_id 逐级向上生成部门路径，如 /0/1/3
+func deptPathOf(parents map[int64]int64, id int64) string {
+	path := "/" + strconv.FormatInt(id, 10)
+	for pid, depth := parents[id], 0; pid != 0 && depth < len(parents); pid, depth = parents[pid], depth+1 {
+		path = "/" + strconv.FormatInt(pid, 10) + path
+	}
+	return "/0" + path
+}
+
+// planDeptMove 计算把部门 id 移动到 parentID 下的变更。
+// 子树按 parent_id 计算，不依赖可能已经过期的 dept_path；
+// 角色数据权限以角色勾选的顶层部门为准，移动后子树中的部门
+// 只要自身或新的上级是顶层部门之一即属于该角色，子树外的数据不变
+func planDeptMove(depts []*model.SysDepts, roleDepts []*model.SysRoleDepts, id, parentID int64) (*deptMovePlan, error) {
+	parents := make(map[int64]int64, len(depts))
+	for _, dept := range depts {
+		parents[dept.ID] = dept.ParentID
+	}
+	if _, ok := parents[id]; !ok {
+		return nil, i18n.WithID(errors.NotFound("DEPT_NOT_FOUND", "部门不存在"), "dept.notFound")
+	}
+	if parentID != 0 {
+		if _, ok := parents[parentID]; !ok {
+			return nil, i18n.WithID(errors.BadRequest("DEPT_MOVE_INVALID", "上级部门不存在"), "dept.parentNotFound")
+		}
+	}
+
+	plan := &deptMovePlan{
+		subtree: deptSubtree(depts, id),
+		paths:   make(map[int64]string),
+		grants:  make(map[int64][]int64),
+		revokes: make(map[int64][]int64),
+	}
+	inSubtree := make(map[int64]bool, len(plan.subtree))
+	for _, deptID := range plan.subtree {
+		inSubtree[deptID] = true
+	}
+	if inSubtree[parentID] {
+		return nil, i18n.WithID(errors.BadRequest("DEPT_MOVE_INVALID", "不能移动到自身或下级部门下"), "dept.moveIntoSelf")
+	}
+
+	newParents := make(map[int64]int64, len(parents))
+	for deptID, pid := range parents {
+		newParents[deptID] = pid
+	}
+	newParents[id] = parentID
+	// ancestors 移动后自身及全部上级，由近到远
+	ancestors := func(deptID int64) []int64 {
+		chain := []int64{deptID}
+		for pid := newParents[deptID]; pid != 0 && len(chain) <= len(newParents); pid = newParents[pid] {
+			chain = append(chain, pid)
+		}
+		return chain
+	}
+	for _, deptID := range plan.subtree {
+		plan.paths[deptID] = deptPathOf(newParents, deptID)
+	}
+
+	roleSets := make(map[int64]map[int64]bool)
+	for _, rd := range roleDepts {
+		if roleSets[rd.RoleID] == nil {
+			roleSets[rd.RoleID] = make(map[int64]bool)
+		}
+		roleSets[rd.RoleID][rd.DeptID] = true
+	}
+	for roleID, set := range roleSets {
+		roots := make(map[int64]bool)
+		for deptID := range set {
+			if !set[parents[deptID]] {
+				roots[deptID] = true
+			}
+		}
+		for _, deptID := range plan.subtree {
+			covered := false
+			for _, a := range ancestors(deptID) {
+				if roots[a] {
+					covered = true
+					break
+				}
+			}
+			switch {
+			case covered && !set[deptID]:
+				plan.grants[roleID] = append(plan.grants[roleID], deptID)
+			case !covered && set[deptID]:
+				plan.revokes[roleID] = append(plan.revokes[roleID], deptID)
+			}
+		}
+	}
+	return plan, nil
+}
+
+// MoveDept 将部门及其全部下级移动到新的上级部门下，
+// 在同一事务中重写子树的部门路径并修正角色的数据权限，返回各角色数据权限的变化
+func (d *SysDeptUseCase) MoveDept(ctx context.Context, id, parentID int64) (*DeptMoveResult, error) {
+	oldDept, err := d.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	var result *DeptMoveResult
+	err = d.tx.Transaction(ctx, func(ctx context.Context) error {
+		result, err = d.moveSubtree(ctx, id, parentID)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	if newDept, err := d.repo.FindByID(ctx, id); err == nil {
+		RecordChange(ctx, ChangeEntityDept, id, oldDept, newDept)
+	}
+	return result, nil
+}
+
+// moveSubtree 移动部门子树，需要在事务中调用
+func (d *SysDeptUseCase) moveSubtree(ctx context.Context, id, parentID int64) (*DeptMoveResult, error) {
+	claims := authz.MustFromContext(ctx)
+	// 锁定全部部门，避免并发移动形成环
+	depts, err := d.repo.ListForUpdate(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roleDepts, err := d.repo.ListRoleDepts(ctx)
+	if err != nil {
+		return nil, err
+	}
+	plan, err := planDeptMove(depts, roleDepts, id, parentID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = d.repo.UpdateParent(ctx, id, parentID, claims.Nickname); err != nil {
+		return nil, err
+	}
+	if err = d.repo.UpdatePaths(ctx, plan.paths); err != nil {
+		return nil, err
+	}
+
+	changes := make(map[int64]*RoleDeptChange)
+	change := func(roleID int64) *RoleDeptChange {
+		if changes[roleID] == nil {
+			changes[roleID] = &RoleDeptChange{RoleID: roleID}
+		}
+		return changes[roleID]
+	}
+	for roleID, deptIDs := range plan.revokes {
+		if err = d.repo.DeleteRoleDepts(ctx, roleID, deptIDs...); err != nil {
+			return nil, err
+		}
+		change(roleID).Revoked = deptIDs
+	}
+	var rows []*model.SysRoleDepts
+	for roleID, deptIDs := range plan.grants {
+		for _, deptID := range deptIDs {
+			rows = append(rows, &model.SysRoleDepts{RoleID: roleID, DeptID: deptID})
+		}
+		change(roleID).Granted = deptIDs
+	}
+	if len(rows) > 0 {
+		if err = d.repo.CreateRoleDepts(ctx, rows...); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &DeptMoveResult{DeptIDs: plan.subtree, RoleIDs: make([]int64, 0, len(changes))}
+	for roleID := range changes {
+		result.RoleIDs = append(result.RoleIDs, roleID)
+	}
+	sort.Slice(result.RoleIDs, func(i, j int) bool { return result.RoleIDs[i] < result.RoleIDs[j] })
+	for _, roleID := range result.RoleIDs {
+		result.RoleChanges = append(result.RoleChanges, changes[roleID])
+	}
+	recordRoleDeptChanges(ctx, roleDepts, result.RoleChanges)
+	return result, nil
+}
+
+// recordRoleDeptChanges 将移动部门引起的角色数据权限变化记录到角色的变更历史
+func recordRoleDeptChanges(ctx context.Context, roleDepts []*model.SysRoleDepts, changes []*RoleDeptChange) {
+	if len(changes) == 0 {
+		return
+	}
+	before := make(map[int64][]int64)
+	for _, rd := range roleDepts {
+		before[rd.RoleID] = append(before[rd.RoleID], rd.DeptID)
+	}
+	for _, c := range changes {
+		revoked := make(map[int64]bool, len(c.Revoked))
+		for _, deptID := range c.Revoked {
+			revoked[deptID] = true
+		}
+		after := append([]int64{}, c.Granted...)
+		for _, deptID := range before[c.RoleID] {
+			if !revoked[deptID] {
+				after = append(after, deptID)
+			}
+		}
+		old := append([]int64{}, before[c.RoleID]...)
+		sort.Slice(old, func(i, j int) bool { return old[i] < old[j] })
+		sort.Slice(after, func(i, j int) bool { return after[i] < after[j] })
+		RecordChange(ctx, ChangeEntityRole, c.RoleID, roleDeptSnapshot{DeptIds: old}, roleDeptSnapshot{DeptIds: after})
+	}
+}
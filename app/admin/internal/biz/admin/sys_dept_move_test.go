@@ -0,0 +1,155 @@
+package admin
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+// testDepts 测试用的部门树：
+//
+//	1
+//	├─ 2
+//	│  └─ 3
+//	└─ 4
+//	   └─ 5
+//	6
+func testDepts() []*model.SysDepts {
+	return []*model.SysDepts{
+		{ID: 1, ParentID: 0},
+		{ID: 2, ParentID: 1},
+		{ID: 3, ParentID: 2},
+		{ID: 4, ParentID: 1},
+		{ID: 5, ParentID: 4},
+		{ID: 6, ParentID: 0},
+	}
+}
+
+// testRoleDepts 测试用的角色数据权限，注释为角色勾选的顶层部门
+func testRoleDepts() []*model.SysRoleDepts {
+	scopes := map[int64][]int64{
+		10: {4, 5},          // 4
+		11: {1, 2, 3, 4, 5}, // 1
+		12: {2, 3},          // 2
+		13: {3},             // 3
+		14: {6},             // 6
+	}
+	var rows []*model.SysRoleDepts
+	for roleID, deptIDs := range scopes {
+		for _, deptID := range deptIDs {
+			rows = append(rows, &model.SysRoleDepts{RoleID: roleID, DeptID: deptID})
+		}
+	}
+	return rows
+}
+
+func Test_planDeptMove(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       int64
+		parentID int64
+		paths    map[int64]string
+		grants   map[int64][]int64
+		revokes  map[int64][]int64
+	}{
+		{
+			name:     "移到兄弟部门下，新上级是顶层部门的角色覆盖整个子树",
+			id:       2,
+			parentID: 4,
+			paths:    map[int64]string{2: "/0/1/4/2", 3: "/0/1/4/2/3"},
+			grants:   map[int64][]int64{10: {2, 3}},
+			revokes:  map[int64][]int64{},
+		},
+		{
+			name:     "只因原上级而覆盖的部门被撤销，自身是顶层部门的保留",
+			id:       3,
+			parentID: 4,
+			paths:    map[int64]string{3: "/0/1/4/3"},
+			grants:   map[int64][]int64{10: {3}},
+			revokes:  map[int64][]int64{12: {3}},
+		},
+		{
+			name:     "移为顶级部门，离开原顶层部门覆盖的范围",
+			id:       2,
+			parentID: 0,
+			paths:    map[int64]string{2: "/0/2", 3: "/0/2/3"},
+			grants:   map[int64][]int64{},
+			revokes:  map[int64][]int64{11: {2, 3}},
+		},
+		{
+			name:     "移到另一棵树下，同时授予和撤销",
+			id:       4,
+			parentID: 6,
+			paths:    map[int64]string{4: "/0/6/4", 5: "/0/6/4/5"},
+			grants:   map[int64][]int64{14: {4, 5}},
+			revokes:  map[int64][]int64{11: {4, 5}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			plan, err := planDeptMove(testDepts(), testRoleDepts(), tt.id, tt.parentID)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !reflect.DeepEqual(plan.paths, tt.paths) {
+				t.Errorf("paths = %v, want %v", plan.paths, tt.paths)
+			}
+			if !reflect.DeepEqual(plan.grants, tt.grants) {
+				t.Errorf("grants = %v, want %v", plan.grants, tt.grants)
+			}
+			if !reflect.DeepEqual(plan.revokes, tt.revokes) {
+				t.Errorf("revokes = %v, want %v", plan.revokes, tt.revokes)
+			}
+		})
+	}
+}
+
+func Test_planDeptMoveInvalid(t *testing.T) {
+	tests := []struct {
+		name     string
+		id       int64
+		parentID int64
+		reason   string
+	}{
+		{name: "部门不存在", id: 99, parentID: 1, reason: "DEPT_NOT_FOUND"},
+		{name: "上级部门不存在", id: 2, parentID: 99, reason: "DEPT_MOVE_INVALID"},
+		{name: "移到自身下", id: 2, parentID: 2, reason: "DEPT_MOVE_INVALID"},
+		{name: "移到下级部门下", id: 2, parentID: 3, reason: "DEPT_MOVE_INVALID"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := planDeptMove(testDepts(), testRoleDepts(), tt.id, tt.parentID)
+			if reason := errors.Reason(err); reason != tt.reason {
+				t.Errorf("reason = %q, want %q", reason, tt.reason)
+			}
+		})
+	}
+}
+
+func Test_recordRoleDeptChanges(t *testing.T) {
+	ctx, recorder := WithChangeRecorder(context.Background())
+	recordRoleDeptChanges(ctx, testRoleDepts(), []*RoleDeptChange{
+		{RoleID: 11, Revoked: []int64{4, 5}},
+		{RoleID: 14, Granted: []int64{4, 5}},
+	})
+	changes := recorder.Changes()
+	if len(changes) != 2 {
+		t.Fatalf("changes = %d, want 2", len(changes))
+	}
+	want := map[int64][2]string{
+		11: {"[1,2,3,4,5]", "[1,2,3]"},
+		14: {"[6]", "[4,5,6]"},
+	}
+	for _, c := range changes {
+		if c.Entity != ChangeEntityRole || c.Field != "dept_ids" {
+			t.Errorf("change = %s.%s, want role.dept_ids", c.Entity, c.Field)
+		}
+		if got := [2]string{c.OldValue, c.NewValue}; got != want[c.EntityID] {
+			t.Errorf("role %d change = %v, want %v", c.EntityID, got, want[c.EntityID])
+		}
+	}
+}
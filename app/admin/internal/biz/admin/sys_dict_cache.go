@@ -0,0 +1,88 @@
+package admin
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+// 字典数据缓存，按字典类型一个 key，过期时间用于兜底读写并发时残留的旧数据
+const (
+	dictCacheKeyPrefix = "kva:dict:data:"
+	dictCacheExpire    = time.Hour
+)
+
+// dictCache 字典数据的读穿透缓存，Redis 不可用时直接读库
+type dictCache struct {
+	redis RedisRepo
+	log   *log.Helper
+}
+
+func newDictCache(redis RedisRepo, logger log.Logger) *dictCache {
+	return &dictCache{
+		redis: redis,
+		log:   log.NewHelper(log.With(logger, "module", "biz/dictCache")),
+	}
+}
+
+// load 先读缓存，未命中时调用 fetch 读库并写回缓存
+func (c *dictCache) load(ctx context.Context, dictType string, fetch func(context.Context, string) ([]*model.SysDictData, error)) ([]*model.SysDictData, error) {
+	key := dictCacheKeyPrefix + dictType
+	if cached := c.redis.Get(ctx, key); cached != "" {
+		var list []*model.SysDictData
+		if err := json.Unmarshal([]byte(cached), &list); err == nil {
+			return list, nil
+		}
+	}
+	list, err := fetch(ctx, dictType)
+	if err != nil {
+		return nil, err
+	}
+	if list == nil {
+		list = []*model.SysDictData{}
+	}
+	if payload, err := json.Marshal(list); err == nil {
+		if err = c.redis.Set(ctx, key, string(payload), dictCacheExpire); err != nil {
+			c.log.Warnf("写入字典缓存失败, dictType: %s, err: %v", dictType, err)
+		}
+	}
+	return list, nil
+}
+
+// invalidate 删除字典类型的缓存，在字典数据或字典类型变更后调用
+func (c *dictCache) invalidate(ctx context.Context, dictTypes ...string) {
+	keys := make([]string, 0, len(dictTypes))
+	seen := make(map[string]bool, len(dictTypes))
+	for _, t := range dictTypes {
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		keys = append(keys, dictCacheKeyPrefix+t)
+	}
+	if len(keys) == 0 {
+		return
+	}
+	if err := c.redis.Del(ctx, keys...); err != nil {
+		c.log.Errorf("删除字典缓存失败, keys: %v, err: %v", keys, err)
+	}
+}
+
+// DictETag 按字典数据内容计算 ETag，内容不变则 ETag 不变
+func DictETag(dictTypes []string, lists [][]*model.SysDictData) string {
+	h := sha256.New()
+	for i, t := range dictTypes {
+		h.Write([]byte(t))
+		h.Write([]byte{0})
+		payload, _ := json.Marshal(lists[i])
+		h.Write(payload)
+		h.Write([]byte{0})
+	}
+	return `"` + hex.EncodeToString(h.Sum(nil)[:16]) + `"`
+}
@@ -17,45 +17,113 @@ type SysDictDataRepo interface {
 	FindByID(ctx context.Context, id int64) (*model.SysDictData, error)
 	FindByIDList(ctx context.Context, ids ...int64) ([]*model.SysDictData, error)
 	FindAll(ctx context.Context) ([]*model.SysDictData, error)
+	FindByType(ctx context.Context, dictType string) ([]*model.SysDictData, error)
 	ListPage(ctx context.Context, dictLabel, dictType string, status int32, page, size int32) ([]*model.SysDictData, error)
 	ListPageCount(ctx context.Context, dictLabel, dictType string, status int32) (int32, error)
 }
 
 type SysDictDatumUseCase struct {
-	repo SysDictDataRepo
-	log  *log.Helper
+	repo        SysDictDataRepo
+	cache       *dictCache
+	translation *SysTranslationUseCase
+	log         *log.Helper
 }
 
-func NewSysDictDatumUseCase(repo SysDictDataRepo, logger log.Logger) *SysDictDatumUseCase {
-	return &SysDictDatumUseCase{repo: repo, log: log.NewHelper(logger)}
+func NewSysDictDatumUseCase(repo SysDictDataRepo, redis RedisRepo, translation *SysTranslationUseCase, logger log.Logger) *SysDictDatumUseCase {
+	return &SysDictDatumUseCase{repo: repo, cache: newDictCache(redis, logger), translation: translation, log: log.NewHelper(logger)}
 }
 
+// ListDictData 字典数据列表，标签按请求语言翻译
 func (p *SysDictDatumUseCase) ListDictData(ctx context.Context, dictLabel, dictType string, status int32, page, size int32) ([]*model.SysDictData, int32, error) {
+	// 下拉框按字典类型查询，走缓存后在内存中过滤和分页
+	if dictType != "" && dictLabel == "" {
+		list, total, err := p.listCached(ctx, dictType, status, page, size)
+		if err != nil {
+			return nil, 0, err
+		}
+		return p.translation.LocalizeDictData(ctx, list), total, nil
+	}
 	total, err := p.repo.ListPageCount(ctx, dictLabel, dictType, status)
 	if err != nil {
 		return nil, 0, err
 	}
 	posts, err := p.repo.ListPage(ctx, dictLabel, dictType, status, page, size)
-	return posts, total, err
+	if err != nil {
+		return nil, 0, err
+	}
+	return p.translation.LocalizeDictData(ctx, posts), total, nil
+}
+
+func (p *SysDictDatumUseCase) listCached(ctx context.Context, dictType string, status int32, page, size int32) ([]*model.SysDictData, int32, error) {
+	list, err := p.FindDictDataByType(ctx, dictType)
+	if err != nil {
+		return nil, 0, err
+	}
+	if status > 0 {
+		filtered := make([]*model.SysDictData, 0, len(list))
+		for _, d := range list {
+			if d.Status == status {
+				filtered = append(filtered, d)
+			}
+		}
+		list = filtered
+	}
+	if page <= 0 {
+		page = 1
+	}
+	if size <= 0 {
+		size = 10
+	}
+	total := int32(len(list))
+	start := min((page-1)*size, total)
+	end := min(start+size, total)
+	return list[start:end], total, nil
 }
 
 func (p *SysDictDatumUseCase) CreateDictData(ctx context.Context, post *model.SysDictData) (*model.SysDictData, error) {
 	claims := authz.MustFromContext(ctx)
 	post.CreateBy = claims.Nickname
 
-	err := p.repo.Create(ctx, post)
-	return post, err
+	if err := p.repo.Create(ctx, post); err != nil {
+		return post, err
+	}
+	p.cache.invalidate(ctx, post.DictType)
+	return post, nil
 }
 
 func (p *SysDictDatumUseCase) UpdateDictData(ctx context.Context, post *model.SysDictData) (*model.SysDictData, error) {
 	claims := authz.MustFromContext(ctx)
 	post.UpdateBy = claims.Nickname
-	err := p.repo.Save(ctx, post)
-	return post, err
+	oldData, _ := p.repo.FindByID(ctx, post.DictCode)
+	if err := p.repo.Save(ctx, post); err != nil {
+		return post, err
+	}
+	// 字典类型可能被修改，新旧类型的缓存都要删除
+	if oldData != nil {
+		p.cache.invalidate(ctx, oldData.DictType, post.DictType)
+	} else {
+		p.cache.invalidate(ctx, post.DictType)
+	}
+	if newData, err := p.repo.FindByID(ctx, post.DictCode); err == nil && oldData != nil {
+		RecordChange(ctx, ChangeEntityDictData, post.DictCode, oldData, newData)
+	}
+	return post, nil
 }
 
 func (p *SysDictDatumUseCase) DeleteDictData(ctx context.Context, id []int64) error {
-	return p.repo.Delete(ctx, id)
+	list, err := p.repo.FindByIDList(ctx, id...)
+	if err != nil {
+		return err
+	}
+	if err = p.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	dictTypes := make([]string, len(list))
+	for i, d := range list {
+		dictTypes[i] = d.DictType
+	}
+	p.cache.invalidate(ctx, dictTypes...)
+	return nil
 }
 
 func (p *SysDictDatumUseCase) FindDictDataByIDList(ctx context.Context, ids []int64) ([]*model.SysDictData, error) {
@@ -72,3 +140,35 @@ func (p *SysDictDatumUseCase) FindDictDataByID(ctx context.Context, id int64) (*
 func (p *SysDictDatumUseCase) FindDictDataAll(ctx context.Context) ([]*model.SysDictData, error) {
 	return p.repo.FindAll(ctx)
 }
+
+// FindDictDataByType 按字典类型查询，优先读缓存
+func (p *SysDictDatumUseCase) FindDictDataByType(ctx context.Context, dictType string) ([]*model.SysDictData, error) {
+	return p.cache.load(ctx, dictType, p.repo.FindByType)
+}
+
+// FindDictDataByTypes 批量查询多个字典类型，返回与 dictTypes 顺序一致、按请求语言翻译后的数据和内容 ETag，
+// ETag 按翻译后的内容计算，不同语言的数据互不命中
+func (p *SysDictDatumUseCase) FindDictDataByTypes(ctx context.Context, dictTypes []string) ([][]*model.SysDictData, string, error) {
+	lists := make([][]*model.SysDictData, len(dictTypes))
+	for i, t := range dictTypes {
+		list, err := p.FindDictDataByType(ctx, t)
+		if err != nil {
+			return nil, "", err
+		}
+		lists[i] = p.translation.LocalizeDictData(ctx, list)
+	}
+	return lists, DictETag(dictTypes, lists), nil
+}
+
+// DictLabels 返回字典类型下 值 -> 标签 的映射
+func (p *SysDictDatumUseCase) DictLabels(ctx context.Context, dictType string) (map[string]string, error) {
+	list, err := p.FindDictDataByType(ctx, dictType)
+	if err != nil {
+		return nil, err
+	}
+	labels := make(map[string]string, len(list))
+	for _, d := range list {
+		labels[d.DictValue] = d.DictLabel
+	}
+	return labels, nil
+}
@@ -0,0 +1,423 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// DictExportFile 字典导出文件
+type DictExportFile struct {
+	Dicts []*DictExport `json:"dicts" yaml:"dicts"`
+}
+
+// DictExport 字典类型导出格式，不包含自增id，便于在不同环境间迁移
+type DictExport struct {
+	DictType string            `json:"dictType" yaml:"dictType"`
+	DictName string            `json:"dictName" yaml:"dictName"`
+	Status   int32             `json:"status" yaml:"status"`
+	Remark   string            `json:"remark,omitempty" yaml:"remark,omitempty"`
+	Data     []*DictDataExport `json:"data" yaml:"data"`
+}
+
+// DictDataExport 字典数据导出格式，同一字典类型下按 dictValue 匹配
+type DictDataExport struct {
+	DictLabel string `json:"dictLabel" yaml:"dictLabel"`
+	DictValue string `json:"dictValue" yaml:"dictValue"`
+	DictSort  int32  `json:"dictSort" yaml:"dictSort"`
+	Status    int32  `json:"status" yaml:"status"`
+	CssClass  string `json:"cssClass,omitempty" yaml:"cssClass,omitempty"`
+	ListClass string `json:"listClass,omitempty" yaml:"listClass,omitempty"`
+	IsDefault string `json:"isDefault,omitempty" yaml:"isDefault,omitempty"`
+	Remark    string `json:"remark,omitempty" yaml:"remark,omitempty"`
+}
+
+// 字典导入时已存在的字典类型和数据的处理方式
+const (
+	DictImportModeSkip      = "skip"
+	DictImportModeOverwrite = "overwrite"
+)
+
+// 字典导入结果中的动作
+const (
+	DictImportCreate    = "create"
+	DictImportUpdate    = "update"
+	DictImportSkip      = "skip"
+	DictImportUnchanged = "unchanged"
+)
+
+// DictImportResult 单个字典类型的导入结果
+type DictImportResult struct {
+	DictType      string
+	Action        string // 字典类型本身的动作：create、update、skip、unchanged
+	ChangedFields []string
+	CreatedValues []string
+	UpdatedValues []string
+	SkippedValues []string
+}
+
+// dictTableHeader 表格格式（xlsx）的表头，每行一条字典数据，没有数据的字典类型占一行
+var dictTableHeader = []string{"字典类型", "字典名称", "类型状态", "类型备注", "字典标签", "字典键值", "排序", "状态", "样式属性", "回显样式", "是否默认", "备注"}
+
+// Table 将导出文件转换为表格
+func (f *DictExportFile) Table() [][]string {
+	rows := [][]string{dictTableHeader}
+	for _, d := range f.Dicts {
+		typeCells := []string{d.DictType, d.DictName, strconv.Itoa(int(d.Status)), d.Remark}
+		if len(d.Data) == 0 {
+			rows = append(rows, typeCells)
+			continue
+		}
+		for _, v := range d.Data {
+			row := append(append([]string{}, typeCells...),
+				v.DictLabel, v.DictValue, strconv.Itoa(int(v.DictSort)), strconv.Itoa(int(v.Status)),
+				v.CssClass, v.ListClass, v.IsDefault, v.Remark)
+			rows = append(rows, row)
+		}
+	}
+	return rows
+}
+
+// ParseDictTable 按表头解析表格格式的字典，字典类型的字段取该类型第一行的值
+func ParseDictTable(rows [][]string) (*DictExportFile, error) {
+	if len(rows) == 0 {
+		return nil, i18n.WithID(errors.BadRequest("DICT_IMPORT_INVALID", "导入内容为空"), "import.empty")
+	}
+	index := make(map[string]int, len(rows[0]))
+	for i, title := range rows[0] {
+		index[strings.TrimSpace(title)] = i
+	}
+	for _, title := range dictTableHeader[:2] {
+		if _, ok := index[title]; !ok {
+			return nil, i18n.WithID(errors.BadRequest("DICT_IMPORT_INVALID", "表头缺少列: "+title), "import.missingColumn", title)
+		}
+	}
+
+	file := &DictExportFile{}
+	byType := make(map[string]*DictExport)
+	for n, row := range rows[1:] {
+		cell := func(title string) string {
+			if i, ok := index[title]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		number := func(title string) (int32, error) {
+			s := cell(title)
+			if s == "" {
+				return 0, nil
+			}
+			v, err := strconv.ParseInt(s, 10, 32)
+			if err != nil {
+				return 0, i18n.WithID(errors.BadRequest("DICT_IMPORT_INVALID", "第 "+strconv.Itoa(n+2)+" 行 "+title+" 不是整数: "+s), "import.notInteger", strconv.Itoa(n+2), title, s)
+			}
+			return int32(v), nil
+		}
+
+		dictType := cell("字典类型")
+		if dictType == "" {
+			continue
+		}
+		d, ok := byType[dictType]
+		if !ok {
+			status, err := number("类型状态")
+			if err != nil {
+				return nil, err
+			}
+			d = &DictExport{
+				DictType: dictType,
+				DictName: cell("字典名称"),
+				Status:   status,
+				Remark:   cell("类型备注"),
+				Data:     make([]*DictDataExport, 0),
+			}
+			byType[dictType] = d
+			file.Dicts = append(file.Dicts, d)
+		}
+		if cell("字典键值") == "" && cell("字典标签") == "" {
+			continue
+		}
+		sortNo, err := number("排序")
+		if err != nil {
+			return nil, err
+		}
+		status, err := number("状态")
+		if err != nil {
+			return nil, err
+		}
+		d.Data = append(d.Data, &DictDataExport{
+			DictLabel: cell("字典标签"),
+			DictValue: cell("字典键值"),
+			DictSort:  sortNo,
+			Status:    status,
+			CssClass:  cell("样式属性"),
+			ListClass: cell("回显样式"),
+			IsDefault: cell("是否默认"),
+			Remark:    cell("备注"),
+		})
+	}
+	return file, nil
+}
+
+// dictTransferContext 导入导出时共用的字典类型和数据索引
+type dictTransferContext struct {
+	types      []*model.SysDictTypes
+	typeByName map[string]*model.SysDictTypes
+	// dataByType 字典类型 -> 字典键值 -> 字典数据
+	dataByType map[string]map[string]*model.SysDictData
+	dataList   map[string][]*model.SysDictData
+}
+
+func (p *SysDictTypeUseCase) loadTransferContext(ctx context.Context) (*dictTransferContext, error) {
+	types, err := p.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	data, err := p.dataRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tc := &dictTransferContext{
+		types:      types,
+		typeByName: make(map[string]*model.SysDictTypes, len(types)),
+		dataByType: make(map[string]map[string]*model.SysDictData, len(types)),
+		dataList:   make(map[string][]*model.SysDictData, len(types)),
+	}
+	for _, t := range types {
+		tc.typeByName[t.DictType] = t
+	}
+	for _, d := range data {
+		if tc.dataByType[d.DictType] == nil {
+			tc.dataByType[d.DictType] = make(map[string]*model.SysDictData)
+		}
+		tc.dataByType[d.DictType][d.DictValue] = d
+		tc.dataList[d.DictType] = append(tc.dataList[d.DictType], d)
+	}
+	for _, list := range tc.dataList {
+		sort.SliceStable(list, func(i, j int) bool {
+			if list[i].DictSort != list[j].DictSort {
+				return list[i].DictSort < list[j].DictSort
+			}
+			return list[i].DictCode < list[j].DictCode
+		})
+	}
+	return tc, nil
+}
+
+// ExportDicts 导出字典类型及其数据，dictTypes 为空时导出全部
+func (p *SysDictTypeUseCase) ExportDicts(ctx context.Context, dictTypes []string) (*DictExportFile, error) {
+	tc, err := p.loadTransferContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	types := tc.types
+	if len(dictTypes) > 0 {
+		types = make([]*model.SysDictTypes, 0, len(dictTypes))
+		for _, name := range dictTypes {
+			t, ok := tc.typeByName[name]
+			if !ok {
+				return nil, i18n.WithID(errors.NotFound("DICT_TYPE_NOT_FOUND", "字典类型不存在: "+name), "dict.typeNotFound", name)
+			}
+			types = append(types, t)
+		}
+	}
+
+	file := &DictExportFile{Dicts: make([]*DictExport, 0, len(types))}
+	for _, t := range types {
+		out := &DictExport{
+			DictType: t.DictType,
+			DictName: t.DictName,
+			Status:   t.Status,
+			Remark:   t.Remark,
+			Data:     make([]*DictDataExport, 0, len(tc.dataList[t.DictType])),
+		}
+		for _, d := range tc.dataList[t.DictType] {
+			out.Data = append(out.Data, exportDictData(d))
+		}
+		file.Dicts = append(file.Dicts, out)
+	}
+	return file, nil
+}
+
+func exportDictData(d *model.SysDictData) *DictDataExport {
+	return &DictDataExport{
+		DictLabel: d.DictLabel,
+		DictValue: d.DictValue,
+		DictSort:  d.DictSort,
+		Status:    d.Status,
+		CssClass:  d.CSSClass,
+		ListClass: d.ListClass,
+		IsDefault: d.IsDefault,
+		Remark:    d.Remark,
+	}
+}
+
+// ImportDicts 按 dictType 和 dictValue 合并导入字典，文件中没有的字典和数据保持不变。
+// mode 为 skip 时已存在的字典类型和数据不修改，为 overwrite 时用导入内容覆盖；
+// dryRun 为 true 时只返回导入结果，否则在同一个事务中写入
+func (p *SysDictTypeUseCase) ImportDicts(ctx context.Context, file *DictExportFile, mode string, dryRun bool) ([]*DictImportResult, error) {
+	if mode == "" {
+		mode = DictImportModeSkip
+	}
+	if mode != DictImportModeSkip && mode != DictImportModeOverwrite {
+		return nil, i18n.WithID(errors.BadRequest("DICT_IMPORT_MODE_INVALID", "导入模式只能是 skip 或 overwrite"), "dict.importModeInvalid")
+	}
+	inFile := make(map[string]struct{}, len(file.Dicts))
+	for _, in := range file.Dicts {
+		if in.DictType == "" {
+			return nil, i18n.WithID(errors.BadRequest("DICT_TYPE_MISSING", "导入的字典缺少 dictType"), "dict.typeMissing")
+		}
+		if _, ok := inFile[in.DictType]; ok {
+			return nil, i18n.WithID(errors.BadRequest("DICT_TYPE_DUPLICATE", "导入的字典 dictType 重复: "+in.DictType), "dict.typeDuplicate", in.DictType)
+		}
+		inFile[in.DictType] = struct{}{}
+		values := make(map[string]struct{}, len(in.Data))
+		for _, v := range in.Data {
+			if v.DictValue == "" {
+				return nil, i18n.WithID(errors.BadRequest("DICT_VALUE_MISSING", "字典 "+in.DictType+" 的数据缺少 dictValue"), "dict.valueMissing", in.DictType)
+			}
+			if _, ok := values[v.DictValue]; ok {
+				return nil, i18n.WithID(errors.BadRequest("DICT_VALUE_DUPLICATE", "字典 "+in.DictType+" 的 dictValue 重复: "+v.DictValue), "dict.valueDuplicate", in.DictType, v.DictValue)
+			}
+			values[v.DictValue] = struct{}{}
+		}
+	}
+
+	tc, err := p.loadTransferContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+	overwrite := mode == DictImportModeOverwrite
+	results := make([]*DictImportResult, 0, len(file.Dicts))
+	for _, in := range file.Dicts {
+		result := &DictImportResult{DictType: in.DictType, Action: DictImportCreate}
+		if current, ok := tc.typeByName[in.DictType]; ok {
+			result.ChangedFields = dictTypeChangedFields(current, in)
+			switch {
+			case len(result.ChangedFields) == 0:
+				result.Action = DictImportUnchanged
+			case overwrite:
+				result.Action = DictImportUpdate
+			default:
+				result.Action = DictImportSkip
+			}
+		}
+		for _, v := range in.Data {
+			current, ok := tc.dataByType[in.DictType][v.DictValue]
+			switch {
+			case !ok:
+				result.CreatedValues = append(result.CreatedValues, v.DictValue)
+			case *exportDictData(current) == *v:
+			case overwrite:
+				result.UpdatedValues = append(result.UpdatedValues, v.DictValue)
+			default:
+				result.SkippedValues = append(result.SkippedValues, v.DictValue)
+			}
+		}
+		results = append(results, result)
+	}
+	if dryRun {
+		return results, nil
+	}
+
+	nickname := ""
+	if claims, err := authz.FromContext(ctx); err == nil {
+		nickname = claims.Nickname
+	}
+	err = p.tx.Transaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		for i, in := range file.Dicts {
+			result := results[i]
+			switch result.Action {
+			case DictImportCreate:
+				t := &model.SysDictTypes{
+					DictType: in.DictType, DictName: in.DictName, Status: in.Status, Remark: in.Remark,
+					CreateBy: nickname, CreateTime: now, UpdateTime: now,
+				}
+				if err := p.repo.Create(ctx, t); err != nil {
+					return err
+				}
+			case DictImportUpdate:
+				t := *tc.typeByName[in.DictType]
+				t.DictName, t.Status, t.Remark = in.DictName, in.Status, in.Remark
+				t.UpdateBy, t.UpdateTime = nickname, now
+				if err := p.repo.Save(ctx, &t); err != nil {
+					return err
+				}
+			}
+
+			created := make(map[string]bool, len(result.CreatedValues))
+			for _, v := range result.CreatedValues {
+				created[v] = true
+			}
+			updated := make(map[string]bool, len(result.UpdatedValues))
+			for _, v := range result.UpdatedValues {
+				updated[v] = true
+			}
+			for _, v := range in.Data {
+				switch {
+				case created[v.DictValue]:
+					d := &model.SysDictData{DictType: in.DictType, CreateBy: nickname, CreateTime: now}
+					applyDictDataExport(d, v, nickname, now)
+					if err := p.dataRepo.Create(ctx, d); err != nil {
+						return err
+					}
+				case updated[v.DictValue]:
+					d := *tc.dataByType[in.DictType][v.DictValue]
+					applyDictDataExport(&d, v, nickname, now)
+					if err := p.dataRepo.Save(ctx, &d); err != nil {
+						return err
+					}
+				}
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	changed := make([]string, 0, len(results))
+	for _, r := range results {
+		if r.Action == DictImportCreate || r.Action == DictImportUpdate || len(r.CreatedValues) > 0 || len(r.UpdatedValues) > 0 {
+			changed = append(changed, r.DictType)
+		}
+	}
+	p.cache.invalidate(ctx, changed...)
+	return results, nil
+}
+
+func applyDictDataExport(d *model.SysDictData, v *DictDataExport, nickname string, now time.Time) {
+	d.DictLabel = v.DictLabel
+	d.DictValue = v.DictValue
+	d.DictSort = v.DictSort
+	d.Status = v.Status
+	d.CSSClass = v.CssClass
+	d.ListClass = v.ListClass
+	d.IsDefault = v.IsDefault
+	d.Remark = v.Remark
+	d.UpdateBy = nickname
+	d.UpdateTime = now
+}
+
+func dictTypeChangedFields(current *model.SysDictTypes, in *DictExport) []string {
+	var fields []string
+	if current.DictName != in.DictName {
+		fields = append(fields, "dictName")
+	}
+	if current.Status != in.Status {
+		fields = append(fields, "status")
+	}
+	if current.Remark != in.Remark {
+		fields = append(fields, "remark")
+	}
+	return fields
+}
@@ -21,12 +21,21 @@ type SysDictTypeRepo interface {
 }
 
 type SysDictTypeUseCase struct {
-	repo SysDictTypeRepo
-	log  *log.Helper
+	repo     SysDictTypeRepo
+	dataRepo SysDictDataRepo
+	tx       Transaction
+	cache    *dictCache
+	log      *log.Helper
 }
 
-func NewSysDictTypeUseCase(repo SysDictTypeRepo, logger log.Logger) *SysDictTypeUseCase {
-	return &SysDictTypeUseCase{repo: repo, log: log.NewHelper(logger)}
+func NewSysDictTypeUseCase(repo SysDictTypeRepo, dataRepo SysDictDataRepo, tx Transaction, redis RedisRepo, logger log.Logger) *SysDictTypeUseCase {
+	return &SysDictTypeUseCase{
+		repo:     repo,
+		dataRepo: dataRepo,
+		tx:       tx,
+		cache:    newDictCache(redis, logger),
+		log:      log.NewHelper(logger),
+	}
 }
 
 func (p *SysDictTypeUseCase) ListDictType(ctx context.Context, dictName, dictType string, status int32, page, size int32) ([]*model.SysDictTypes, int32, error) {
@@ -49,12 +58,35 @@ func (p *SysDictTypeUseCase) CreateDictType(ctx context.Context, post *model.Sys
 func (p *SysDictTypeUseCase) UpdateDictType(ctx context.Context, post *model.SysDictTypes) (*model.SysDictTypes, error) {
 	claims := authz.MustFromContext(ctx)
 	post.UpdateBy = claims.Nickname
-	err := p.repo.Save(ctx, post)
-	return post, err
+	oldType, _ := p.repo.FindByID(ctx, post.DictID)
+	if err := p.repo.Save(ctx, post); err != nil {
+		return post, err
+	}
+	if oldType != nil {
+		p.cache.invalidate(ctx, oldType.DictType, post.DictType)
+	} else {
+		p.cache.invalidate(ctx, post.DictType)
+	}
+	if newType, err := p.repo.FindByID(ctx, post.DictID); err == nil && oldType != nil {
+		RecordChange(ctx, ChangeEntityDictType, post.DictID, oldType, newType)
+	}
+	return post, nil
 }
 
 func (p *SysDictTypeUseCase) DeleteDictType(ctx context.Context, id []int64) error {
-	return p.repo.Delete(ctx, id)
+	list, err := p.repo.FindByIDList(ctx, id...)
+	if err != nil {
+		return err
+	}
+	if err = p.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	dictTypes := make([]string, len(list))
+	for i, t := range list {
+		dictTypes[i] = t.DictType
+	}
+	p.cache.invalidate(ctx, dictTypes...)
+	return nil
 }
 
 func (p *SysDictTypeUseCase) FindDictTypeByIDList(ctx context.Context, ids []int64) ([]*model.SysDictTypes, error) {
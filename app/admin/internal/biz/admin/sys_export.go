@@ -0,0 +1,331 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/export"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// 导出任务状态
+const (
+	ExportTaskStatusRunning  int32 = 1 // 导出中
+	ExportTaskStatusFinished int32 = 2 // 已完成
+	ExportTaskStatusFailed   int32 = 3 // 失败
+)
+
+const (
+	// exportSyncLimit 不超过该行数时直接在请求中返回文件，否则转为后台任务
+	exportSyncLimit = 5000
+	// exportPageSize 导出时每页读取的行数
+	exportPageSize = 500
+	// exportWorkers 同时执行的后台导出任务数
+	exportWorkers = 2
+	// exportTimeout 单个后台导出任务的超时时间
+	exportTimeout = 30 * time.Minute
+	// exportExpire 导出文件保留时间
+	exportExpire = 24 * time.Hour
+	// exportStaleGrace 超过超时时间仍为导出中的任务视为中断的宽限时间
+	exportStaleGrace = 5 * time.Minute
+)
+
+// ExportColumn 导出列，DictType 不为空时按字典将值转换为标签
+type ExportColumn struct {
+	Title    string
+	DictType string
+}
+
+// ExportSource 导出数据源，由各列表接口按相同的过滤条件构造
+type ExportSource struct {
+	Name    string
+	Columns []ExportColumn
+	// Fetch 分页读取并返回总行数，page 从 1 开始，每行与 Columns 一一对应
+	Fetch func(ctx context.Context, page, size int32) ([][]string, int64, error)
+	// Scan 可选，按 id 游标读取 id 大于 after 的行并返回最后一行的 id，
+	// 设置时代替 Fetch 导出数据，避免导出期间持续写入的表按偏移分页时跳过或重复行
+	Scan func(ctx context.Context, after int64, size int32) ([][]string, int64, error)
+}
+
+// SysExportTaskRepo 接口定义
+type SysExportTaskRepo interface {
+	Create(ctx context.Context, task *model.SysExportTasks) error
+	Update(ctx context.Context, task *model.SysExportTasks) error
+	FindByID(ctx context.Context, id int64) (*model.SysExportTasks, error)
+	ListPage(ctx context.Context, userID int64, page, size int32) ([]*model.SysExportTasks, error)
+	Count(ctx context.Context, userID int64) (int32, error)
+	FindExpired(ctx context.Context, now time.Time) ([]*model.SysExportTasks, error)
+	Delete(ctx context.Context, id int64) error
+	// FailRunning 将 createdBefore 之前创建、仍为导出中的任务标记为失败，node 为空时不限节点，返回更新条数
+	FailRunning(ctx context.Context, node string, createdBefore time.Time, message string) (int64, error)
+}
+
+type SysExportUseCase struct {
+	repo      SysExportTaskRepo
+	dictCase  *SysDictDatumUseCase
+	dir       string
+	node      string
+	startedAt time.Time
+	workers   chan struct{}
+	log       *log.Helper
+}
+
+// NewSysExportUseCase 导出文件写入 data.export.dir，多实例部署时应为共享存储；
+// 任务记录执行的节点，文件不在共享存储时只能由该节点读取和清理
+func NewSysExportUseCase(c *conf.Data, repo SysExportTaskRepo, dictCase *SysDictDatumUseCase, logger log.Logger) *SysExportUseCase {
+	dir := c.GetExport().GetDir()
+	if dir == "" {
+		dir = filepath.Join(os.TempDir(), "kva-export")
+	}
+	node, _ := os.Hostname()
+	return &SysExportUseCase{
+		repo:      repo,
+		dictCase:  dictCase,
+		dir:       dir,
+		node:      node,
+		startedAt: time.Now(),
+		workers:   make(chan struct{}, exportWorkers),
+		log:       log.NewHelper(log.With(logger, "module", "biz/export")),
+	}
+}
+
+// NeedTask 判断导出行数是否超过同步导出的上限
+func (e *SysExportUseCase) NeedTask(ctx context.Context, src *ExportSource) (bool, error) {
+	_, total, err := src.Fetch(ctx, 1, 1)
+	if err != nil {
+		return false, err
+	}
+	return total > exportSyncLimit, nil
+}
+
+// Write 按页读取数据源写入 w，返回写入的数据行数
+func (e *SysExportUseCase) Write(ctx context.Context, src *ExportSource, format string, w io.Writer) (int64, error) {
+	writer, err := export.NewWriter(format, w)
+	if err != nil {
+		return 0, err
+	}
+	header := make([]string, len(src.Columns))
+	labels := make([]map[string]string, len(src.Columns))
+	for i, col := range src.Columns {
+		header[i] = col.Title
+		if col.DictType != "" {
+			if labels[i], err = e.dictCase.DictLabels(ctx, col.DictType); err != nil {
+				return 0, err
+			}
+		}
+	}
+	if err = writer.Write(header); err != nil {
+		return 0, err
+	}
+
+	var rows, after int64
+	for page := int32(1); ; page++ {
+		var list [][]string
+		if src.Scan != nil {
+			list, after, err = src.Scan(ctx, after, exportPageSize)
+		} else {
+			list, _, err = src.Fetch(ctx, page, exportPageSize)
+		}
+		if err != nil {
+			return rows, err
+		}
+		for _, row := range list {
+			for i, value := range row {
+				if i < len(labels) && labels[i] != nil {
+					if label, ok := labels[i][value]; ok {
+						row[i] = label
+					}
+				}
+			}
+			if err = writer.Write(row); err != nil {
+				return rows, err
+			}
+			rows++
+		}
+		if len(list) < exportPageSize {
+			break
+		}
+	}
+	return rows, writer.Close()
+}
+
+// ExportFileName 下载文件名
+func ExportFileName(name, format string) string {
+	return fmt.Sprintf("%s_%s.%s", name, time.Now().Format("20060102150405"), format)
+}
+
+// Submit 创建后台导出任务，完成后通过下载链接获取文件
+func (e *SysExportUseCase) Submit(ctx context.Context, src *ExportSource, format string) (*model.SysExportTasks, error) {
+	claims := authz.MustFromContext(ctx)
+	now := time.Now()
+	task := &model.SysExportTasks{
+		Name:         src.Name,
+		Format:       format,
+		Status:       ExportTaskStatusRunning,
+		FileName:     ExportFileName(src.Name, format),
+		Node:         e.node,
+		CreateUserID: claims.UserID,
+		CreateBy:     claims.Nickname,
+		ExpireAt:     now.Add(exportExpire),
+		CreatedAt:    now,
+		UpdatedAt:    now,
+	}
+	if err := e.repo.Create(ctx, task); err != nil {
+		return nil, err
+	}
+	e.log.Infof("导出任务已创建, id: %d, name: %s, by: %s", task.ID, task.Name, task.CreateBy)
+	go e.run(task, src)
+	return task, nil
+}
+
+// run 在后台执行导出，使用独立的 context，请求结束后继续执行；
+// 超时从任务创建时开始计算，包含排队等待的时间
+func (e *SysExportUseCase) run(task *model.SysExportTasks, src *ExportSource) {
+	ctx, cancel := context.WithDeadline(context.Background(), task.CreatedAt.Add(exportTimeout))
+	defer cancel()
+
+	var rows int64
+	var err error
+	select {
+	case e.workers <- struct{}{}:
+		rows, err = e.writeFile(ctx, task, src)
+		<-e.workers
+	case <-ctx.Done():
+		err = ctx.Err()
+	}
+	task.Rows = rows
+	task.UpdatedAt = time.Now()
+	if err != nil {
+		task.Status = ExportTaskStatusFailed
+		task.ErrorMessage = err.Error()
+		e.log.Errorf("导出任务失败, id: %d, err: %v", task.ID, err)
+	} else {
+		task.Status = ExportTaskStatusFinished
+		e.log.Infof("导出任务已完成, id: %d, rows: %d", task.ID, rows)
+	}
+	// 超时后 ctx 已取消，状态使用新的 context 更新
+	if err = e.repo.Update(context.Background(), task); err != nil {
+		e.log.Errorf("更新导出任务状态失败, id: %d, err: %v", task.ID, err)
+	}
+}
+
+func (e *SysExportUseCase) writeFile(ctx context.Context, task *model.SysExportTasks, src *ExportSource) (int64, error) {
+	if err := os.MkdirAll(e.dir, 0o755); err != nil {
+		return 0, err
+	}
+	task.FilePath = filepath.Join(e.dir, strconv.FormatInt(task.ID, 10)+"."+task.Format)
+	f, err := os.Create(task.FilePath)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+	rows, err := e.Write(ctx, src, task.Format, f)
+	if err != nil {
+		_ = os.Remove(task.FilePath)
+		task.FilePath = ""
+	}
+	return rows, err
+}
+
+// FindTask 查询导出任务，只能查看自己的任务
+func (e *SysExportUseCase) FindTask(ctx context.Context, id int64) (*model.SysExportTasks, error) {
+	claims := authz.MustFromContext(ctx)
+	task, err := e.repo.FindByID(ctx, id)
+	if err != nil || task.CreateUserID != claims.UserID {
+		return nil, i18n.WithID(errors.NotFound("EXPORT_TASK_NOT_FOUND", "导出任务不存在"), "export.taskNotFound")
+	}
+	return task, nil
+}
+
+// Open 打开已完成的导出文件
+func (e *SysExportUseCase) Open(ctx context.Context, id int64) (*model.SysExportTasks, *os.File, error) {
+	task, err := e.FindTask(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if task.Status != ExportTaskStatusFinished {
+		return nil, nil, i18n.WithID(errors.BadRequest("EXPORT_TASK_NOT_READY", "导出任务未完成"), "export.taskNotReady")
+	}
+	if !task.ExpireAt.After(time.Now()) {
+		return nil, nil, i18n.WithID(errors.NotFound("EXPORT_TASK_EXPIRED", "导出文件已过期"), "export.fileExpired")
+	}
+	f, err := os.Open(task.FilePath)
+	if os.IsNotExist(err) && task.Node != e.node {
+		return nil, nil, i18n.WithID(errors.ServiceUnavailable("EXPORT_FILE_ON_OTHER_NODE", "导出文件位于其他节点: "+task.Node), "export.fileOnOtherNode", task.Node)
+	}
+	if err != nil {
+		return nil, nil, i18n.WithID(errors.NotFound("EXPORT_TASK_EXPIRED", "导出文件已过期"), "export.fileExpired")
+	}
+	return task, f, nil
+}
+
+// ListTasks 当前用户的导出任务列表
+func (e *SysExportUseCase) ListTasks(ctx context.Context, page, size int32) ([]*model.SysExportTasks, int32, error) {
+	claims := authz.MustFromContext(ctx)
+	total, err := e.repo.Count(ctx, claims.UserID)
+	if err != nil {
+		return nil, 0, err
+	}
+	list, err := e.repo.ListPage(ctx, claims.UserID, page, size)
+	return list, total, err
+}
+
+// CleanExpired 删除过期的导出文件和任务，由定时任务调用
+func (e *SysExportUseCase) CleanExpired(ctx context.Context) error {
+	list, err := e.repo.FindExpired(ctx, time.Now())
+	if err != nil {
+		return err
+	}
+	for _, task := range list {
+		if task.FilePath != "" {
+			err = os.Remove(task.FilePath)
+			if os.IsNotExist(err) && task.Node != e.node {
+				// 文件不在共享存储时由执行导出的节点清理
+				continue
+			}
+			if err != nil && !os.IsNotExist(err) {
+				e.log.Errorf("删除导出文件失败, id: %d, err: %v", task.ID, err)
+				continue
+			}
+		}
+		if err = e.repo.Delete(ctx, task.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FailInterrupted 将本节点重启前未完成的任务标记为失败，服务启动时调用
+func (e *SysExportUseCase) FailInterrupted(ctx context.Context) error {
+	n, err := e.repo.FailRunning(ctx, e.node, e.startedAt, "服务重启，导出已中断")
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		e.log.Infof("已将 %d 个中断的导出任务标记为失败, node: %s", n, e.node)
+	}
+	return nil
+}
+
+// FailStale 将超过超时时间仍为导出中的任务标记为失败，执行节点已下线时由其他节点处理，由定时任务调用
+func (e *SysExportUseCase) FailStale(ctx context.Context) error {
+	n, err := e.repo.FailRunning(ctx, "", time.Now().Add(-exportTimeout-exportStaleGrace), "导出超时")
+	if err != nil {
+		return err
+	}
+	if n > 0 {
+		e.log.Infof("已将 %d 个超时的导出任务标记为失败", n)
+	}
+	return nil
+}
@@ -0,0 +1,175 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+// 记录字段变更的实体类型
+const (
+	ChangeEntityUser     = "user"
+	ChangeEntityRole     = "role"
+	ChangeEntityDept     = "dept"
+	ChangeEntityMenu     = "menu"
+	ChangeEntityDictType = "dict_type"
+	ChangeEntityDictData = "dict_data"
+)
+
+// changeMaskedValue 敏感字段变更时记录的值
+const changeMaskedValue = "******"
+
+// changeIgnoredFields 不记录变更的字段，按 json 名称
+var changeIgnoredFields = map[string]struct{}{
+	"created_at": {},
+	"updated_at": {},
+	"deleted_at": {},
+	"create_by":  {},
+	"update_by":  {},
+}
+
+// changeMaskedFields 只记录发生变更、不记录具体值的敏感字段
+var changeMaskedFields = map[string]struct{}{
+	"password": {},
+	"salt":     {},
+	"secret":   {},
+}
+
+// SysLogChangeWithUser 字段变更及操作用户
+type SysLogChangeWithUser struct {
+	model.SysLogChanges
+	Username string
+	NickName string
+}
+
+type changeRecorderKey struct{}
+
+// ChangeRecorder 收集一次请求中的实体字段变更，请求成功后由变更历史中间件写入
+type ChangeRecorder struct {
+	mu      sync.Mutex
+	changes []model.SysLogChanges
+}
+
+// WithChangeRecorder 在 context 中放入变更收集器，由变更历史中间件调用
+func WithChangeRecorder(ctx context.Context) (context.Context, *ChangeRecorder) {
+	recorder := &ChangeRecorder{}
+	return context.WithValue(ctx, changeRecorderKey{}, recorder), recorder
+}
+
+// Changes 返回已收集的变更
+func (r *ChangeRecorder) Changes() []model.SysLogChanges {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.changes
+}
+
+// RecordChange 比较实体修改前后的快照，将变化的字段记录到当前请求的变更收集器，
+// context 中没有收集器时（如定时任务）忽略
+func RecordChange(ctx context.Context, entity string, entityID int64, before, after interface{}) {
+	recorder, ok := ctx.Value(changeRecorderKey{}).(*ChangeRecorder)
+	if !ok {
+		return
+	}
+	oldFields, err := snapshotFields(before)
+	if err != nil {
+		return
+	}
+	newFields, err := snapshotFields(after)
+	if err != nil {
+		return
+	}
+
+	names := make([]string, 0, len(newFields))
+	for name := range newFields {
+		names = append(names, name)
+	}
+	for name := range oldFields {
+		if _, ok := newFields[name]; !ok {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	now := time.Now()
+	recorder.mu.Lock()
+	defer recorder.mu.Unlock()
+	for _, name := range names {
+		if _, ok := changeIgnoredFields[name]; ok {
+			continue
+		}
+		oldValue, newValue := oldFields[name], newFields[name]
+		if bytes.Equal(oldValue, newValue) {
+			continue
+		}
+		change := model.SysLogChanges{
+			Entity:    entity,
+			EntityID:  entityID,
+			Field:     name,
+			OldValue:  changeValue(oldValue),
+			NewValue:  changeValue(newValue),
+			CreatedAt: now,
+		}
+		if _, ok := changeMaskedFields[name]; ok {
+			change.OldValue, change.NewValue = changeMaskedValue, changeMaskedValue
+		}
+		recorder.changes = append(recorder.changes, change)
+	}
+}
+
+// snapshotFields 按 json 名称展开实体字段，值为压缩后的 json
+func snapshotFields(entity interface{}) (map[string]json.RawMessage, error) {
+	fields := make(map[string]json.RawMessage)
+	if entity == nil {
+		return fields, nil
+	}
+	content, err := json.Marshal(entity)
+	if err != nil {
+		return nil, err
+	}
+	if err = json.Unmarshal(content, &fields); err != nil {
+		return nil, err
+	}
+	for name, value := range fields {
+		var buf bytes.Buffer
+		if err = json.Compact(&buf, value); err == nil {
+			fields[name] = buf.Bytes()
+		}
+	}
+	return fields, nil
+}
+
+// changeValue 字符串记录原值，其他类型记录 json
+func changeValue(value json.RawMessage) string {
+	if len(value) == 0 || string(value) == "null" {
+		return ""
+	}
+	if value[0] == '"' {
+		var s string
+		if err := json.Unmarshal(value, &s); err == nil {
+			return s
+		}
+	}
+	return string(value)
+}
+
+// SaveChanges 同步写入请求收集的字段变更，不依赖操作记录是否写入，
+// 写入失败只记录日志，返回是否写入成功
+func (uc *SysLogsUseCase) SaveChanges(ctx context.Context, changes []model.SysLogChanges) bool {
+	if err := uc.opRepo.CreateChanges(ctx, changes); err != nil {
+		log.NewHelper(uc.log).WithContext(ctx).Errorf("save entity changes failed: %v", err)
+		return false
+	}
+	return true
+}
+
+// ListChanges 实体的字段变更历史，按时间倒序
+func (uc *SysLogsUseCase) ListChanges(ctx context.Context, entity string, entityID int64, page, size int32) ([]*SysLogChangeWithUser, int64, error) {
+	return uc.opRepo.ListChanges(ctx, entity, entityID, page, size)
+}
@@ -0,0 +1,110 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/common"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// 登录结果
+const (
+	LoginStatusSuccess int32 = 1 // 成功
+	LoginStatusFailed  int32 = 2 // 失败
+)
+
+// MfaMethodTotp 谷歌验证码
+const MfaMethodTotp = "totp"
+
+// SysLoginLogCondition 登录日志查询条件
+type SysLoginLogCondition struct {
+	Username  string
+	IP        string
+	Status    int32
+	StartTime time.Time
+	EndTime   time.Time
+}
+
+// SysLoginLogRepo 接口定义
+type SysLoginLogRepo interface {
+	Create(ctx context.Context, record *model.SysLoginLogs) error
+	ListPage(ctx context.Context, cond *SysLoginLogCondition, page, size int32) ([]*model.SysLoginLogs, int64, error)
+	// Clean 删除时间范围内的登录日志，返回删除条数
+	Clean(ctx context.Context, startTime, endTime time.Time) (int64, error)
+	// LastLogins 用户最近一次成功登录的记录
+	LastLogins(ctx context.Context, userIDs []int64) (map[int64]*model.SysLoginLogs, error)
+}
+
+type SysLoginLogUseCase struct {
+	repo SysLoginLogRepo
+	log  *log.Helper
+}
+
+func NewSysLoginLogUseCase(repo SysLoginLogRepo, logger log.Logger) *SysLoginLogUseCase {
+	return &SysLoginLogUseCase{
+		repo: repo,
+		log:  log.NewHelper(log.With(logger, "module", "biz/loginLog")),
+	}
+}
+
+// Record 记录一次登录，loginErr 为空表示登录成功，写入失败只记录日志不影响登录
+func (l *SysLoginLogUseCase) Record(ctx context.Context, username string, userID int64, mfaMethod string, loginErr error) {
+	ip, agent := common.RequestInfo(ctx)
+	browser, os := common.ParseUserAgent(agent)
+	record := &model.SysLoginLogs{
+		UserID:    userID,
+		Username:  truncate(username, 191),
+		Status:    LoginStatusSuccess,
+		IP:        truncate(ip, 64),
+		Agent:     truncate(agent, 512),
+		Browser:   browser,
+		Os:        os,
+		MfaMethod: mfaMethod,
+		CreatedAt: time.Now(),
+	}
+	if loginErr != nil {
+		se := errors.FromError(loginErr)
+		record.Status = LoginStatusFailed
+		record.Reason = se.Reason
+		record.Message = truncate(se.Message, 255)
+	}
+	if err := l.repo.Create(ctx, record); err != nil {
+		l.log.Errorf("记录登录日志失败, username: %s, err: %v", username, err)
+	}
+}
+
+func (l *SysLoginLogUseCase) ListPage(ctx context.Context, cond *SysLoginLogCondition, page, size int32) ([]*model.SysLoginLogs, int64, error) {
+	return l.repo.ListPage(ctx, cond, page, size)
+}
+
+// Clean 清理时间范围内的登录日志
+func (l *SysLoginLogUseCase) Clean(ctx context.Context, startTime, endTime time.Time) (int64, error) {
+	if !endTime.After(startTime) {
+		return 0, i18n.WithID(errors.BadRequest("LOGIN_LOG_TIME_INVALID", "结束时间必须晚于开始时间"), "time.rangeInvalid")
+	}
+	return l.repo.Clean(ctx, startTime, endTime)
+}
+
+// LastLogins 用户最近一次成功登录的记录，没有登录过的用户不在结果中
+func (l *SysLoginLogUseCase) LastLogins(ctx context.Context, userIDs []int64) (map[int64]*model.SysLoginLogs, error) {
+	if len(userIDs) == 0 {
+		return map[int64]*model.SysLoginLogs{}, nil
+	}
+	return l.repo.LastLogins(ctx, userIDs)
+}
+
+// truncate 按字节截断，避免超出字段长度，不截断多字节字符
+func truncate(s string, max int) string {
+	if len(s) <= max {
+		return s
+	}
+	for max > 0 && s[max]&0xC0 == 0x80 {
+		max--
+	}
+	return s[:max]
+}
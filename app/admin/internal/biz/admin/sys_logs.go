@@ -2,63 +2,185 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
+	stderrors "errors"
+	"net/http"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
 )
 
+// SysLogsCondition 操作记录查询条件，零值表示不过滤
+type SysLogsCondition struct {
+	Username  string
+	Nickname  string
+	Operation string // 按操作前缀匹配
+	Path      string // 按路径前缀匹配
+	Method    string
+	IP        string
+	Status    int64
+	Reason    string
+	TraceID   string
+	Failed    bool // 只查询 HTTP 状态码 >= 400 的记录
+	StartTime time.Time
+	EndTime   time.Time
+	SortField string // 排序字段（列名），默认 id
+	SortAsc   bool
+	MaxID     int64 // 只查询 id 不超过该值的记录，导出时固定数据范围
+}
+
+// SysLogsWithUser 操作记录及操作用户
+type SysLogsWithUser struct {
+	model.SysLogs
+	Username string
+	NickName string
+}
+
 // SysLogsRepo 接口定义
 type SysLogsRepo interface {
 	Create(ctx context.Context, g *model.SysLogs) error
+	CreateInBatches(ctx context.Context, logs []*model.SysLogs, batchSize int) error
 	FindByID(ctx context.Context, id int64) (*model.SysLogs, error)
 	FindByPage(ctx context.Context, offset, limit int) ([]*model.SysLogs, int64, error)
+	ListPage(ctx context.Context, cond *SysLogsCondition, page, size int32) ([]*SysLogsWithUser, int64, error)
+	// ListAfter 按 id 升序读取 id 大于 afterID 的记录，不统计总数
+	ListAfter(ctx context.Context, cond *SysLogsCondition, afterID int64, size int) ([]*SysLogsWithUser, error)
+	// MaxID 当前最大的记录 id，包含已软删除的记录
+	MaxID(ctx context.Context) (int64, error)
 	Delete(ctx context.Context, id int64) error
 	DeleteByIds(ctx context.Context, ids []int64) error
 	DeleteByTimeRange(ctx context.Context, startTime, endTime string) error
 	FindByTimeRange(ctx context.Context, startTime, endTime string, offset, limit int) ([]*model.SysLogs, int64, error)
+
+	// CreateChained 锁定审计链头，为记录分配序号并计算哈希后写入
+	CreateChained(ctx context.Context, logs []*model.SysLogs, hash func(*model.SysLogs) string) error
+	ChainHead(ctx context.Context) (*model.SysLogChains, error)
+	// ScanChained 按序号顺序读取 fromSeq 开始的审计记录，包含已软删除的记录
+	ScanChained(ctx context.Context, fromSeq int64, limit int) ([]*model.SysLogs, error)
+	FindBySeq(ctx context.Context, seq int64) (*model.SysLogs, error)
+	MaxSeqBefore(ctx context.Context, endTime time.Time) (int64, error)
+	ListCheckpoints(ctx context.Context) ([]*model.SysLogCheckpoints, error)
+	LastCheckpoint(ctx context.Context) (*model.SysLogCheckpoints, error)
+	// Archive 保存检查点并删除已封存的记录
+	Archive(ctx context.Context, cp *model.SysLogCheckpoints) error
+	// CreateChanges 写入实体的字段变更，写入后 changes 中的 ID 为新记录的 id
+	CreateChanges(ctx context.Context, changes []model.SysLogChanges) error
+	// ListChanges 分页查询实体的字段变更，关联 sys_users 获取用户名和昵称
+	ListChanges(ctx context.Context, entity string, entityID int64, page, size int32) ([]*SysLogChangeWithUser, int64, error)
 }
 
 // SysLogsUseCase is a SysOperationRecords use case.
 type SysLogsUseCase struct {
-	opRepo SysLogsRepo
-	log    log.Logger
+	opRepo  SysLogsRepo
+	writer  *SysLogsWriter
+	audit   bool
+	signKey []byte
+	log     log.Logger
 }
 
 // NewSysLogsUseCase new a SysOperationRecords use case.
-func NewSysLogsUseCase(opRepo SysLogsRepo, logger log.Logger) *SysLogsUseCase {
-	return &SysLogsUseCase{
-		opRepo: opRepo,
-		log:    logger,
+// 审计模式下检查点必须使用独立的签名密钥，不能与 JWT 密钥相同，否则拒绝启动
+func NewSysLogsUseCase(opRepo SysLogsRepo, writer *SysLogsWriter, lc *conf.LogConfig, s *conf.Auth, logger log.Logger) (*SysLogsUseCase, error) {
+	audit := lc.GetAudit().GetEnabled()
+	signKey := lc.GetAudit().GetSignKey()
+	if audit && signKey == "" {
+		return nil, stderrors.New("log.audit.signKey is required when audit mode is enabled")
+	}
+	if audit && signKey == s.GetJwtKey() {
+		return nil, stderrors.New("log.audit.signKey must differ from auth.jwtKey")
 	}
+	return &SysLogsUseCase{
+		opRepo:  opRepo,
+		writer:  writer,
+		audit:   audit,
+		signKey: []byte(signKey),
+		log:     logger,
+	}, nil
 }
 
 // CreateOperationRecord creates a SysOperationRecords, and returns the new SysOperationRecords.
 func (uc *SysLogsUseCase) CreateOperationRecord(ctx context.Context, g *model.SysLogs) error {
+	if uc.audit {
+		return uc.opRepo.CreateChained(ctx, []*model.SysLogs{g}, logHasher(uc.signKey))
+	}
 	return uc.opRepo.Create(ctx, g)
 }
 
+// RecordAsync 将操作记录放入异步写入队列，队列已满时丢弃
+func (uc *SysLogsUseCase) RecordAsync(g *model.SysLogs) {
+	uc.writer.Write(g)
+}
+
+// WriterStats 操作记录异步写入的统计
+func (uc *SysLogsUseCase) WriterStats() SysLogsWriterStats {
+	return uc.writer.Stats()
+}
+
+// RecordEvent 同步写入不经过接口请求的审计事件，如定时任务使临时授权过期，
+// detail 序列化为 JSON 保存在请求体中，写入失败只记录日志
+func (uc *SysLogsUseCase) RecordEvent(ctx context.Context, operation string, userID int64, detail interface{}) {
+	body, err := json.Marshal(detail)
+	if err != nil {
+		log.NewHelper(uc.log).WithContext(ctx).Errorf("marshal audit event %s failed: %v", operation, err)
+		return
+	}
+	now := time.Now()
+	g := &model.SysLogs{
+		CreatedAt: now,
+		UpdatedAt: now,
+		Status:    http.StatusOK,
+		Body:      string(body),
+		UserID:    userID,
+		Operation: operation,
+	}
+	if err = uc.CreateOperationRecord(ctx, g); err != nil {
+		log.NewHelper(uc.log).WithContext(ctx).Errorf("record audit event %s failed: %v", operation, err)
+	}
+}
+
 // FindOperationRecordById finds a SysOperationRecords by id.
 func (uc *SysLogsUseCase) FindOperationRecordById(ctx context.Context, id int64) (*model.SysLogs, error) {
 	return uc.opRepo.FindByID(ctx, id)
 }
 
 // ListPage lists SysOperationRecords by page.
-func (uc *SysLogsUseCase) ListPage(ctx context.Context, pageNum, pageSize int32) ([]*model.SysLogs, int64, error) {
-	return uc.opRepo.FindByPage(ctx, int((pageNum-1)*pageSize), int(pageSize))
+func (uc *SysLogsUseCase) ListPage(ctx context.Context, cond *SysLogsCondition, pageNum, pageSize int32) ([]*SysLogsWithUser, int64, error) {
+	return uc.opRepo.ListPage(ctx, cond, pageNum, pageSize)
+}
+
+// ListAfter 按 id 游标读取操作记录，用于导出
+func (uc *SysLogsUseCase) ListAfter(ctx context.Context, cond *SysLogsCondition, afterID int64, size int) ([]*SysLogsWithUser, error) {
+	return uc.opRepo.ListAfter(ctx, cond, afterID, size)
+}
+
+// MaxID 当前最大的操作记录 id
+func (uc *SysLogsUseCase) MaxID(ctx context.Context) (int64, error) {
+	return uc.opRepo.MaxID(ctx)
 }
 
 // DeleteOperationRecord deletes a SysOperationRecords by id.
 func (uc *SysLogsUseCase) DeleteOperationRecord(ctx context.Context, id int64) error {
+	if err := uc.checkAppendOnly(); err != nil {
+		return err
+	}
 	return uc.opRepo.Delete(ctx, id)
 }
 
 // DeleteByIds deletes operation records by ids.
 func (uc *SysLogsUseCase) DeleteByIds(ctx context.Context, ids []int64) error {
+	if err := uc.checkAppendOnly(); err != nil {
+		return err
+	}
 	return uc.opRepo.DeleteByIds(ctx, ids)
 }
 
 // DeleteByTimeRange deletes operation records within the specified time range
 func (uc *SysLogsUseCase) DeleteByTimeRange(ctx context.Context, startTime, endTime string) error {
+	if err := uc.checkAppendOnly(); err != nil {
+		return err
+	}
 	return uc.opRepo.DeleteByTimeRange(ctx, startTime, endTime)
 }
 
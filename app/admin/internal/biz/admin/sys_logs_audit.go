@@ -0,0 +1,229 @@
+package admin
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// 审计链校验发现的问题类型
+const (
+	LogChainProblemGap       = "gap"       // 序号不连续，记录被删除
+	LogChainProblemLink      = "link"      // 与上一条记录的哈希不一致
+	LogChainProblemModified  = "modified"  // 记录内容被修改
+	LogChainProblemDeleted   = "deleted"   // 记录被软删除
+	LogChainProblemSignature = "signature" // 检查点签名不正确
+	LogChainProblemTail      = "tail"      // 链尾记录被删除
+)
+
+// logChainVerifyBatch 校验时每批读取的记录数
+const logChainVerifyBatch = 1000
+
+// logChainMaxProblems 校验结果最多返回的问题数
+const logChainMaxProblems = 100
+
+// LogChainProblem 审计链校验发现的问题
+type LogChainProblem struct {
+	Seq     int64
+	ID      int64
+	Kind    string
+	Message string
+}
+
+// LogChainVerifyResult 审计链校验结果
+type LogChainVerifyResult struct {
+	Valid       bool
+	Checked     int64
+	LastSeq     int64
+	Checkpoints int
+	Problems    []*LogChainProblem
+}
+
+func (r *LogChainVerifyResult) addProblem(p *LogChainProblem) {
+	r.Valid = false
+	if len(r.Problems) < logChainMaxProblems {
+		r.Problems = append(r.Problems, p)
+	}
+}
+
+// HashSysLog 使用签名密钥计算记录内容与上一条哈希的 HMAC-SHA256，不包含 id、updated_at、
+// deleted_at 和 hash 本身；没有密钥时无法在篡改记录后重新计算出正确的哈希链
+func HashSysLog(key []byte, record *model.SysLogs) string {
+	content, _ := json.Marshal([]interface{}{
+		record.Seq,
+		record.PrevHash,
+		record.CreatedAt.UnixMilli(),
+		record.UserID,
+		record.ImpersonatorID,
+		record.IP,
+		record.Method,
+		record.Path,
+		record.Operation,
+		record.Status,
+		record.Latency,
+		record.Agent,
+		record.ErrorCode,
+		record.Reason,
+		record.ErrorMessage,
+		record.TraceID,
+		record.Body,
+		record.Resp,
+	})
+	mac := hmac.New(sha256.New, key)
+	mac.Write(content)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// logHasher 返回使用 key 计算记录哈希的函数，供写入哈希链时使用
+func logHasher(key []byte) func(*model.SysLogs) string {
+	return func(record *model.SysLogs) string {
+		return HashSysLog(key, record)
+	}
+}
+
+// signCheckpoint 使用签名密钥计算检查点的 HMAC-SHA256
+func (uc *SysLogsUseCase) signCheckpoint(cp *model.SysLogCheckpoints) string {
+	mac := hmac.New(sha256.New, uc.signKey)
+	_, _ = fmt.Fprintf(mac, "%d|%d|%s|%s|%d|%d", cp.StartSeq, cp.EndSeq, cp.PrevHash, cp.EndHash, cp.Count, cp.EndTime.Unix())
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// checkAppendOnly 审计模式下只能通过归档删除记录
+func (uc *SysLogsUseCase) checkAppendOnly() error {
+	if uc.audit {
+		return i18n.WithID(errors.Forbidden("OPERATION_RECORD_APPEND_ONLY", "审计模式下不能直接删除操作记录，请使用归档"), "logs.appendOnly")
+	}
+	return nil
+}
+
+// VerifyChain 校验检查点签名及剩余记录的哈希链，发现删除、修改和断链
+func (uc *SysLogsUseCase) VerifyChain(ctx context.Context) (*LogChainVerifyResult, error) {
+	result := &LogChainVerifyResult{Valid: true}
+	checkpoints, err := uc.opRepo.ListCheckpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	result.Checkpoints = len(checkpoints)
+
+	expectSeq, prevHash := int64(1), ""
+	for _, cp := range checkpoints {
+		if !hmac.Equal([]byte(uc.signCheckpoint(cp)), []byte(cp.Signature)) {
+			result.addProblem(&LogChainProblem{Seq: cp.EndSeq, Kind: LogChainProblemSignature,
+				Message: fmt.Sprintf("检查点 %d-%d 签名不正确", cp.StartSeq, cp.EndSeq)})
+		}
+		if cp.StartSeq != expectSeq || cp.PrevHash != prevHash {
+			result.addProblem(&LogChainProblem{Seq: cp.StartSeq, Kind: LogChainProblemGap,
+				Message: fmt.Sprintf("检查点 %d-%d 与上一段不连续，期望从 %d 开始", cp.StartSeq, cp.EndSeq, expectSeq)})
+		}
+		expectSeq, prevHash = cp.EndSeq+1, cp.EndHash
+	}
+
+	for {
+		list, err := uc.opRepo.ScanChained(ctx, expectSeq, logChainVerifyBatch)
+		if err != nil {
+			return nil, err
+		}
+		for _, record := range list {
+			if record.Seq != expectSeq {
+				result.addProblem(&LogChainProblem{Seq: expectSeq, ID: record.ID, Kind: LogChainProblemGap,
+					Message: fmt.Sprintf("缺少序号 %d-%d 的记录", expectSeq, record.Seq-1)})
+			}
+			if record.PrevHash != prevHash {
+				result.addProblem(&LogChainProblem{Seq: record.Seq, ID: record.ID, Kind: LogChainProblemLink,
+					Message: "与上一条记录的哈希不一致"})
+			}
+			if HashSysLog(uc.signKey, record) != record.Hash {
+				result.addProblem(&LogChainProblem{Seq: record.Seq, ID: record.ID, Kind: LogChainProblemModified,
+					Message: "记录内容与哈希不一致"})
+			}
+			if record.DeletedAt.Valid {
+				result.addProblem(&LogChainProblem{Seq: record.Seq, ID: record.ID, Kind: LogChainProblemDeleted,
+					Message: "记录已被删除"})
+			}
+			expectSeq, prevHash = record.Seq+1, record.Hash
+			result.Checked++
+		}
+		if len(list) < logChainVerifyBatch {
+			break
+		}
+	}
+
+	head, err := uc.opRepo.ChainHead(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if head.Seq != expectSeq-1 || head.Hash != prevHash {
+		result.addProblem(&LogChainProblem{Seq: head.Seq, Kind: LogChainProblemTail,
+			Message: fmt.Sprintf("链尾应为序号 %d，实际校验到 %d", head.Seq, expectSeq-1)})
+	}
+	result.LastSeq = expectSeq - 1
+	return result, nil
+}
+
+// Archive 归档 endTime 之前的记录：校验通过后生成签名检查点并删除已封存的记录
+func (uc *SysLogsUseCase) Archive(ctx context.Context, endTime time.Time) (*model.SysLogCheckpoints, error) {
+	if !uc.audit {
+		return nil, i18n.WithID(errors.BadRequest("OPERATION_RECORD_AUDIT_DISABLED", "未开启审计模式"), "logs.auditDisabled")
+	}
+	result, err := uc.VerifyChain(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !result.Valid {
+		return nil, i18n.WithID(errors.Conflict("OPERATION_RECORD_CHAIN_BROKEN", "审计链校验未通过，不能归档"), "logs.chainBroken")
+	}
+
+	startSeq, prevHash := int64(1), ""
+	last, err := uc.opRepo.LastCheckpoint(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if last != nil {
+		startSeq, prevHash = last.EndSeq+1, last.EndHash
+	}
+	endSeq, err := uc.opRepo.MaxSeqBefore(ctx, endTime)
+	if err != nil {
+		return nil, err
+	}
+	if endSeq < startSeq {
+		return nil, i18n.WithID(errors.BadRequest("OPERATION_RECORD_ARCHIVE_EMPTY", "没有可归档的记录"), "logs.archiveEmpty")
+	}
+	end, err := uc.opRepo.FindBySeq(ctx, endSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	claims := authz.MustFromContext(ctx)
+	cp := &model.SysLogCheckpoints{
+		StartSeq:  startSeq,
+		EndSeq:    endSeq,
+		PrevHash:  prevHash,
+		EndHash:   end.Hash,
+		Count:     endSeq - startSeq + 1,
+		EndTime:   endTime,
+		CreateBy:  claims.Nickname,
+		CreatedAt: time.Now(),
+	}
+	cp.Signature = uc.signCheckpoint(cp)
+	if err = uc.opRepo.Archive(ctx, cp); err != nil {
+		return nil, err
+	}
+	log.NewHelper(uc.log).Infof("操作记录已归档, seq: %d-%d, count: %d, by: %s", cp.StartSeq, cp.EndSeq, cp.Count, cp.CreateBy)
+	return cp, nil
+}
+
+// ListCheckpoints 归档检查点列表
+func (uc *SysLogsUseCase) ListCheckpoints(ctx context.Context) ([]*model.SysLogCheckpoints, error) {
+	return uc.opRepo.ListCheckpoints(ctx)
+}
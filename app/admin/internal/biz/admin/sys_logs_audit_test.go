@@ -0,0 +1,352 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
+	"gorm.io/gorm"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+)
+
+// fakeChainRepo 内存中的审计链，records 按写入顺序保存，测试可直接篡改
+type fakeChainRepo struct {
+	SysLogsRepo
+	records     []*model.SysLogs
+	head        model.SysLogChains
+	checkpoints []*model.SysLogCheckpoints
+}
+
+func (r *fakeChainRepo) CreateChained(ctx context.Context, logs []*model.SysLogs, hash func(*model.SysLogs) string) error {
+	for _, record := range logs {
+		r.head.Seq++
+		record.ID = r.head.Seq
+		record.Seq = r.head.Seq
+		record.PrevHash = r.head.Hash
+		record.Hash = hash(record)
+		r.head.Hash = record.Hash
+		r.records = append(r.records, record)
+	}
+	return nil
+}
+
+func (r *fakeChainRepo) ChainHead(ctx context.Context) (*model.SysLogChains, error) {
+	head := r.head
+	return &head, nil
+}
+
+func (r *fakeChainRepo) ScanChained(ctx context.Context, fromSeq int64, limit int) ([]*model.SysLogs, error) {
+	var list []*model.SysLogs
+	for _, record := range r.records {
+		if record.Seq >= fromSeq {
+			list = append(list, record)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Seq < list[j].Seq })
+	if len(list) > limit {
+		list = list[:limit]
+	}
+	return list, nil
+}
+
+func (r *fakeChainRepo) FindBySeq(ctx context.Context, seq int64) (*model.SysLogs, error) {
+	for _, record := range r.records {
+		if record.Seq == seq {
+			return record, nil
+		}
+	}
+	return nil, gorm.ErrRecordNotFound
+}
+
+func (r *fakeChainRepo) MaxSeqBefore(ctx context.Context, endTime time.Time) (int64, error) {
+	var seq int64
+	for _, record := range r.records {
+		if !record.CreatedAt.After(endTime) && record.Seq > seq {
+			seq = record.Seq
+		}
+	}
+	return seq, nil
+}
+
+func (r *fakeChainRepo) ListCheckpoints(ctx context.Context) ([]*model.SysLogCheckpoints, error) {
+	return r.checkpoints, nil
+}
+
+func (r *fakeChainRepo) LastCheckpoint(ctx context.Context) (*model.SysLogCheckpoints, error) {
+	if len(r.checkpoints) == 0 {
+		return nil, nil
+	}
+	return r.checkpoints[len(r.checkpoints)-1], nil
+}
+
+func (r *fakeChainRepo) Archive(ctx context.Context, cp *model.SysLogCheckpoints) error {
+	r.checkpoints = append(r.checkpoints, cp)
+	r.remove(func(record *model.SysLogs) bool { return record.Seq >= cp.StartSeq && record.Seq <= cp.EndSeq })
+	return nil
+}
+
+func (r *fakeChainRepo) remove(match func(*model.SysLogs) bool) {
+	list := r.records[:0]
+	for _, record := range r.records {
+		if !match(record) {
+			list = append(list, record)
+		}
+	}
+	r.records = list
+}
+
+func (r *fakeChainRepo) bySeq(seq int64) *model.SysLogs {
+	record, _ := r.FindBySeq(context.Background(), seq)
+	return record
+}
+
+var testChainStart = time.Date(2026, 10, 19, 10, 0, 0, 0, time.Local)
+
+// newTestChain 写入 n 条审计记录，第 i 条的创建时间为 testChainStart 后 i 分钟
+func newTestChain(t *testing.T, n int) (*SysLogsUseCase, *fakeChainRepo) {
+	t.Helper()
+	repo := &fakeChainRepo{}
+	uc, err := NewSysLogsUseCase(repo, nil, &conf.LogConfig{Audit: &conf.LogConfig_Audit{Enabled: true, SignKey: "audit-key"}},
+		&conf.Auth{JwtKey: "jwt-key"}, log.DefaultLogger)
+	if err != nil {
+		t.Fatalf("new use case: %v", err)
+	}
+	for i := 1; i <= n; i++ {
+		record := &model.SysLogs{
+			CreatedAt: testChainStart.Add(time.Duration(i) * time.Minute),
+			UserID:    1,
+			Operation: "/api.admin.v1.SysUser/UpdateSysUser",
+			Body:      `{"id":1}`,
+			Status:    200,
+		}
+		if err = uc.CreateOperationRecord(context.Background(), record); err != nil {
+			t.Fatalf("create record %d: %v", i, err)
+		}
+	}
+	return uc, repo
+}
+
+func archiveContext() context.Context {
+	return jwt.NewContext(context.Background(), &authz.TokenClaims{UserID: 1, RoleKey: SuperAdminRoleKey, Nickname: "admin"})
+}
+
+func problemKinds(result *LogChainVerifyResult) map[string]bool {
+	kinds := make(map[string]bool)
+	for _, p := range result.Problems {
+		kinds[p.Kind] = true
+	}
+	return kinds
+}
+
+func Test_NewSysLogsUseCase_SignKey(t *testing.T) {
+	tests := []struct {
+		name    string
+		audit   *conf.LogConfig_Audit
+		wantErr bool
+	}{
+		{name: "audit disabled", audit: &conf.LogConfig_Audit{}},
+		{name: "dedicated key", audit: &conf.LogConfig_Audit{Enabled: true, SignKey: "audit-key"}},
+		{name: "missing key", audit: &conf.LogConfig_Audit{Enabled: true}, wantErr: true},
+		{name: "jwt key reused", audit: &conf.LogConfig_Audit{Enabled: true, SignKey: "jwt-key"}, wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSysLogsUseCase(&fakeChainRepo{}, nil, &conf.LogConfig{Audit: tt.audit}, &conf.Auth{JwtKey: "jwt-key"}, log.DefaultLogger)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("err = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func Test_SysLogsUseCase_VerifyChain(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(repo *fakeChainRepo)
+		want   []string
+	}{
+		{
+			name:   "intact",
+			tamper: func(repo *fakeChainRepo) {},
+		},
+		{
+			name:   "edited row",
+			tamper: func(repo *fakeChainRepo) { repo.bySeq(3).Body = `{"id":2}` },
+			want:   []string{LogChainProblemModified},
+		},
+		{
+			name: "edited row with recomputed hash",
+			tamper: func(repo *fakeChainRepo) {
+				record := repo.bySeq(3)
+				record.Status = 500
+				record.Hash = HashSysLog([]byte("audit-key"), record)
+			},
+			want: []string{LogChainProblemLink},
+		},
+		{
+			name: "rows and head rehashed without the key",
+			tamper: func(repo *fakeChainRepo) {
+				repo.bySeq(3).Status = 500
+				prevHash := repo.bySeq(2).Hash
+				for seq := int64(3); seq <= 5; seq++ {
+					record := repo.bySeq(seq)
+					record.PrevHash = prevHash
+					record.Hash = HashSysLog([]byte("guessed-key"), record)
+					prevHash = record.Hash
+				}
+				repo.head.Hash = prevHash
+			},
+			want: []string{LogChainProblemModified},
+		},
+		{
+			name:   "deleted row",
+			tamper: func(repo *fakeChainRepo) { repo.remove(func(r *model.SysLogs) bool { return r.Seq == 3 }) },
+			want:   []string{LogChainProblemGap, LogChainProblemLink},
+		},
+		{
+			name:   "deleted tail",
+			tamper: func(repo *fakeChainRepo) { repo.remove(func(r *model.SysLogs) bool { return r.Seq == 5 }) },
+			want:   []string{LogChainProblemTail},
+		},
+		{
+			name:   "soft deleted row",
+			tamper: func(repo *fakeChainRepo) { repo.bySeq(2).DeletedAt = gorm.DeletedAt{Time: time.Now(), Valid: true} },
+			want:   []string{LogChainProblemDeleted},
+		},
+		{
+			name: "reordered seq",
+			tamper: func(repo *fakeChainRepo) {
+				a, b := repo.bySeq(2), repo.bySeq(3)
+				a.Seq, b.Seq = b.Seq, a.Seq
+			},
+			want: []string{LogChainProblemLink, LogChainProblemModified},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := newTestChain(t, 5)
+			tt.tamper(repo)
+			result, err := uc.VerifyChain(context.Background())
+			if err != nil {
+				t.Fatalf("verify: %v", err)
+			}
+			if result.Valid != (len(tt.want) == 0) {
+				t.Fatalf("valid = %v, problems = %+v", result.Valid, result.Problems)
+			}
+			kinds := problemKinds(result)
+			for _, kind := range tt.want {
+				if !kinds[kind] {
+					t.Errorf("missing %s problem, got %+v", kind, result.Problems)
+				}
+			}
+		})
+	}
+}
+
+func Test_SysLogsUseCase_Archive(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(uc *SysLogsUseCase, repo *fakeChainRepo)
+		want   []string
+	}{
+		{
+			name:   "intact",
+			tamper: func(uc *SysLogsUseCase, repo *fakeChainRepo) {},
+		},
+		{
+			name: "checkpoint edited",
+			tamper: func(uc *SysLogsUseCase, repo *fakeChainRepo) {
+				repo.checkpoints[0].Count = 2
+			},
+			want: []string{LogChainProblemSignature},
+		},
+		{
+			name: "checkpoint signed with another key",
+			tamper: func(uc *SysLogsUseCase, repo *fakeChainRepo) {
+				uc.signKey = []byte("other-key")
+			},
+			want: []string{LogChainProblemSignature},
+		},
+		{
+			name: "first row after checkpoint deleted",
+			tamper: func(uc *SysLogsUseCase, repo *fakeChainRepo) {
+				repo.remove(func(r *model.SysLogs) bool { return r.Seq == 4 })
+			},
+			want: []string{LogChainProblemGap, LogChainProblemLink},
+		},
+		{
+			name: "row after checkpoint edited",
+			tamper: func(uc *SysLogsUseCase, repo *fakeChainRepo) {
+				repo.bySeq(4).UserID = 2
+			},
+			want: []string{LogChainProblemModified},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uc, repo := newTestChain(t, 5)
+			cp, err := uc.Archive(archiveContext(), testChainStart.Add(3*time.Minute))
+			if err != nil {
+				t.Fatalf("archive: %v", err)
+			}
+			if cp.StartSeq != 1 || cp.EndSeq != 3 || cp.Count != 3 || len(repo.records) != 2 {
+				t.Fatalf("checkpoint = %+v, %d records left", cp, len(repo.records))
+			}
+			tt.tamper(uc, repo)
+			result, err := uc.VerifyChain(context.Background())
+			if err != nil {
+				t.Fatalf("verify: %v", err)
+			}
+			if result.Valid != (len(tt.want) == 0) {
+				t.Fatalf("valid = %v, problems = %+v", result.Valid, result.Problems)
+			}
+			kinds := problemKinds(result)
+			for _, kind := range tt.want {
+				if !kinds[kind] {
+					t.Errorf("missing %s problem, got %+v", kind, result.Problems)
+				}
+			}
+		})
+	}
+}
+
+func Test_SysLogsUseCase_ArchiveBrokenChain(t *testing.T) {
+	uc, repo := newTestChain(t, 5)
+	repo.bySeq(2).Body = `{"id":2}`
+	_, err := uc.Archive(archiveContext(), testChainStart.Add(3*time.Minute))
+	if errors.Reason(err) != "OPERATION_RECORD_CHAIN_BROKEN" {
+		t.Fatalf("err = %v, want chain broken", err)
+	}
+	if len(repo.checkpoints) != 0 || len(repo.records) != 5 {
+		t.Fatalf("archived a broken chain: %d checkpoints, %d records", len(repo.checkpoints), len(repo.records))
+	}
+}
+
+func Test_SysLogsUseCase_ArchiveTwice(t *testing.T) {
+	uc, repo := newTestChain(t, 5)
+	ctx := archiveContext()
+	if _, err := uc.Archive(ctx, testChainStart.Add(2*time.Minute)); err != nil {
+		t.Fatalf("first archive: %v", err)
+	}
+	cp, err := uc.Archive(ctx, testChainStart.Add(4*time.Minute))
+	if err != nil {
+		t.Fatalf("second archive: %v", err)
+	}
+	if cp.StartSeq != 3 || cp.EndSeq != 4 || cp.PrevHash != repo.checkpoints[0].EndHash {
+		t.Fatalf("second checkpoint = %+v", cp)
+	}
+	if _, err = uc.Archive(ctx, testChainStart.Add(4*time.Minute)); errors.Reason(err) != "OPERATION_RECORD_ARCHIVE_EMPTY" {
+		t.Fatalf("err = %v, want archive empty", err)
+	}
+	result, err := uc.VerifyChain(context.Background())
+	if err != nil || !result.Valid || result.Checkpoints != 2 || result.LastSeq != 5 {
+		t.Fatalf("verify = %+v, %v", result, err)
+	}
+}
@@ -0,0 +1,178 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// 操作记录实时推送的默认参数
+const (
+	// logsStreamMaxSubscribers 单个实例同时在线的订阅数上限
+	logsStreamMaxSubscribers = 100
+	// logsStreamBuffer 每个订阅的缓冲条数，消费过慢时丢弃新记录
+	logsStreamBuffer       = 256
+	logsStreamPublishWait  = 3 * time.Second
+	logsStreamFailedStatus = 400
+)
+
+var ErrLogsStreamBusy = i18n.WithID(errors.New(429, "LOGS_STREAM_BUSY", "实时日志订阅数已达上限, 请稍后重试"), "logs.streamBusy")
+
+// SysLogsStreamRepo 跨实例广播新写入的操作记录
+type SysLogsStreamRepo interface {
+	Publish(ctx context.Context, logs []*model.SysLogs) error
+	// Subscribe 接收所有实例发布的记录，ctx 取消后通道关闭
+	Subscribe(ctx context.Context) <-chan *model.SysLogs
+}
+
+// SysLogsStreamFilter 订阅过滤条件，零值表示不过滤
+type SysLogsStreamFilter struct {
+	UserID     int64
+	PathPrefix string
+	Failed     bool // 只推送失败的请求（HTTP 状态码 >= 400）
+}
+
+func (f *SysLogsStreamFilter) Match(r *model.SysLogs) bool {
+	if f.UserID > 0 && r.UserID != f.UserID {
+		return false
+	}
+	if f.PathPrefix != "" && !strings.HasPrefix(r.Path, f.PathPrefix) {
+		return false
+	}
+	if f.Failed && r.Status < logsStreamFailedStatus {
+		return false
+	}
+	return true
+}
+
+// SysLogsSubscription 单个客户端的订阅
+type SysLogsSubscription struct {
+	stream  *SysLogsStream
+	filter  SysLogsStreamFilter
+	ch      chan *model.SysLogs
+	dropped atomic.Int64
+}
+
+// C 推送记录的通道，服务停止时关闭
+func (s *SysLogsSubscription) C() <-chan *model.SysLogs {
+	return s.ch
+}
+
+// Dropped 因消费过慢被丢弃的条数
+func (s *SysLogsSubscription) Dropped() int64 {
+	return s.dropped.Load()
+}
+
+// Close 取消订阅
+func (s *SysLogsSubscription) Close() {
+	s.stream.unsubscribe(s)
+}
+
+// SysLogsStream 操作记录实时推送，写入成功后经 Redis 发布订阅广播到所有实例，
+// 每个实例只订阅一次再分发给本地的订阅者；实现 transport.Server 以便随应用启动
+type SysLogsStream struct {
+	repo   SysLogsStreamRepo
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu   sync.RWMutex
+	subs map[*SysLogsSubscription]struct{}
+	// stopped 停止后关闭所有订阅且不再接受新订阅
+	stopped bool
+
+	publishFailed atomic.Int64
+	log           *log.Helper
+}
+
+func NewSysLogsStream(repo SysLogsStreamRepo, logger log.Logger) *SysLogsStream {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &SysLogsStream{
+		repo:   repo,
+		ctx:    ctx,
+		cancel: cancel,
+		subs:   make(map[*SysLogsSubscription]struct{}),
+		log:    log.NewHelper(log.With(logger, "module", "biz/logsStream")),
+	}
+}
+
+// Publish 广播已写入的记录，失败只记日志，不影响写入
+func (s *SysLogsStream) Publish(logs []*model.SysLogs) {
+	if len(logs) == 0 || s.ctx.Err() != nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(s.ctx, logsStreamPublishWait)
+	defer cancel()
+	if err := s.repo.Publish(ctx, logs); err != nil {
+		if n := s.publishFailed.Add(1); n == 1 || n%100 == 0 {
+			s.log.Warnf("广播操作记录失败, 累计 %d 次: %v", n, err)
+		}
+	}
+}
+
+// Subscribe 新建订阅，调用方用完后需调用 Close
+func (s *SysLogsStream) Subscribe(filter SysLogsStreamFilter) (*SysLogsSubscription, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.stopped || len(s.subs) >= logsStreamMaxSubscribers {
+		return nil, ErrLogsStreamBusy
+	}
+	sub := &SysLogsSubscription{
+		stream: s,
+		filter: filter,
+		ch:     make(chan *model.SysLogs, logsStreamBuffer),
+	}
+	s.subs[sub] = struct{}{}
+	return sub, nil
+}
+
+func (s *SysLogsStream) unsubscribe(sub *SysLogsSubscription) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if _, ok := s.subs[sub]; ok {
+		delete(s.subs, sub)
+		close(sub.ch)
+	}
+}
+
+func (s *SysLogsStream) dispatch(r *model.SysLogs) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	for sub := range s.subs {
+		if !sub.filter.Match(r) {
+			continue
+		}
+		select {
+		case sub.ch <- r:
+		default:
+			sub.dropped.Add(1)
+		}
+	}
+}
+
+// Start 订阅广播频道直到停止
+func (s *SysLogsStream) Start(context.Context) error {
+	for r := range s.repo.Subscribe(s.ctx) {
+		s.dispatch(r)
+	}
+	return nil
+}
+
+// Stop 停止订阅并关闭所有客户端的推送通道
+func (s *SysLogsStream) Stop(context.Context) error {
+	s.cancel()
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stopped = true
+	for sub := range s.subs {
+		delete(s.subs, sub)
+		close(sub.ch)
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+package admin
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+// 操作记录异步写入的默认参数
+const (
+	logsWriterQueueSize     = 10000
+	logsWriterWorkers       = 2
+	logsWriterBatchSize     = 200
+	logsWriterFlushInterval = time.Second
+	// logsWriterEnqueueWait 队列已满时最多等待的时间，超时后丢弃记录
+	logsWriterEnqueueWait = 50 * time.Millisecond
+	logsWriterSaveTimeout = 10 * time.Second
+)
+
+// SysLogsWriterStats 异步写入统计
+type SysLogsWriterStats struct {
+	Queued          int64 // 当前队列长度
+	Capacity        int64 // 队列容量
+	Written         int64 // 已写入条数
+	Failed          int64 // 写入失败条数
+	Dropped         int64 // 队列已满被丢弃的条数
+	FlushFailures   int64 // 批量写入失败次数
+	PublishFailures int64 // 实时推送广播失败次数
+}
+
+// SysLogsWriter 操作记录异步批量写入，有界队列加固定数量的写入协程，
+// 实现 transport.Server 以便随应用启动，停止时写完队列中剩余的记录
+type SysLogsWriter struct {
+	repo   SysLogsRepo
+	stream *SysLogsStream
+	audit  bool
+	// hash 审计模式下计算记录哈希，使用审计签名密钥
+	hash  func(*model.SysLogs) string
+	queue chan *model.SysLogs
+	mu    sync.RWMutex
+	stop  chan struct{}
+	done  chan struct{}
+	wg    sync.WaitGroup
+
+	stopped bool
+	written atomic.Int64
+	failed  atomic.Int64
+	dropped atomic.Int64
+	// flushFailed 批量写入失败的次数，failed 为失败的条数
+	flushFailed atomic.Int64
+
+	log *log.Helper
+}
+
+func NewSysLogsWriter(repo SysLogsRepo, stream *SysLogsStream, lc *conf.LogConfig, logger log.Logger) *SysLogsWriter {
+	return &SysLogsWriter{
+		repo:   repo,
+		stream: stream,
+		audit:  lc.GetAudit().GetEnabled(),
+		hash:   logHasher([]byte(lc.GetAudit().GetSignKey())),
+		queue:  make(chan *model.SysLogs, logsWriterQueueSize),
+		stop:   make(chan struct{}),
+		done:   make(chan struct{}),
+		log:    log.NewHelper(log.With(logger, "module", "biz/logsWriter")),
+	}
+}
+
+// Write 记录入队，队列已满时短暂等待形成背压，仍无空位则丢弃并计数；
+// 停止后的记录直接同步写入
+func (w *SysLogsWriter) Write(record *model.SysLogs) {
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+	if w.stopped {
+		w.save([]*model.SysLogs{record})
+		return
+	}
+	select {
+	case w.queue <- record:
+		return
+	default:
+	}
+	timer := time.NewTimer(logsWriterEnqueueWait)
+	defer timer.Stop()
+	select {
+	case w.queue <- record:
+	case <-timer.C:
+		if n := w.dropped.Add(1); n == 1 || n%1000 == 0 {
+			w.log.Warnf("操作记录队列已满, 已丢弃 %d 条", n)
+		}
+	}
+}
+
+// Stats 返回写入统计
+func (w *SysLogsWriter) Stats() SysLogsWriterStats {
+	return SysLogsWriterStats{
+		Queued:          int64(len(w.queue)),
+		Capacity:        int64(cap(w.queue)),
+		Written:         w.written.Load(),
+		Failed:          w.failed.Load(),
+		Dropped:         w.dropped.Load(),
+		FlushFailures:   w.flushFailed.Load(),
+		PublishFailures: w.stream.publishFailed.Load(),
+	}
+}
+
+func (w *SysLogsWriter) Start(context.Context) error {
+	for i := 0; i < logsWriterWorkers; i++ {
+		w.wg.Add(1)
+		go w.work()
+	}
+	w.wg.Wait()
+	close(w.done)
+	return nil
+}
+
+// Stop 停止接收新记录并等待队列中的记录写完
+func (w *SysLogsWriter) Stop(ctx context.Context) error {
+	w.mu.Lock()
+	if w.stopped {
+		w.mu.Unlock()
+		return nil
+	}
+	w.stopped = true
+	close(w.stop)
+	w.mu.Unlock()
+
+	select {
+	case <-w.done:
+	case <-ctx.Done():
+		w.log.Errorf("等待操作记录写入超时, 剩余 %d 条未写入", len(w.queue))
+		return ctx.Err()
+	}
+	stats := w.Stats()
+	w.log.Infof("操作记录写入已停止, written: %d, failed: %d, dropped: %d, flush failures: %d", stats.Written, stats.Failed, stats.Dropped, stats.FlushFailures)
+	return nil
+}
+
+func (w *SysLogsWriter) work() {
+	defer w.wg.Done()
+	ticker := time.NewTicker(logsWriterFlushInterval)
+	defer ticker.Stop()
+	batch := make([]*model.SysLogs, 0, logsWriterBatchSize)
+	for {
+		select {
+		case record := <-w.queue:
+			batch = append(batch, record)
+			if len(batch) >= logsWriterBatchSize {
+				batch = w.flush(batch)
+			}
+		case <-ticker.C:
+			batch = w.flush(batch)
+		case <-w.stop:
+			// 停止后不再有新记录入队，写完剩余记录后退出
+			for {
+				select {
+				case record := <-w.queue:
+					batch = append(batch, record)
+					if len(batch) >= logsWriterBatchSize {
+						batch = w.flush(batch)
+					}
+				default:
+					w.flush(batch)
+					return
+				}
+			}
+		}
+	}
+}
+
+func (w *SysLogsWriter) flush(batch []*model.SysLogs) []*model.SysLogs {
+	if len(batch) == 0 {
+		return batch
+	}
+	w.save(batch)
+	return batch[:0]
+}
+
+func (w *SysLogsWriter) save(batch []*model.SysLogs) {
+	// 使用独立的 context，请求结束后仍需写入
+	ctx, cancel := context.WithTimeout(context.Background(), logsWriterSaveTimeout)
+	defer cancel()
+	var err error
+	if w.audit {
+		// 审计模式下按写入顺序串成哈希链
+		err = w.repo.CreateChained(ctx, batch, w.hash)
+	} else {
+		err = w.repo.CreateInBatches(ctx, batch, logsWriterBatchSize)
+	}
+	if err != nil {
+		w.flushFailed.Add(1)
+		w.failed.Add(int64(len(batch)))
+		w.log.Errorf("写入操作记录失败, 共 %d 条: %v", len(batch), err)
+		return
+	}
+	w.written.Add(int64(len(batch)))
+	// 写入成功后推送给实时订阅者
+	w.stream.Publish(batch)
+}
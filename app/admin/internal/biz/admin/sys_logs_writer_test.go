@@ -0,0 +1,150 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+// fakeLogsRepo 记录每次批量写入的条数，err 不为空时写入失败
+type fakeLogsRepo struct {
+	SysLogsRepo
+	mu      sync.Mutex
+	batches []int
+	err     error
+}
+
+func (r *fakeLogsRepo) CreateInBatches(ctx context.Context, logs []*model.SysLogs, batchSize int) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.err != nil {
+		return r.err
+	}
+	r.batches = append(r.batches, len(logs))
+	return nil
+}
+
+func (r *fakeLogsRepo) written() (batches []int, total int) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, n := range r.batches {
+		total += n
+	}
+	return append([]int(nil), r.batches...), total
+}
+
+type fakeLogsStreamRepo struct{}
+
+func (fakeLogsStreamRepo) Publish(context.Context, []*model.SysLogs) error { return nil }
+
+func (fakeLogsStreamRepo) Subscribe(ctx context.Context) <-chan *model.SysLogs {
+	return make(chan *model.SysLogs)
+}
+
+func newTestLogsWriter(repo SysLogsRepo) *SysLogsWriter {
+	return NewSysLogsWriter(repo, NewSysLogsStream(fakeLogsStreamRepo{}, log.DefaultLogger), &conf.LogConfig{}, log.DefaultLogger)
+}
+
+func stopLogsWriter(t *testing.T, w *SysLogsWriter) {
+	t.Helper()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := w.Stop(ctx); err != nil {
+		t.Fatalf("stop: %v", err)
+	}
+}
+
+func Test_SysLogsWriter_Batch(t *testing.T) {
+	repo := &fakeLogsRepo{}
+	w := newTestLogsWriter(repo)
+	const n = logsWriterBatchSize*2 + 50
+	for i := 0; i < n; i++ {
+		w.Write(&model.SysLogs{})
+	}
+	go w.Start(context.Background())
+	stopLogsWriter(t, w)
+
+	batches, total := repo.written()
+	if total != n {
+		t.Fatalf("written %d records, want %d", total, n)
+	}
+	for _, size := range batches {
+		if size > logsWriterBatchSize {
+			t.Fatalf("batch size %d exceeds %d", size, logsWriterBatchSize)
+		}
+	}
+	if stats := w.Stats(); stats.Written != n || stats.Queued != 0 || stats.Failed != 0 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func Test_SysLogsWriter_FlushInterval(t *testing.T) {
+	repo := &fakeLogsRepo{}
+	w := newTestLogsWriter(repo)
+	go w.Start(context.Background())
+	defer stopLogsWriter(t, w)
+
+	for i := 0; i < 3; i++ {
+		w.Write(&model.SysLogs{})
+	}
+	// 不足一批的记录在刷新间隔到达后写入
+	deadline := time.Now().Add(3 * logsWriterFlushInterval)
+	for time.Now().Before(deadline) {
+		if _, total := repo.written(); total == 3 {
+			return
+		}
+		time.Sleep(20 * time.Millisecond)
+	}
+	_, total := repo.written()
+	t.Fatalf("written %d records after flush interval, want 3", total)
+}
+
+func Test_SysLogsWriter_WriteAfterStop(t *testing.T) {
+	repo := &fakeLogsRepo{}
+	w := newTestLogsWriter(repo)
+	go w.Start(context.Background())
+	stopLogsWriter(t, w)
+
+	// 停止后的记录同步写入
+	w.Write(&model.SysLogs{})
+	if _, total := repo.written(); total != 1 {
+		t.Fatalf("written %d records after stop, want 1", total)
+	}
+}
+
+func Test_SysLogsWriter_Drop(t *testing.T) {
+	repo := &fakeLogsRepo{}
+	w := newTestLogsWriter(repo)
+	// 不启动写入协程，队列已满后等待超时丢弃
+	w.queue = make(chan *model.SysLogs, 2)
+	for i := 0; i < 5; i++ {
+		w.Write(&model.SysLogs{})
+	}
+	stats := w.Stats()
+	if stats.Queued != 2 || stats.Capacity != 2 || stats.Dropped != 3 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
+
+func Test_SysLogsWriter_FlushFailure(t *testing.T) {
+	repo := &fakeLogsRepo{err: errors.New("db down")}
+	w := newTestLogsWriter(repo)
+	for i := 0; i < 5; i++ {
+		w.Write(&model.SysLogs{})
+	}
+	go w.Start(context.Background())
+	stopLogsWriter(t, w)
+
+	// 每个写入协程各自攒批，失败次数不超过协程数
+	stats := w.Stats()
+	if stats.Failed != 5 || stats.FlushFailures < 1 || stats.FlushFailures > logsWriterWorkers || stats.Written != 0 {
+		t.Fatalf("unexpected stats %+v", stats)
+	}
+}
@@ -7,29 +7,38 @@ import (
 	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
 )
 
 // SysMenuRepo 接口定义
 type SysMenuRepo interface {
 	Save(ctx context.Context, menu *model.SysMenus) error
 	Create(ctx context.Context, menu *model.SysMenus) error
-	Delete(ctx context.Context, id int64) error
-	DeleteMultiple(ctx context.Context, ids []int64) error
+	// Delete、DeleteMultiple 软删除菜单，deleteBy 不为空时记为更新人
+	Delete(ctx context.Context, id int64, deleteBy string) error
+	DeleteMultiple(ctx context.Context, ids []int64, deleteBy string) error
 	FindByID(ctx context.Context, id int64) (*model.SysMenus, error)
 	Count(ctx context.Context, name string, status int32) (int32, error)
 	GetAllChildren(ctx context.Context, id int64) ([]int64, error)
 	FindByNameStatus(ctx context.Context, menuName string, status int32) ([]*model.SysMenus, error)
 	SelectMenuLabel(ctx context.Context, menu model.SysMenus) ([]*pb.MenuLabel, error)
 	GetRoleMenuId(ctx context.Context, roleId int64) ([]int32, error)
+	ListAll(ctx context.Context) ([]*model.SysMenus, error)
+	ListAllBtns(ctx context.Context) ([]*model.SysMenuBtns, error)
+	// DeleteBtns 删除菜单的按钮
+	DeleteBtns(ctx context.Context, menuIds ...int64) error
+	// DeleteRoleRefs 删除角色对菜单及其按钮的授权
+	DeleteRoleRefs(ctx context.Context, menuIds ...int64) error
 }
 
 type SysMenuUseCase struct {
 	repo SysMenuRepo
+	tx   Transaction
 	log  *log.Helper
 }
 
-func NewSysMenusUseCase(repo SysMenuRepo, logger log.Logger) *SysMenuUseCase {
-	return &SysMenuUseCase{repo: repo, log: log.NewHelper(logger)}
+func NewSysMenusUseCase(repo SysMenuRepo, tx Transaction, logger log.Logger) *SysMenuUseCase {
+	return &SysMenuUseCase{repo: repo, tx: tx, log: log.NewHelper(logger)}
 }
 
 func (m *SysMenuUseCase) CreateMenus(ctx context.Context, menu *model.SysMenus) (*model.SysMenus, error) {
@@ -44,21 +53,41 @@ func (m *SysMenuUseCase) UpdateMenus(ctx context.Context, menu *model.SysMenus)
 	claims := authz.MustFromContext(ctx)
 	menu.UpdateBy = claims.Nickname
 
-	err := m.repo.Save(ctx, menu)
-	return menu, err
+	// Save 在记录不存在时会新增，查不到修改前的记录时不记录变更
+	oldMenu, _ := m.repo.FindByID(ctx, menu.ID)
+	if err := m.repo.Save(ctx, menu); err != nil {
+		return menu, err
+	}
+	if newMenu, err := m.repo.FindByID(ctx, menu.ID); err == nil && oldMenu != nil {
+		RecordChange(ctx, ChangeEntityMenu, menu.ID, oldMenu, newMenu)
+	}
+	return menu, nil
 }
 
-func (m *SysMenuUseCase) DeleteMenus(ctx context.Context, id int64) error {
-	// 删除父级菜单时同时删除子菜单，否则获取菜单会报错
+// DeleteMenus 删除菜单及其按钮和角色授权，存在子菜单时按 opts 级联删除，否则拒绝删除
+func (m *SysMenuUseCase) DeleteMenus(ctx context.Context, id int64, opts DeleteOptions) error {
 	allChildrenMenus, err := m.repo.GetAllChildren(ctx, id)
 	if err != nil {
-		return pb.ErrorDatabaseErr("获取所有子菜单失败:%s", err.Error())
+		return i18n.WithID(pb.ErrorDatabaseErr("获取所有子菜单失败:%s", err.Error()), "menu.listChildrenFailed", err.Error())
 	}
-	err = m.repo.DeleteMultiple(ctx, allChildrenMenus)
-	if err != nil {
-		return pb.ErrorDatabaseErr("删除子菜单失败:%s", err.Error())
+	if !opts.Cascade {
+		if err = (DeleteBlockers{Children: len(allChildrenMenus)}).Err(); err != nil {
+			return err
+		}
 	}
-	return m.repo.Delete(ctx, id)
+	ids := append([]int64{id}, allChildrenMenus...)
+	return m.tx.Transaction(ctx, func(ctx context.Context) error {
+		if err := m.repo.DeleteRoleRefs(ctx, ids...); err != nil {
+			return err
+		}
+		if err := m.repo.DeleteBtns(ctx, ids...); err != nil {
+			return err
+		}
+		if err := m.repo.DeleteMultiple(ctx, allChildrenMenus, operatorName(ctx)); err != nil {
+			return i18n.WithID(pb.ErrorDatabaseErr("删除子菜单失败:%s", err.Error()), "menu.deleteChildrenFailed", err.Error())
+		}
+		return m.repo.Delete(ctx, id, operatorName(ctx))
+	})
 }
 
 func (m *SysMenuUseCase) FindMenus(ctx context.Context, id int64) (*model.SysMenus, error) {
@@ -2,7 +2,7 @@ package admin
 
 import (
 	"context"
-	"errors"
+	"slices"
 
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
@@ -13,7 +13,8 @@ import (
 type SysPostRepo interface {
 	Save(ctx context.Context, post *model.SysPosts) error
 	Create(ctx context.Context, post *model.SysPosts) error
-	Delete(ctx context.Context, id []int64) error
+	// Delete 软删除岗位，deleteBy 不为空时记为更新人
+	Delete(ctx context.Context, id []int64, deleteBy string) error
 	FindByID(ctx context.Context, id int64) (*model.SysPosts, error)
 	FindByIDList(ctx context.Context, ids ...int64) ([]*model.SysPosts, error)
 	FindAll(ctx context.Context) ([]*model.SysPosts, error)
@@ -26,10 +27,11 @@ type SysPostUseCase struct {
 	repo SysPostRepo
 	log  *log.Helper
 	uc   *SysUserUseCase
+	tx   Transaction
 }
 
-func NewSysPostUseCase(repo SysPostRepo, logger log.Logger, uc *SysUserUseCase) *SysPostUseCase {
-	return &SysPostUseCase{repo: repo, log: log.NewHelper(logger), uc: uc}
+func NewSysPostUseCase(repo SysPostRepo, logger log.Logger, uc *SysUserUseCase, tx Transaction) *SysPostUseCase {
+	return &SysPostUseCase{repo: repo, log: log.NewHelper(logger), uc: uc, tx: tx}
 }
 
 func (p *SysPostUseCase) ListPost(ctx context.Context, postName, postCode string, status int32, page, size int32) ([]*model.SysPosts, int32, error) {
@@ -56,24 +58,33 @@ func (p *SysPostUseCase) UpdatePost(ctx context.Context, post *model.SysPosts) (
 	return post, err
 }
 
-func (p *SysPostUseCase) DeletePost(ctx context.Context, ids []int64) error {
-	deList := make([]int64, 0)
-	for _, postId := range ids {
-		posts, err := p.uc.FindByPostId(ctx, postId)
+// DeletePost 删除岗位，岗位下有用户时按 opts 转移用户，否则拒绝删除
+func (p *SysPostUseCase) DeletePost(ctx context.Context, ids []int64, opts DeleteOptions) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	return p.tx.Transaction(ctx, func(ctx context.Context) error {
+		users, err := p.uc.findReferencing(ctx, userRefPost, ids)
 		if err != nil {
 			return err
 		}
-		if len(posts) == 0 {
-			deList = append(deList, postId)
+		if opts.ReassignTo == 0 {
+			if err = (DeleteBlockers{Users: len(users)}).Err(); err != nil {
+				return err
+			}
 		} else {
-			return errors.New("岗位已绑定用户, 无法删除")
+			if slices.Contains(ids, opts.ReassignTo) {
+				return errReassignInvalid
+			}
+			if _, err = p.repo.FindByID(ctx, opts.ReassignTo); err != nil {
+				return errReassignInvalid
+			}
 		}
-	}
-	if len(deList) == 0 {
-		return nil
-	}
-
-	return p.repo.Delete(ctx, deList)
+		if err = p.uc.reassign(ctx, userRefPost, users, ids, opts.ReassignTo); err != nil {
+			return err
+		}
+		return p.repo.Delete(ctx, ids, operatorName(ctx))
+	})
 }
 
 func (p *SysPostUseCase) FindPostByIDList(ctx context.Context, ids []int64) ([]*model.SysPosts, error) {
@@ -0,0 +1,299 @@
+package admin
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+	"github.com/swordkee/kratos-vue-admin/pkg/util"
+)
+
+// 回收站实体类型
+const (
+	RecycleEntityUser = "user"
+	RecycleEntityRole = "role"
+	RecycleEntityDept = "dept"
+	RecycleEntityMenu = "menu"
+	RecycleEntityPost = "post"
+)
+
+// RecycleEntities 支持回收站的全部实体类型
+var RecycleEntities = []string{RecycleEntityUser, RecycleEntityRole, RecycleEntityDept, RecycleEntityMenu, RecycleEntityPost}
+
+// recyclePurgeInterval 自动清理回收站的间隔
+const recyclePurgeInterval = time.Hour
+
+// RecycleItem 回收站中的记录
+type RecycleItem struct {
+	ID   int64
+	Name string
+	// Key 恢复时需要唯一的值，用户名、角色代码、岗位代码，其他实体为空
+	Key string
+	// ParentID 上级部门或上级菜单，其他实体为 0
+	ParentID int64
+	// DeleteBy 删除人，软删除时记为更新人
+	DeleteBy  string
+	DeletedAt time.Time
+}
+
+// SysRecycleRepo 接口定义
+type SysRecycleRepo interface {
+	ListDeleted(ctx context.Context, entity string, page, size int32) ([]*RecycleItem, int64, error)
+	FindDeleted(ctx context.Context, entity string, ids ...int64) ([]*RecycleItem, error)
+	// FindActiveKeys 未删除的记录中已占用的唯一键
+	FindActiveKeys(ctx context.Context, entity string, keys ...string) ([]string, error)
+	// FindActiveIDs 未删除的记录中存在的 id
+	FindActiveIDs(ctx context.Context, entity string, ids ...int64) ([]int64, error)
+	// FindDeletedUsers 已删除的用户，用于恢复前校验其部门、角色和岗位
+	FindDeletedUsers(ctx context.Context, ids ...int64) ([]*model.SysUsers, error)
+	// Restore 清除删除时间，恢复人记为更新人
+	Restore(ctx context.Context, entity, updateBy string, ids ...int64) error
+	// Purge 永久删除已删除的记录，返回删除条数
+	Purge(ctx context.Context, entity string, ids ...int64) (int64, error)
+	// PurgeBefore 永久删除删除时间早于 before 的记录
+	PurgeBefore(ctx context.Context, entity string, before time.Time) (int64, error)
+}
+
+type SysRecycleUseCase struct {
+	repo     SysRecycleRepo
+	deptRepo SysDeptRepo
+	tx       Transaction
+	conf     *conf.Data
+
+	// lastPurge 上次自动清理的时间，只在定时任务中读写
+	lastPurge time.Time
+	log       *log.Helper
+}
+
+func NewSysRecycleUseCase(repo SysRecycleRepo, deptRepo SysDeptRepo, tx Transaction, c *conf.Data, logger log.Logger) *SysRecycleUseCase {
+	return &SysRecycleUseCase{
+		repo:     repo,
+		deptRepo: deptRepo,
+		tx:       tx,
+		conf:     c,
+		log:      log.NewHelper(log.With(logger, "module", "biz/recycle")),
+	}
+}
+
+func (r *SysRecycleUseCase) ListPage(ctx context.Context, entity string, page, size int32) ([]*RecycleItem, int64, error) {
+	return r.repo.ListDeleted(ctx, entity, page, size)
+}
+
+// Restore 恢复已删除的记录。唯一键已被占用，上级既未恢复也不在本次恢复范围内，
+// 或用户的部门、角色、岗位已被删除时拒绝恢复；
+// 角色的菜单、按钮、数据权限和 api 权限在删除时已清除，恢复后需要重新授权
+func (r *SysRecycleUseCase) Restore(ctx context.Context, entity string, ids []int64) (int, error) {
+	claims := authz.MustFromContext(ctx)
+	ids = uniqueIDs(ids)
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	err := r.tx.Transaction(ctx, func(ctx context.Context) error {
+		items, err := r.repo.FindDeleted(ctx, entity, ids...)
+		if err != nil {
+			return err
+		}
+		if len(items) != len(ids) {
+			return i18n.WithID(errors.NotFound("RECYCLE_NOT_FOUND", "回收站中不存在该记录"), "recycle.notFound")
+		}
+
+		restoring := make(map[int64]bool, len(items))
+		keys := make([]string, 0, len(items))
+		seen := make(map[string]bool, len(items))
+		var parentIDs []int64
+		for _, item := range items {
+			restoring[item.ID] = true
+			if item.Key != "" {
+				if seen[item.Key] {
+					return i18n.WithID(errors.Conflict("RECYCLE_RESTORE_CONFLICT", "唯一键已被占用，无法恢复: "+item.Key), "recycle.keyTaken", item.Key)
+				}
+				seen[item.Key] = true
+				keys = append(keys, item.Key)
+			}
+		}
+		for _, item := range items {
+			if item.ParentID != 0 && !restoring[item.ParentID] {
+				parentIDs = append(parentIDs, item.ParentID)
+			}
+		}
+
+		if len(keys) > 0 {
+			taken, err := r.repo.FindActiveKeys(ctx, entity, keys...)
+			if err != nil {
+				return err
+			}
+			if len(taken) > 0 {
+				return i18n.WithID(errors.Conflict("RECYCLE_RESTORE_CONFLICT", "唯一键已被占用，无法恢复: "+strings.Join(taken, ",")), "recycle.keyTaken", strings.Join(taken, ","))
+			}
+		}
+		if len(parentIDs) > 0 {
+			active, err := r.repo.FindActiveIDs(ctx, entity, parentIDs...)
+			if err != nil {
+				return err
+			}
+			exists := make(map[int64]bool, len(active))
+			for _, id := range active {
+				exists[id] = true
+			}
+			for _, item := range items {
+				if item.ParentID != 0 && !restoring[item.ParentID] && !exists[item.ParentID] {
+					return i18n.WithID(errors.BadRequest("RECYCLE_RESTORE_INVALID", "上级已删除，请先恢复上级: "+item.Name), "recycle.parentDeleted", item.Name)
+				}
+			}
+		}
+
+		if entity == RecycleEntityUser {
+			if err = r.checkUserRefs(ctx, ids); err != nil {
+				return err
+			}
+		}
+
+		if err = r.repo.Restore(ctx, entity, claims.Nickname, ids...); err != nil {
+			return err
+		}
+		if entity == RecycleEntityDept {
+			return r.rebuildDeptPaths(ctx, ids)
+		}
+		return nil
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(ids), nil
+}
+
+// checkUserRefs 用户删除期间，其部门、角色和岗位可能也被删除，此时需要先恢复它们
+func (r *SysRecycleUseCase) checkUserRefs(ctx context.Context, ids []int64) error {
+	users, err := r.repo.FindDeletedUsers(ctx, ids...)
+	if err != nil {
+		return err
+	}
+	var deptIDs, roleIDs, postIDs []int64
+	for _, user := range users {
+		deptIDs = append(deptIDs, user.DeptID)
+		roleIDs = append(append(roleIDs, user.RoleID), util.Split2Int64Slice(user.RoleIds)...)
+		postIDs = append(append(postIDs, user.PostID), util.Split2Int64Slice(user.PostIds)...)
+	}
+	depts, err := r.activeIDs(ctx, RecycleEntityDept, deptIDs)
+	if err != nil {
+		return err
+	}
+	roles, err := r.activeIDs(ctx, RecycleEntityRole, roleIDs)
+	if err != nil {
+		return err
+	}
+	posts, err := r.activeIDs(ctx, RecycleEntityPost, postIDs)
+	if err != nil {
+		return err
+	}
+	missing := func(active map[int64]bool, ids ...int64) bool {
+		for _, id := range ids {
+			if id > 0 && !active[id] {
+				return true
+			}
+		}
+		return false
+	}
+	for _, user := range users {
+		switch {
+		case missing(depts, user.DeptID):
+			return i18n.WithID(errors.BadRequest("RECYCLE_RESTORE_INVALID", "用户的部门已删除，请先恢复部门: "+user.NickName), "recycle.userDeptDeleted", user.NickName)
+		case missing(roles, append(util.Split2Int64Slice(user.RoleIds), user.RoleID)...):
+			return i18n.WithID(errors.BadRequest("RECYCLE_RESTORE_INVALID", "用户的角色已删除，请先恢复角色: "+user.NickName), "recycle.userRoleDeleted", user.NickName)
+		case missing(posts, append(util.Split2Int64Slice(user.PostIds), user.PostID)...):
+			return i18n.WithID(errors.BadRequest("RECYCLE_RESTORE_INVALID", "用户的岗位已删除，请先恢复岗位: "+user.NickName), "recycle.userPostDeleted", user.NickName)
+		}
+	}
+	return nil
+}
+
+// activeIDs 未删除的记录中存在的 id
+func (r *SysRecycleUseCase) activeIDs(ctx context.Context, entity string, ids []int64) (map[int64]bool, error) {
+	active := make(map[int64]bool)
+	if ids = uniqueIDs(ids); len(ids) == 0 {
+		return active, nil
+	}
+	found, err := r.repo.FindActiveIDs(ctx, entity, ids...)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range found {
+		active[id] = true
+	}
+	return active, nil
+}
+
+// operatorName 当前操作人，软删除前记为更新人，回收站以此显示删除人；没有登录信息时为空
+func operatorName(ctx context.Context) string {
+	claims, err := authz.FromContext(ctx)
+	if err != nil {
+		return ""
+	}
+	return claims.Nickname
+}
+
+// rebuildDeptPaths 删除期间上级可能已移动，按当前的上级重新生成恢复部门的路径
+func (r *SysRecycleUseCase) rebuildDeptPaths(ctx context.Context, ids []int64) error {
+	depts, err := r.deptRepo.ListForUpdate(ctx)
+	if err != nil {
+		return err
+	}
+	parents := make(map[int64]int64, len(depts))
+	for _, dept := range depts {
+		parents[dept.ID] = dept.ParentID
+	}
+	paths := make(map[int64]string, len(ids))
+	for _, id := range ids {
+		paths[id] = deptPathOf(parents, id)
+	}
+	return r.deptRepo.UpdatePaths(ctx, paths)
+}
+
+// Purge 永久删除回收站中的记录，返回删除条数
+func (r *SysRecycleUseCase) Purge(ctx context.Context, entity string, ids []int64) (int64, error) {
+	ids = uniqueIDs(ids)
+	if len(ids) == 0 {
+		return 0, nil
+	}
+	return r.repo.Purge(ctx, entity, ids...)
+}
+
+// PurgeExpired 永久删除超过保留天数的记录，由定时任务调用
+func (r *SysRecycleUseCase) PurgeExpired(ctx context.Context) error {
+	days := r.conf.GetRecycle().GetRetentionDays()
+	if days <= 0 || time.Since(r.lastPurge) < recyclePurgeInterval {
+		return nil
+	}
+	r.lastPurge = time.Now()
+	before := time.Now().AddDate(0, 0, -int(days))
+	for _, entity := range RecycleEntities {
+		count, err := r.repo.PurgeBefore(ctx, entity, before)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			r.log.WithContext(ctx).Infof("回收站清理 %s %d 条", entity, count)
+		}
+	}
+	return nil
+}
+
+// uniqueIDs 去掉重复和无效的 id
+func uniqueIDs(ids []int64) []int64 {
+	seen := make(map[int64]bool, len(ids))
+	result := make([]int64, 0, len(ids))
+	for _, id := range ids {
+		if id > 0 && !seen[id] {
+			seen[id] = true
+			result = append(result, id)
+		}
+	}
+	return result
+}
@@ -0,0 +1,130 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// DeleteOptions 删除仍被引用的数据时的处理方式，默认存在引用时拒绝删除
+type DeleteOptions struct {
+	// Cascade 同时删除全部下级节点
+	Cascade bool
+	// ReassignTo 将关联的用户转移到该部门、岗位或角色
+	ReassignTo int64
+}
+
+// DeleteBlockers 阻止删除的引用数量
+type DeleteBlockers struct {
+	Users    int
+	Children int
+}
+
+// Err 存在引用时返回说明引用数量的错误，数量同时写入错误的 metadata
+func (b DeleteBlockers) Err() error {
+	if b.Users == 0 && b.Children == 0 {
+		return nil
+	}
+	var parts []string
+	if b.Users > 0 {
+		parts = append(parts, fmt.Sprintf("%d 个用户", b.Users))
+	}
+	if b.Children > 0 {
+		parts = append(parts, fmt.Sprintf("%d 个下级", b.Children))
+	}
+	users, children := strconv.Itoa(b.Users), strconv.Itoa(b.Children)
+	return i18n.WithID(errors.Conflict("DELETE_BLOCKED", "存在关联数据，无法删除: "+strings.Join(parts, ", ")).
+		WithMetadata(map[string]string{
+			"users":    users,
+			"children": children,
+		}), "delete.blocked", users, children)
+}
+
+// errReassignInvalid 转移用户的目标不存在或在本次删除范围内
+var errReassignInvalid = i18n.WithID(errors.BadRequest("DELETE_REASSIGN_INVALID", "转移的目标不存在或将被删除"), "delete.reassignInvalid")
+
+// userRef 用户引用的数据类型
+type userRef int
+
+const (
+	userRefDept userRef = iota
+	userRefPost
+	userRefRole
+)
+
+// findReferencing 查询引用了指定部门、岗位或角色的用户，岗位和角色同时匹配多岗位、多角色
+func (uc *SysUserUseCase) findReferencing(ctx context.Context, ref userRef, ids []int64) ([]*model.SysUsers, error) {
+	if len(ids) == 0 {
+		return nil, nil
+	}
+	switch ref {
+	case userRefDept:
+		return uc.userRepo.FindByDeptIds(ctx, ids...)
+	case userRefPost:
+		return uc.userRepo.FindByPostIds(ctx, ids...)
+	default:
+		return uc.userRepo.FindByRoleIds(ctx, ids...)
+	}
+}
+
+// reassign 将用户对 from 的引用改为 to，多岗位、多角色中去掉 from 并补上 to
+func (uc *SysUserUseCase) reassign(ctx context.Context, ref userRef, users []*model.SysUsers, from []int64, to int64) error {
+	removed := make(map[int64]bool, len(from))
+	for _, id := range from {
+		removed[id] = true
+	}
+	for _, user := range users {
+		switch ref {
+		case userRefDept:
+			user.DeptID = to
+		case userRefPost:
+			if removed[user.PostID] {
+				user.PostID = to
+			}
+			user.PostIds = replaceIDList(user.PostIds, removed, to)
+		case userRefRole:
+			if removed[user.RoleID] {
+				user.RoleID = to
+			}
+			user.RoleIds = replaceIDList(user.RoleIds, removed, to)
+		}
+		if err := uc.userRepo.UpdateReferences(ctx, user); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// replaceIDList 从逗号分隔的 id 列表中去掉 removed 中的 id，有去掉时补上 to
+func replaceIDList(list string, removed map[int64]bool, to int64) string {
+	ids := make([]string, 0)
+	changed, exists := false, false
+	for _, item := range strings.Split(list, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+		id, err := strconv.ParseInt(item, 10, 64)
+		if err == nil && removed[id] {
+			changed = true
+			continue
+		}
+		if err == nil && id == to {
+			exists = true
+		}
+		ids = append(ids, item)
+	}
+	if !changed {
+		return list
+	}
+	if !exists {
+		ids = append(ids, strconv.FormatInt(to, 10))
+	}
+	return strings.Join(ids, ",")
+}
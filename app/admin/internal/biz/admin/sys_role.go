@@ -2,6 +2,7 @@ package admin
 
 import (
 	"context"
+	"sort"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -11,17 +12,23 @@ import (
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
 )
 
+// SuperAdminRoleKey 超级管理员角色代码
+const SuperAdminRoleKey = "admin"
+
 // SysRoleRepo 接口定义
 type SysRoleRepo interface {
 	Create(ctx context.Context, role *model.SysRoles) error
 	Save(ctx context.Context, role *model.SysRoles) error
-	Delete(ctx context.Context, id ...int64) error
+	// Delete 软删除角色，deleteBy 不为空时记为更新人
+	Delete(ctx context.Context, deleteBy string, id ...int64) error
 	FindByID(ctx context.Context, id int64) (*model.SysRoles, error)
 	FindByIDList(ctx context.Context, ids ...int64) ([]*model.SysRoles, error)
 	FindAll(ctx context.Context) ([]*model.SysRoles, error)
 	ListPage(ctx context.Context, name, key string, status int32, page, size int32) ([]*model.SysRoles, error)
 	Count(ctx context.Context, name, key string, status int32) (int32, error)
 	Update(ctx context.Context, role *model.SysRoles) error
+	// DeleteRoleDepts 删除角色的数据权限部门
+	DeleteRoleDepts(ctx context.Context, roleIds ...int64) error
 }
 
 type SysRoleUseCase struct {
@@ -82,12 +89,40 @@ func (r *SysRoleUseCase) CreateRole(ctx context.Context, role *model.SysRoles, m
 	return role, err
 }
 
+// roleChangeSnapshot 角色及其菜单、按钮和 api 授权，用于记录变更历史
+type roleChangeSnapshot struct {
+	*model.SysRoles
+	MenuIds   []int64  `json:"menu_ids"`
+	ButtonIds []int64  `json:"button_ids"`
+	Apis      []string `json:"apis"` // "METHOD path"，按字典序排列
+}
+
+func (r *SysRoleUseCase) roleChangeSnapshot(ctx context.Context, role *model.SysRoles) (*roleChangeSnapshot, error) {
+	menuIds, buttonIds, err := r.roleMenuCase.FindGrantedMenuIds(ctx, role.ID)
+	if err != nil {
+		return nil, err
+	}
+	apis := []string{}
+	for _, policy := range r.casbinCase.FindPolicyPathByRoleId(role.RoleKey) {
+		if len(policy) >= 3 {
+			apis = append(apis, policy[2]+" "+policy[1])
+		}
+	}
+	sort.Strings(apis)
+	return &roleChangeSnapshot{SysRoles: role, MenuIds: menuIds, ButtonIds: buttonIds, Apis: apis}, nil
+}
+
 func (r *SysRoleUseCase) UpdateRole(ctx context.Context, role *model.SysRoles, menuIds []int64, apis []*pb.ApiBase) (*model.SysRoles, error) {
 	//claims := authz.MustFromContext(ctx)
 	oldRole, err := r.repo.FindByID(ctx, role.ID)
 	if err != nil {
 		return nil, err
 	}
+	copied := *oldRole
+	before, err := r.roleChangeSnapshot(ctx, &copied)
+	if err != nil {
+		return nil, err
+	}
 	//oldRole.UpdateBy = claims.Nickname
 	oldRole.UpdatedAt = time.Now()
 
@@ -118,7 +153,15 @@ func (r *SysRoleUseCase) UpdateRole(ctx context.Context, role *model.SysRoles, m
 		}
 		return nil
 	})
-	return role, err
+	if err != nil {
+		return role, err
+	}
+	if after, err := r.repo.FindByID(ctx, role.ID); err == nil {
+		if snapshot, err := r.roleChangeSnapshot(ctx, after); err == nil {
+			RecordChange(ctx, ChangeEntityRole, role.ID, before, snapshot)
+		}
+	}
+	return role, nil
 }
 
 func (r *SysRoleUseCase) ChangeRoleStatus(ctx context.Context, id int64, status int32) error {
@@ -146,7 +189,9 @@ func (r *SysRoleUseCase) ChangeDataScope(ctx context.Context, id int64, scope in
 	return r.repo.Save(ctx, role)
 }
 
-func (r *SysRoleUseCase) DeleteRole(ctx context.Context, ids []int64) error {
+// DeleteRole 删除角色及其菜单、按钮、数据权限和 api 权限，
+// 角色下有用户时按 opts 转移用户，否则拒绝删除
+func (r *SysRoleUseCase) DeleteRole(ctx context.Context, ids []int64, opts DeleteOptions) error {
 	if len(ids) == 0 {
 		return nil
 	}
@@ -154,35 +199,52 @@ func (r *SysRoleUseCase) DeleteRole(ctx context.Context, ids []int64) error {
 	roleM := make(map[int64]*model.SysRoles)
 
 	for _, rid := range ids {
-		//  查询角色下有没有用户
-		userCount, err := r.userUseCase.CountByRoleId(ctx, rid)
-		if err != nil {
-			return err
-		}
-		if userCount > 0 {
-			r.log.Errorf("role: %d 存在用户无法删除", rid)
-			// 返回一下错误提示
-			return pb.ErrorRoleBindAccount("改角色绑定了用户无法被删除")
-		}
-		delList = append(delList, rid)
-
 		role, err := r.repo.FindByID(ctx, rid)
 		if err != nil {
 			return err
 		}
-
+		delList = append(delList, rid)
 		roleM[rid] = role
 	}
+	if opts.ReassignTo != 0 {
+		if _, ok := roleM[opts.ReassignTo]; ok {
+			return errReassignInvalid
+		}
+		if _, err := r.repo.FindByID(ctx, opts.ReassignTo); err != nil {
+			return errReassignInvalid
+		}
+	}
 
 	err := r.tx.Transaction(ctx, func(ctx context.Context) error {
+		// 查询角色下有没有用户
+		users, err := r.userUseCase.findReferencing(ctx, userRefRole, delList)
+		if err != nil {
+			return err
+		}
+		if opts.ReassignTo == 0 {
+			if err = (DeleteBlockers{Users: len(users)}).Err(); err != nil {
+				r.log.Errorf("role: %v 存在用户无法删除", delList)
+				return err
+			}
+		}
+		if err = r.userUseCase.reassign(ctx, userRefRole, users, delList, opts.ReassignTo); err != nil {
+			return err
+		}
 		// 删除角色
-		if err := r.repo.Delete(ctx, delList...); err != nil {
+		if err := r.repo.Delete(ctx, operatorName(ctx), delList...); err != nil {
 			return err
 		}
-		// 删除菜单
+		// 删除菜单和按钮
 		if err := r.roleMenuCase.DeleteByRoleId(ctx, delList...); err != nil {
 			return err
 		}
+		if err := r.roleMenuCase.DeleteRoleBtnsByRoleId(ctx, delList...); err != nil {
+			return err
+		}
+		// 删除数据权限
+		if err := r.repo.DeleteRoleDepts(ctx, delList...); err != nil {
+			return err
+		}
 		// 删除角色绑定api
 		for _, roleID := range delList {
 			if err := r.casbinCase.ClearCasbin(roleM[roleID].RoleKey); err != nil {
@@ -196,6 +258,23 @@ func (r *SysRoleUseCase) DeleteRole(ctx context.Context, ids []int64) error {
 	return err
 }
 
+// HasSuperAdmin 角色列表中是否包含超级管理员
+func (r *SysRoleUseCase) HasSuperAdmin(ctx context.Context, ids []int64) (bool, error) {
+	if len(ids) == 0 {
+		return false, nil
+	}
+	roles, err := r.repo.FindByIDList(ctx, ids...)
+	if err != nil {
+		return false, err
+	}
+	for _, role := range roles {
+		if role.RoleKey == SuperAdminRoleKey {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 func (r *SysRoleUseCase) FindRoleByIDList(ctx context.Context, ids []int64) ([]*model.SysRoles, error) {
 	if len(ids) == 0 {
 		return []*model.SysRoles{}, nil
@@ -14,16 +14,21 @@ type SysRoleMenuRepo interface {
 	DeleteByRoleId(ctx context.Context, roleIDs ...int64) error
 	GetPermission(ctx context.Context, roleID int64) ([]string, error)
 	FindMenuByRoleId(ctx context.Context, roleID int64) ([]*model.SysMenus, error)
+	FindGrantedMenus(ctx context.Context, roleID int64) ([]*model.SysMenus, error)
 	SelectMenuRole(ctx context.Context, roleName string) ([]*pb.MenuTree, error)
+	FindRoleBtns(ctx context.Context, roleID int64) ([]*model.SysRoleBtns, error)
+	CreateRoleBtns(ctx context.Context, roleBtns ...*model.SysRoleBtns) error
+	DeleteRoleBtnsByRoleId(ctx context.Context, roleIDs ...int64) error
 }
 
 type SysRoleMenuUseCase struct {
-	repo SysRoleMenuRepo
-	log  *log.Helper
+	repo        SysRoleMenuRepo
+	translation *SysTranslationUseCase
+	log         *log.Helper
 }
 
-func NewSysRoleMenuUseCase(repo SysRoleMenuRepo, logger log.Logger) *SysRoleMenuUseCase {
-	return &SysRoleMenuUseCase{repo: repo, log: log.NewHelper(logger)}
+func NewSysRoleMenuUseCase(repo SysRoleMenuRepo, translation *SysTranslationUseCase, logger log.Logger) *SysRoleMenuUseCase {
+	return &SysRoleMenuUseCase{repo: repo, translation: translation, log: log.NewHelper(logger)}
 }
 
 func (r *SysRoleMenuUseCase) CreateRoleMenus(ctx context.Context, role *model.SysRoles, menuIDs []int64) error {
@@ -42,6 +47,10 @@ func (r *SysRoleMenuUseCase) DeleteByRoleId(ctx context.Context, roleIDs ...int6
 	return r.repo.DeleteByRoleId(ctx, roleIDs...)
 }
 
+func (r *SysRoleMenuUseCase) DeleteRoleBtnsByRoleId(ctx context.Context, roleIDs ...int64) error {
+	return r.repo.DeleteRoleBtnsByRoleId(ctx, roleIDs...)
+}
+
 func (r *SysRoleMenuUseCase) FindPermission(ctx context.Context, roleID int64) ([]string, error) {
 	return r.repo.GetPermission(ctx, roleID)
 }
@@ -50,6 +59,44 @@ func (r *SysRoleMenuUseCase) FindMenuByRoleId(ctx context.Context, roleID int64)
 	return r.repo.FindMenuByRoleId(ctx, roleID)
 }
 
+// FindGrantedMenuIds 角色授权的目录和菜单 id 及按钮 id，按 id 升序
+func (r *SysRoleMenuUseCase) FindGrantedMenuIds(ctx context.Context, roleID int64) (menuIds, buttonIds []int64, err error) {
+	menus, err := r.repo.FindGrantedMenus(ctx, roleID)
+	if err != nil {
+		return nil, nil, err
+	}
+	menuIds, buttonIds = []int64{}, []int64{}
+	for _, menu := range menus {
+		if menu.MenuType == "F" {
+			buttonIds = append(buttonIds, menu.ID)
+		} else {
+			menuIds = append(menuIds, menu.ID)
+		}
+	}
+	return menuIds, buttonIds, nil
+}
+
+func (r *SysRoleMenuUseCase) FindRoleBtns(ctx context.Context, roleID int64) ([]*model.SysRoleBtns, error) {
+	return r.repo.FindRoleBtns(ctx, roleID)
+}
+
+// ReplaceRoleBtns 替换角色的按钮权限
+func (r *SysRoleMenuUseCase) ReplaceRoleBtns(ctx context.Context, roleID int64, roleBtns []*model.SysRoleBtns) error {
+	if err := r.repo.DeleteRoleBtnsByRoleId(ctx, roleID); err != nil {
+		return err
+	}
+	if len(roleBtns) == 0 {
+		return nil
+	}
+	return r.repo.CreateRoleBtns(ctx, roleBtns...)
+}
+
+// SelectMenuRole 角色的菜单树，菜单标题按请求语言翻译
 func (r *SysRoleMenuUseCase) SelectMenuRole(ctx context.Context, roleName string) ([]*pb.MenuTree, error) {
-	return r.repo.SelectMenuRole(ctx, roleName)
+	menus, err := r.repo.SelectMenuRole(ctx, roleName)
+	if err != nil {
+		return nil, err
+	}
+	r.translation.LocalizeMenus(ctx, menus)
+	return menus, nil
 }
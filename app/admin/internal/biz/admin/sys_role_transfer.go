@@ -0,0 +1,484 @@
+package admin
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// RoleExportFile 角色导出文件
+type RoleExportFile struct {
+	Roles []*RoleExport `json:"roles" yaml:"roles"`
+}
+
+// RoleExport 角色导出格式，菜单、按钮、api 都使用业务键而不是自增id，便于在不同环境间迁移
+type RoleExport struct {
+	RoleKey       string           `json:"roleKey" yaml:"roleKey"`
+	RoleName      string           `json:"roleName" yaml:"roleName"`
+	ParentKey     string           `json:"parentKey,omitempty" yaml:"parentKey,omitempty"`
+	Status        int32            `json:"status" yaml:"status"`
+	DataScope     int32            `json:"dataScope" yaml:"dataScope"`
+	RoleSort      int32            `json:"roleSort" yaml:"roleSort"`
+	DefaultRouter string           `json:"defaultRouter,omitempty" yaml:"defaultRouter,omitempty"`
+	Remark        string           `json:"remark,omitempty" yaml:"remark,omitempty"`
+	Menus         []string         `json:"menus" yaml:"menus"`
+	Apis          []*RoleExportApi `json:"apis" yaml:"apis"`
+	Btns          []string         `json:"btns,omitempty" yaml:"btns,omitempty"`
+}
+
+// RoleExportApi 角色的 api 权限
+type RoleExportApi struct {
+	Path   string `json:"path" yaml:"path"`
+	Method string `json:"method" yaml:"method"`
+}
+
+// RoleImportDiff 单个角色导入前后的差异
+type RoleImportDiff struct {
+	RoleKey       string
+	Action        string // create、update、unchanged
+	ChangedFields []string
+	AddedMenus    []string
+	RemovedMenus  []string
+	AddedApis     []*RoleExportApi
+	RemovedApis   []*RoleExportApi
+	AddedBtns     []string
+	RemovedBtns   []string
+}
+
+const (
+	RoleImportCreate    = "create"
+	RoleImportUpdate    = "update"
+	RoleImportUnchanged = "unchanged"
+)
+
+// menuKey 菜单的业务键，有权限标识时使用权限标识，否则使用路由地址
+func menuKey(menu *model.SysMenus) string {
+	return MenuTransKey(menu.Permission, menu.Path)
+}
+
+// btnKey 按钮的业务键，格式为 菜单键#按钮key
+func btnKey(menu *model.SysMenus, btn *model.SysMenuBtns) string {
+	return menuKey(menu) + "#" + btn.Name
+}
+
+// roleTransferContext 导入导出时共用的菜单、按钮、角色索引
+type roleTransferContext struct {
+	roles     []*model.SysRoles
+	roleByID  map[int64]*model.SysRoles
+	roleByKey map[string]*model.SysRoles
+	menuByID  map[int64]*model.SysMenus
+	menuByKey map[string]*model.SysMenus
+	btnByID   map[int64]*model.SysMenuBtns
+	btnByKey  map[string]*model.SysMenuBtns
+}
+
+func (r *SysRoleUseCase) loadTransferContext(ctx context.Context) (*roleTransferContext, error) {
+	roles, err := r.repo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	menus, err := r.menuRepo.ListAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	btns, err := r.menuRepo.ListAllBtns(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	tc := &roleTransferContext{
+		roles:     roles,
+		roleByID:  make(map[int64]*model.SysRoles, len(roles)),
+		roleByKey: make(map[string]*model.SysRoles, len(roles)),
+		menuByID:  make(map[int64]*model.SysMenus, len(menus)),
+		menuByKey: make(map[string]*model.SysMenus, len(menus)),
+		btnByID:   make(map[int64]*model.SysMenuBtns, len(btns)),
+		btnByKey:  make(map[string]*model.SysMenuBtns, len(btns)),
+	}
+	for _, role := range roles {
+		tc.roleByID[role.ID] = role
+		tc.roleByKey[role.RoleKey] = role
+	}
+	for _, menu := range menus {
+		tc.menuByID[menu.ID] = menu
+		tc.menuByKey[menuKey(menu)] = menu
+	}
+	for _, btn := range btns {
+		tc.btnByID[btn.ID] = btn
+		if menu, ok := tc.menuByID[btn.MenuID]; ok {
+			tc.btnByKey[btnKey(menu, btn)] = btn
+		}
+	}
+	return tc, nil
+}
+
+// exportRole 将数据库中的角色转换为导出格式
+func (r *SysRoleUseCase) exportRole(ctx context.Context, tc *roleTransferContext, role *model.SysRoles) (*RoleExport, error) {
+	out := &RoleExport{
+		RoleKey:       role.RoleKey,
+		RoleName:      role.RoleName,
+		Status:        role.Status,
+		DataScope:     role.DataScope,
+		RoleSort:      role.RoleSort,
+		DefaultRouter: role.DefaultRouter,
+		Remark:        role.Remark,
+		Menus:         make([]string, 0),
+		Apis:          make([]*RoleExportApi, 0),
+	}
+	if parent, ok := tc.roleByID[role.ParentID]; ok {
+		out.ParentKey = parent.RoleKey
+	}
+
+	menuIds, err := r.menuRepo.GetRoleMenuId(ctx, role.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range menuIds {
+		if menu, ok := tc.menuByID[int64(id)]; ok {
+			out.Menus = append(out.Menus, menuKey(menu))
+		}
+	}
+	sort.Strings(out.Menus)
+
+	for _, p := range r.casbinCase.FindPolicyPathByRoleId(role.RoleKey) {
+		out.Apis = append(out.Apis, &RoleExportApi{Path: p[1], Method: p[2]})
+	}
+	sort.Slice(out.Apis, func(i, j int) bool {
+		return apiKey(out.Apis[i].Path, out.Apis[i].Method) < apiKey(out.Apis[j].Path, out.Apis[j].Method)
+	})
+
+	roleBtns, err := r.roleMenuCase.FindRoleBtns(ctx, role.ID)
+	if err != nil {
+		return nil, err
+	}
+	for _, rb := range roleBtns {
+		btn, ok := tc.btnByID[rb.BtnID]
+		if !ok {
+			continue
+		}
+		if menu, ok := tc.menuByID[btn.MenuID]; ok {
+			out.Btns = append(out.Btns, btnKey(menu, btn))
+		}
+	}
+	sort.Strings(out.Btns)
+	return out, nil
+}
+
+// ExportRoles 导出角色，roleKeys 为空时导出全部角色
+func (r *SysRoleUseCase) ExportRoles(ctx context.Context, roleKeys []string) (*RoleExportFile, error) {
+	tc, err := r.loadTransferContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := tc.roles
+	if len(roleKeys) > 0 {
+		roles = make([]*model.SysRoles, 0, len(roleKeys))
+		for _, key := range roleKeys {
+			role, ok := tc.roleByKey[key]
+			if !ok {
+				return nil, i18n.WithID(errors.NotFound("ROLE_NOT_FOUND", "角色不存在: "+key), "role.notFound", key)
+			}
+			roles = append(roles, role)
+		}
+	}
+
+	file := &RoleExportFile{Roles: make([]*RoleExport, 0, len(roles))}
+	for _, role := range roles {
+		out, err := r.exportRole(ctx, tc, role)
+		if err != nil {
+			return nil, err
+		}
+		file.Roles = append(file.Roles, out)
+	}
+	return file, nil
+}
+
+// ImportRoles 按 roleKey 匹配导入角色，菜单、按钮按业务键匹配。
+// dryRun 为 true 时只返回差异；否则在同一个事务中写入角色、菜单、按钮和 api 权限
+func (r *SysRoleUseCase) ImportRoles(ctx context.Context, file *RoleExportFile, dryRun bool) ([]*RoleImportDiff, []string, error) {
+	tc, err := r.loadTransferContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var warnings []string
+	inFile := make(map[string]struct{}, len(file.Roles))
+	for _, in := range file.Roles {
+		if in.RoleKey == "" {
+			return nil, nil, i18n.WithID(errors.BadRequest("ROLE_KEY_MISSING", "导入的角色缺少 roleKey"), "role.keyMissing")
+		}
+		if _, ok := inFile[in.RoleKey]; ok {
+			return nil, nil, i18n.WithID(errors.BadRequest("ROLE_KEY_DUPLICATE", "导入的角色 roleKey 重复: "+in.RoleKey), "role.keyDuplicate", in.RoleKey)
+		}
+		inFile[in.RoleKey] = struct{}{}
+	}
+	for _, in := range file.Roles {
+		if in.ParentKey == "" {
+			continue
+		}
+		if _, ok := inFile[in.ParentKey]; ok {
+			continue
+		}
+		if _, ok := tc.roleByKey[in.ParentKey]; !ok {
+			warnings = append(warnings, "角色 "+in.RoleKey+" 的上级角色不存在: "+in.ParentKey)
+		}
+	}
+
+	diffs := make([]*RoleImportDiff, 0, len(file.Roles))
+	for _, in := range file.Roles {
+		// 过滤目标环境中不存在的菜单和按钮
+		menus := make([]string, 0, len(in.Menus))
+		for _, key := range in.Menus {
+			if _, ok := tc.menuByKey[key]; !ok {
+				warnings = append(warnings, "角色 "+in.RoleKey+" 的菜单不存在: "+key)
+				continue
+			}
+			menus = append(menus, key)
+		}
+		in.Menus = menus
+		btns := make([]string, 0, len(in.Btns))
+		for _, key := range in.Btns {
+			if _, ok := tc.btnByKey[key]; !ok {
+				warnings = append(warnings, "角色 "+in.RoleKey+" 的按钮不存在: "+key)
+				continue
+			}
+			btns = append(btns, key)
+		}
+		in.Btns = btns
+
+		diff := &RoleImportDiff{RoleKey: in.RoleKey, Action: RoleImportCreate}
+		current := &RoleExport{}
+		if role, ok := tc.roleByKey[in.RoleKey]; ok {
+			if current, err = r.exportRole(ctx, tc, role); err != nil {
+				return nil, nil, err
+			}
+			diff.ChangedFields = roleChangedFields(current, in)
+		}
+		diff.AddedMenus, diff.RemovedMenus = diffStrings(current.Menus, in.Menus)
+		diff.AddedBtns, diff.RemovedBtns = diffStrings(current.Btns, in.Btns)
+		diff.AddedApis, diff.RemovedApis = diffApis(current.Apis, in.Apis)
+		if _, ok := tc.roleByKey[in.RoleKey]; ok {
+			diff.Action = RoleImportUpdate
+			if len(diff.ChangedFields) == 0 && len(diff.AddedMenus) == 0 && len(diff.RemovedMenus) == 0 &&
+				len(diff.AddedBtns) == 0 && len(diff.RemovedBtns) == 0 && len(diff.AddedApis) == 0 && len(diff.RemovedApis) == 0 {
+				diff.Action = RoleImportUnchanged
+			}
+		}
+		diffs = append(diffs, diff)
+	}
+	if dryRun {
+		return diffs, warnings, nil
+	}
+
+	nickname := ""
+	if claims, err := authz.FromContext(ctx); err == nil {
+		nickname = claims.Nickname
+	}
+	var policies, restore []rolePolicies
+	err = r.tx.Transaction(ctx, func(ctx context.Context) error {
+		now := time.Now()
+		// 先写入角色本身，上级角色可能也在本次导入中
+		for i, in := range file.Roles {
+			if diffs[i].Action == RoleImportUnchanged {
+				continue
+			}
+			role, ok := tc.roleByKey[in.RoleKey]
+			if !ok {
+				role = &model.SysRoles{RoleKey: in.RoleKey, CreateBy: nickname, CreatedAt: now}
+			}
+			role.RoleName = in.RoleName
+			role.Status = in.Status
+			role.DataScope = in.DataScope
+			role.RoleSort = in.RoleSort
+			role.DefaultRouter = in.DefaultRouter
+			role.Remark = in.Remark
+			role.UpdateBy = nickname
+			role.UpdatedAt = now
+			if ok {
+				if err := r.repo.Save(ctx, role); err != nil {
+					return err
+				}
+			} else {
+				if err := r.repo.Create(ctx, role); err != nil {
+					return err
+				}
+				tc.roleByKey[role.RoleKey] = role
+			}
+		}
+
+		for i, in := range file.Roles {
+			if diffs[i].Action == RoleImportUnchanged {
+				continue
+			}
+			role := tc.roleByKey[in.RoleKey]
+			var parentID int64
+			if parent, ok := tc.roleByKey[in.ParentKey]; ok && in.ParentKey != "" {
+				parentID = parent.ID
+			}
+			if role.ParentID != parentID {
+				role.ParentID = parentID
+				if err := r.repo.Save(ctx, role); err != nil {
+					return err
+				}
+			}
+
+			// 菜单
+			menuIds := make([]int64, len(in.Menus))
+			for j, key := range in.Menus {
+				menuIds[j] = tc.menuByKey[key].ID
+			}
+			if err := r.roleMenuCase.DeleteByRoleId(ctx, role.ID); err != nil {
+				return err
+			}
+			if len(menuIds) > 0 {
+				if err := r.roleMenuCase.CreateRoleMenus(ctx, role, menuIds); err != nil {
+					return err
+				}
+			}
+
+			// 按钮
+			roleBtns := make([]*model.SysRoleBtns, len(in.Btns))
+			for j, key := range in.Btns {
+				btn := tc.btnByKey[key]
+				roleBtns[j] = &model.SysRoleBtns{RoleID: role.ID, MenuID: btn.MenuID, BtnID: btn.ID}
+			}
+			if err := r.roleMenuCase.ReplaceRoleBtns(ctx, role.ID, roleBtns); err != nil {
+				return err
+			}
+
+			// api 权限
+			apis := make([]*pb.ApiBase, len(in.Apis))
+			for j, api := range in.Apis {
+				apis[j] = &pb.ApiBase{Path: api.Path, Method: strings.ToUpper(api.Method)}
+			}
+			policies = append(policies, rolePolicies{roleKey: role.RoleKey, apis: apis})
+		}
+		// casbin 策略通过共享的 enforcer 写入，不参与数据库事务，所以放在最后写入
+		restore, err = r.applyRolePolicies(ctx, policies)
+		return err
+	})
+	if err != nil {
+		// 事务回滚后恢复已写入角色的原有策略
+		r.restoreRolePolicies(ctx, restore)
+		return nil, nil, err
+	}
+	return diffs, warnings, nil
+}
+
+// rolePolicies 角色的 api 权限
+type rolePolicies struct {
+	roleKey string
+	apis    []*pb.ApiBase
+}
+
+// applyRolePolicies 写入角色的 api 权限，返回写入前各角色的原有权限，用于失败时恢复；
+// 写入失败的角色也包含在内，因为写入时会先清空原有策略
+func (r *SysRoleUseCase) applyRolePolicies(ctx context.Context, list []rolePolicies) ([]rolePolicies, error) {
+	previous := make([]rolePolicies, 0, len(list))
+	for _, p := range list {
+		var apis []*pb.ApiBase
+		for _, policy := range r.casbinCase.FindPolicyPathByRoleId(p.roleKey) {
+			if len(policy) >= 3 {
+				apis = append(apis, &pb.ApiBase{Path: policy[1], Method: policy[2]})
+			}
+		}
+		previous = append(previous, rolePolicies{roleKey: p.roleKey, apis: apis})
+		if err := r.setRolePolicies(ctx, p); err != nil {
+			return previous, err
+		}
+	}
+	return previous, nil
+}
+
+// restoreRolePolicies 按 applyRolePolicies 返回的原有权限逆序恢复，恢复失败只记录日志
+func (r *SysRoleUseCase) restoreRolePolicies(ctx context.Context, previous []rolePolicies) {
+	for i := len(previous) - 1; i >= 0; i-- {
+		if err := r.setRolePolicies(ctx, previous[i]); err != nil {
+			r.log.WithContext(ctx).Errorf("恢复角色 %s 的 api 权限失败: %v", previous[i].roleKey, err)
+		}
+	}
+}
+
+func (r *SysRoleUseCase) setRolePolicies(ctx context.Context, p rolePolicies) error {
+	if len(p.apis) == 0 {
+		return r.casbinCase.ClearCasbin(p.roleKey)
+	}
+	return r.casbinCase.UpdateCasbin(ctx, p.roleKey, p.apis)
+}
+
+func roleChangedFields(current, in *RoleExport) []string {
+	var fields []string
+	if current.RoleName != in.RoleName {
+		fields = append(fields, "roleName")
+	}
+	if current.ParentKey != in.ParentKey {
+		fields = append(fields, "parentKey")
+	}
+	if current.Status != in.Status {
+		fields = append(fields, "status")
+	}
+	if current.DataScope != in.DataScope {
+		fields = append(fields, "dataScope")
+	}
+	if current.RoleSort != in.RoleSort {
+		fields = append(fields, "roleSort")
+	}
+	if current.DefaultRouter != in.DefaultRouter {
+		fields = append(fields, "defaultRouter")
+	}
+	if current.Remark != in.Remark {
+		fields = append(fields, "remark")
+	}
+	return fields
+}
+
+// diffStrings 返回 to 相对 from 新增和删除的元素
+func diffStrings(from, to []string) (added, removed []string) {
+	fromSet := make(map[string]struct{}, len(from))
+	for _, s := range from {
+		fromSet[s] = struct{}{}
+	}
+	toSet := make(map[string]struct{}, len(to))
+	for _, s := range to {
+		toSet[s] = struct{}{}
+		if _, ok := fromSet[s]; !ok {
+			added = append(added, s)
+		}
+	}
+	for _, s := range from {
+		if _, ok := toSet[s]; !ok {
+			removed = append(removed, s)
+		}
+	}
+	return
+}
+
+func diffApis(from, to []*RoleExportApi) (added, removed []*RoleExportApi) {
+	fromSet := make(map[string]struct{}, len(from))
+	for _, api := range from {
+		fromSet[apiKey(api.Path, api.Method)] = struct{}{}
+	}
+	toSet := make(map[string]struct{}, len(to))
+	for _, api := range to {
+		key := apiKey(api.Path, api.Method)
+		toSet[key] = struct{}{}
+		if _, ok := fromSet[key]; !ok {
+			added = append(added, api)
+		}
+	}
+	for _, api := range from {
+		if _, ok := toSet[apiKey(api.Path, api.Method)]; !ok {
+			removed = append(removed, api)
+		}
+	}
+	return
+}
@@ -0,0 +1,292 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// 临时授权类型
+const (
+	TempGrantTypeRole = "role"
+	TempGrantTypeApi  = "api"
+)
+
+// 临时授权状态
+const (
+	TempGrantStatusPending int32 = 1 // 待生效
+	TempGrantStatusActive  int32 = 2 // 生效中
+	TempGrantStatusExpired int32 = 3 // 已过期
+	TempGrantStatusRevoked int32 = 4 // 已撤销
+)
+
+// 临时授权状态变化的审计事件
+const (
+	TempGrantEventCreated   = "tempGrant.created"
+	TempGrantEventActivated = "tempGrant.activated"
+	TempGrantEventExpired   = "tempGrant.expired"
+	TempGrantEventRevoked   = "tempGrant.revoked"
+)
+
+// TempGrantEvent 临时授权状态变化的审计内容
+type TempGrantEvent struct {
+	GrantID   int64     `json:"grantId"`
+	UserID    int64     `json:"userId"`
+	GrantType string    `json:"grantType"`
+	RoleKey   string    `json:"roleKey,omitempty"`
+	Apis      string    `json:"apis,omitempty"`
+	StartTime time.Time `json:"startTime"`
+	EndTime   time.Time `json:"endTime"`
+	CreateBy  string    `json:"createBy,omitempty"`
+	RevokeBy  string    `json:"revokeBy,omitempty"`
+}
+
+// SysTempGrantRepo 接口定义
+type SysTempGrantRepo interface {
+	Create(ctx context.Context, grant *model.SysTempGrants) error
+	FindByID(ctx context.Context, id int64) (*model.SysTempGrants, error)
+	FindActiveByUserID(ctx context.Context, userID int64) ([]*model.SysTempGrants, error)
+	// FindDue 查找到达生效时间的待生效授权和到达失效时间的生效中授权
+	FindDue(ctx context.Context, now time.Time) ([]*model.SysTempGrants, error)
+	// UpdateStatus 仅当状态仍为 from 时更新，返回是否更新成功，多实例下避免重复处理
+	UpdateStatus(ctx context.Context, grant *model.SysTempGrants, from int32) (bool, error)
+	ListPage(ctx context.Context, userID int64, status []int32, page, size int32) ([]*model.SysTempGrants, error)
+	Count(ctx context.Context, userID int64, status []int32) (int32, error)
+}
+
+type SysTempGrantUseCase struct {
+	repo       SysTempGrantRepo
+	roleRepo   SysRoleRepo
+	userRepo   SysUserRepo
+	casbinCase *CasbinRuleUseCase
+	logsCase   *SysLogsUseCase
+	log        *log.Helper
+}
+
+func NewSysTempGrantUseCase(repo SysTempGrantRepo, roleRepo SysRoleRepo, userRepo SysUserRepo, casbinCase *CasbinRuleUseCase, logsCase *SysLogsUseCase, logger log.Logger) *SysTempGrantUseCase {
+	return &SysTempGrantUseCase{
+		repo:       repo,
+		roleRepo:   roleRepo,
+		userRepo:   userRepo,
+		casbinCase: casbinCase,
+		logsCase:   logsCase,
+		log:        log.NewHelper(log.With(logger, "module", "biz/tempGrant")),
+	}
+}
+
+// DecodeTempGrantApis 解析授权中保存的 api 列表
+func DecodeTempGrantApis(grant *model.SysTempGrants) []*pb.ApiBase {
+	var apis []*pb.ApiBase
+	if grant.Apis != "" {
+		_ = json.Unmarshal([]byte(grant.Apis), &apis)
+	}
+	return apis
+}
+
+// Grant 创建临时授权，开始时间已到时立即写入 casbin。不能授予超级管理员角色，
+// 也不能授予操作人自己没有的权限
+func (t *SysTempGrantUseCase) Grant(ctx context.Context, grant *model.SysTempGrants, apis []*pb.ApiBase) (*model.SysTempGrants, error) {
+	claims := authz.MustFromContext(ctx)
+	now := time.Now()
+	if grant.StartTime.IsZero() || grant.StartTime.Before(now) {
+		grant.StartTime = now
+	}
+	if !grant.EndTime.After(grant.StartTime) {
+		return nil, i18n.WithID(errors.BadRequest("TEMP_GRANT_INVALID", "失效时间必须晚于生效时间和当前时间"), "tempGrant.timeInvalid")
+	}
+	if _, err := t.userRepo.FindByID(ctx, grant.UserID); err != nil {
+		return nil, i18n.WithID(pb.ErrorUserNotFound("用户不存在"), "user.notFound")
+	}
+
+	switch grant.GrantType {
+	case TempGrantTypeRole:
+		roles, err := t.roleRepo.FindAll(ctx)
+		if err != nil {
+			return nil, err
+		}
+		found := false
+		for _, role := range roles {
+			if role.RoleKey == grant.RoleKey {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return nil, i18n.WithID(errors.BadRequest("TEMP_GRANT_INVALID", "角色不存在: "+grant.RoleKey), "role.notFound", grant.RoleKey)
+		}
+		if grant.RoleKey == SuperAdminRoleKey {
+			return nil, i18n.WithID(errors.Forbidden("TEMP_GRANT_FORBIDDEN", "不能临时授予超级管理员角色"), "tempGrant.superAdmin")
+		}
+		holds, err := holdsPolicies(t.casbinCase.repo, claims, grant.RoleKey)
+		if err != nil {
+			return nil, err
+		}
+		if !holds {
+			return nil, i18n.WithID(errors.Forbidden("TEMP_GRANT_FORBIDDEN", "不能授予权限高于自己的角色: "+grant.RoleKey), "tempGrant.higherRole", grant.RoleKey)
+		}
+		grant.Apis = "[]"
+	case TempGrantTypeApi:
+		if len(apis) == 0 {
+			return nil, i18n.WithID(errors.BadRequest("TEMP_GRANT_INVALID", "api 权限不能为空"), "tempGrant.apiEmpty")
+		}
+		for _, api := range apis {
+			api.Method = strings.ToUpper(api.Method)
+			holds, err := holdsApi(t.casbinCase.repo, claims, api.Path, api.Method)
+			if err != nil {
+				return nil, err
+			}
+			if !holds {
+				name := api.Method + " " + api.Path
+				return nil, i18n.WithID(errors.Forbidden("TEMP_GRANT_FORBIDDEN", "不能授予自己没有的 api 权限: "+name), "tempGrant.apiNotHeld", name)
+			}
+		}
+		content, err := json.Marshal(apis)
+		if err != nil {
+			return nil, err
+		}
+		grant.RoleKey = ""
+		grant.Apis = string(content)
+	default:
+		return nil, i18n.WithID(errors.BadRequest("TEMP_GRANT_INVALID", "不支持的授权类型: "+grant.GrantType), "tempGrant.typeUnsupported", grant.GrantType)
+	}
+
+	grant.Status = TempGrantStatusPending
+	if !grant.StartTime.After(now) {
+		grant.Status = TempGrantStatusActive
+	}
+	grant.CreateBy = claims.Nickname
+	grant.CreatedAt = now
+	grant.UpdatedAt = now
+	if err := t.repo.Create(ctx, grant); err != nil {
+		return nil, err
+	}
+	t.log.Infof("临时授权已创建, id: %d, user: %d, type: %s, role: %s, apis: %s, start: %s, end: %s, by: %s",
+		grant.ID, grant.UserID, grant.GrantType, grant.RoleKey, grant.Apis, grant.StartTime, grant.EndTime, grant.CreateBy)
+	t.audit(ctx, TempGrantEventCreated, claims.UserID, grant)
+
+	if grant.Status == TempGrantStatusActive {
+		if err := t.syncUser(ctx, grant.UserID); err != nil {
+			return nil, err
+		}
+	}
+	return grant, nil
+}
+
+// Revoke 手动撤销临时授权，失效时间记为撤销时间
+func (t *SysTempGrantUseCase) Revoke(ctx context.Context, id int64) error {
+	claims := authz.MustFromContext(ctx)
+	grant, err := t.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	from := grant.Status
+	if from != TempGrantStatusPending && from != TempGrantStatusActive {
+		return i18n.WithID(errors.BadRequest("TEMP_GRANT_INVALID", "授权已失效，无需撤销"), "tempGrant.expired")
+	}
+	now := time.Now()
+	grant.Status = TempGrantStatusRevoked
+	grant.RevokeBy = claims.Nickname
+	grant.EndTime = now
+	grant.UpdatedAt = now
+	ok, err := t.repo.UpdateStatus(ctx, grant, from)
+	if err != nil {
+		return err
+	}
+	if !ok {
+		return i18n.WithID(errors.Conflict("TEMP_GRANT_CHANGED", "授权状态已变化，请刷新后重试"), "tempGrant.changed")
+	}
+	t.log.Infof("临时授权已撤销, id: %d, user: %d, by: %s", grant.ID, grant.UserID, grant.RevokeBy)
+	t.audit(ctx, TempGrantEventRevoked, claims.UserID, grant)
+	if from == TempGrantStatusActive {
+		return t.syncUser(ctx, grant.UserID)
+	}
+	return nil
+}
+
+// ListPage 临时授权列表，history 为 false 时只返回待生效和生效中的授权
+func (t *SysTempGrantUseCase) ListPage(ctx context.Context, userID int64, history bool, page, size int32) ([]*model.SysTempGrants, int32, error) {
+	var status []int32
+	if !history {
+		status = []int32{TempGrantStatusPending, TempGrantStatusActive}
+	}
+	total, err := t.repo.Count(ctx, userID, status)
+	if err != nil {
+		return nil, 0, err
+	}
+	list, err := t.repo.ListPage(ctx, userID, status, page, size)
+	return list, total, err
+}
+
+// Reconcile 使到期的授权生效或失效，并同步到 casbin，由定时任务调用
+func (t *SysTempGrantUseCase) Reconcile(ctx context.Context) error {
+	now := time.Now()
+	grants, err := t.repo.FindDue(ctx, now)
+	if err != nil {
+		return err
+	}
+	users := make(map[int64]struct{})
+	for _, grant := range grants {
+		from := grant.Status
+		// 生效前已过期的授权直接标记为过期
+		if grant.EndTime.After(now) {
+			grant.Status = TempGrantStatusActive
+		} else {
+			grant.Status = TempGrantStatusExpired
+		}
+		grant.UpdatedAt = now
+		ok, err := t.repo.UpdateStatus(ctx, grant, from)
+		if err != nil {
+			return err
+		}
+		if !ok {
+			continue
+		}
+		if grant.Status == TempGrantStatusActive {
+			t.log.Infof("临时授权已生效, id: %d, user: %d", grant.ID, grant.UserID)
+			t.audit(ctx, TempGrantEventActivated, 0, grant)
+		} else {
+			t.log.Infof("临时授权已过期, id: %d, user: %d", grant.ID, grant.UserID)
+			t.audit(ctx, TempGrantEventExpired, 0, grant)
+		}
+		users[grant.UserID] = struct{}{}
+	}
+	for userID := range users {
+		if err = t.syncUser(ctx, userID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// audit 将授权状态变化写入操作记录，operatorID 为 0 表示由定时任务触发
+func (t *SysTempGrantUseCase) audit(ctx context.Context, event string, operatorID int64, grant *model.SysTempGrants) {
+	t.logsCase.RecordEvent(ctx, event, operatorID, &TempGrantEvent{
+		GrantID:   grant.ID,
+		UserID:    grant.UserID,
+		GrantType: grant.GrantType,
+		RoleKey:   grant.RoleKey,
+		Apis:      grant.Apis,
+		StartTime: grant.StartTime,
+		EndTime:   grant.EndTime,
+		CreateBy:  grant.CreateBy,
+		RevokeBy:  grant.RevokeBy,
+	})
+}
+
+// syncUser 按用户当前生效的授权重建用户主体的 casbin 策略
+func (t *SysTempGrantUseCase) syncUser(ctx context.Context, userID int64) error {
+	grants, err := t.repo.FindActiveByUserID(ctx, userID)
+	if err != nil {
+		return err
+	}
+	return t.casbinCase.ReplaceUserGrants(userID, grants)
+}
@@ -0,0 +1,196 @@
+package admin
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// 翻译模块
+const (
+	TranslationModuleDict = "dict" // 字典标签
+	TranslationModuleMenu = "menu" // 菜单标题
+)
+
+// translationCacheExpire 翻译的本地缓存时间，其他实例修改后最迟在该时间后生效
+const translationCacheExpire = time.Minute
+
+// SysTranslationCondition 翻译查询条件
+type SysTranslationCondition struct {
+	Module string
+	Locale string
+	// KeyPrefix 翻译键前缀
+	KeyPrefix string
+}
+
+// SysTranslationRepo 接口定义
+type SysTranslationRepo interface {
+	ListPage(ctx context.Context, cond *SysTranslationCondition, page, size int32) ([]*model.SysTranslations, int64, error)
+	FindByID(ctx context.Context, id int64) (*model.SysTranslations, error)
+	FindByModuleLocale(ctx context.Context, module, locale string) ([]*model.SysTranslations, error)
+	// Upsert 按 module、trans_key、locale 新增或覆盖译文
+	Upsert(ctx context.Context, items []*model.SysTranslations) error
+	Delete(ctx context.Context, id int64) error
+}
+
+type translationEntry struct {
+	values   map[string]string
+	expireAt time.Time
+}
+
+type SysTranslationUseCase struct {
+	repo SysTranslationRepo
+
+	mu sync.RWMutex
+	// cache 按 模块|语言 缓存 翻译键 -> 译文
+	cache map[string]*translationEntry
+	log   *log.Helper
+}
+
+func NewSysTranslationUseCase(repo SysTranslationRepo, logger log.Logger) *SysTranslationUseCase {
+	return &SysTranslationUseCase{
+		repo:  repo,
+		cache: make(map[string]*translationEntry),
+		log:   log.NewHelper(log.With(logger, "module", "biz/translation")),
+	}
+}
+
+// DictTransKey 字典标签的翻译键
+func DictTransKey(dictType, dictValue string) string {
+	return dictType + ":" + dictValue
+}
+
+// MenuTransKey 菜单标题的翻译键，与角色导入导出使用的菜单业务键一致
+func MenuTransKey(permission, path string) string {
+	if permission != "" {
+		return permission
+	}
+	return "path:" + path
+}
+
+func (t *SysTranslationUseCase) ListPage(ctx context.Context, cond *SysTranslationCondition, page, size int32) ([]*model.SysTranslations, int64, error) {
+	return t.repo.ListPage(ctx, cond, page, size)
+}
+
+// Save 批量保存译文，返回保存条数
+func (t *SysTranslationUseCase) Save(ctx context.Context, items []*model.SysTranslations) (int, error) {
+	claims := authz.MustFromContext(ctx)
+	now := time.Now()
+	for _, item := range items {
+		locale, ok := i18n.Normalize(item.Locale)
+		if !ok {
+			return 0, i18n.WithID(errors.BadRequest("TRANSLATION_INVALID", "不支持的语言: "+item.Locale), "locale.unsupported", item.Locale)
+		}
+		if locale == i18n.DefaultLocale {
+			return 0, i18n.WithID(errors.BadRequest("TRANSLATION_INVALID", "默认语言即源数据，无需翻译"), "translation.defaultLocale")
+		}
+		if item.Value == "" {
+			return 0, i18n.WithID(errors.BadRequest("TRANSLATION_INVALID", "翻译内容不能为空"), "translation.empty")
+		}
+		item.Locale = locale
+		item.CreateBy = claims.Nickname
+		item.UpdateBy = claims.Nickname
+		item.CreatedAt = now
+		item.UpdatedAt = now
+	}
+	if err := t.repo.Upsert(ctx, items); err != nil {
+		return 0, err
+	}
+	for _, item := range items {
+		t.invalidate(item.Module, item.Locale)
+	}
+	return len(items), nil
+}
+
+func (t *SysTranslationUseCase) Delete(ctx context.Context, id int64) error {
+	item, err := t.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if err = t.repo.Delete(ctx, id); err != nil {
+		return err
+	}
+	t.invalidate(item.Module, item.Locale)
+	return nil
+}
+
+// translations 模块在指定语言下的全部译文，默认语言返回 nil；读库失败时只记日志，按源数据返回
+func (t *SysTranslationUseCase) translations(ctx context.Context, module, locale string) map[string]string {
+	if locale == i18n.DefaultLocale {
+		return nil
+	}
+	key := module + "|" + locale
+	t.mu.RLock()
+	entry, ok := t.cache[key]
+	t.mu.RUnlock()
+	if ok && time.Now().Before(entry.expireAt) {
+		return entry.values
+	}
+
+	list, err := t.repo.FindByModuleLocale(ctx, module, locale)
+	if err != nil {
+		t.log.WithContext(ctx).Errorf("读取翻译失败, module: %s, locale: %s, err: %v", module, locale, err)
+		return nil
+	}
+	values := make(map[string]string, len(list))
+	for _, item := range list {
+		values[item.TransKey] = item.Value
+	}
+	t.mu.Lock()
+	t.cache[key] = &translationEntry{values: values, expireAt: time.Now().Add(translationCacheExpire)}
+	t.mu.Unlock()
+	return values
+}
+
+func (t *SysTranslationUseCase) invalidate(module, locale string) {
+	t.mu.Lock()
+	delete(t.cache, module+"|"+locale)
+	t.mu.Unlock()
+}
+
+// LocalizeDictData 按请求语言翻译字典标签，返回副本，不修改传入的数据
+func (t *SysTranslationUseCase) LocalizeDictData(ctx context.Context, list []*model.SysDictData) []*model.SysDictData {
+	values := t.translations(ctx, TranslationModuleDict, i18n.FromContext(ctx))
+	if len(values) == 0 {
+		return list
+	}
+	localized := make([]*model.SysDictData, len(list))
+	for i, d := range list {
+		localized[i] = d
+		if label, ok := values[DictTransKey(d.DictType, d.DictValue)]; ok {
+			c := *d
+			c.DictLabel = label
+			localized[i] = &c
+		}
+	}
+	return localized
+}
+
+// LocalizeMenus 按请求语言翻译菜单树的名称和标题
+func (t *SysTranslationUseCase) LocalizeMenus(ctx context.Context, menus []*pb.MenuTree) {
+	values := t.translations(ctx, TranslationModuleMenu, i18n.FromContext(ctx))
+	if len(values) == 0 {
+		return
+	}
+	var walk func([]*pb.MenuTree)
+	walk = func(menus []*pb.MenuTree) {
+		for _, m := range menus {
+			if title, ok := values[MenuTransKey(m.Permission, m.Path)]; ok {
+				m.MenuName = title
+				if m.Title != "" {
+					m.Title = title
+				}
+			}
+			walk(m.Children)
+		}
+	}
+	walk(menus)
+}
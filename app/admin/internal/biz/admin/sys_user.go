@@ -4,6 +4,8 @@ import (
 	"context"
 	"path/filepath"
 	"time"
+	"unicode"
+	"unicode/utf8"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
@@ -17,14 +19,42 @@ import (
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/common"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
 	"github.com/swordkee/kratos-vue-admin/pkg/util"
 )
 
 var (
-	ErrUserNotFound    = errors.New(400, "user not found", "user not found")
-	ErrPasswordInvalid = errors.New(400, "password invalid", "user not found")
+	ErrUserNotFound    = i18n.WithID(errors.New(400, "user not found", "user not found"), "user.notFound")
+	ErrPasswordInvalid = i18n.WithID(errors.New(400, "password invalid", "user not found"), "user.passwordInvalid")
+	ErrPasswordWeak    = i18n.WithID(errors.BadRequest("PASSWORD_WEAK", "密码长度为 8-30 位，且必须同时包含字母和数字"), "user.passwordWeak")
 )
 
+// 密码长度限制，修改密码和管理员重置密码使用同一规则
+const (
+	passwordMinLen = 8
+	passwordMaxLen = 30
+)
+
+// checkPassword 校验密码长度，且必须同时包含字母和数字
+func checkPassword(password string) error {
+	if n := utf8.RuneCountInString(password); n < passwordMinLen || n > passwordMaxLen {
+		return ErrPasswordWeak
+	}
+	var letter, digit bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLetter(r):
+			letter = true
+		case unicode.IsDigit(r):
+			digit = true
+		}
+	}
+	if !letter || !digit {
+		return ErrPasswordWeak
+	}
+	return nil
+}
+
 // UserListCondition is a condition for user list query.
 type UserListCondition struct {
 	UserName string
@@ -35,12 +65,25 @@ type UserListCondition struct {
 // SysUserRepo 接口定义
 type SysUserRepo interface {
 	Save(ctx context.Context, user *model.SysUsers) (*model.SysUsers, error)
-	Delete(ctx context.Context, id int64) error
+	// Delete 软删除用户，deleteBy 不为空时记为更新人
+	Delete(ctx context.Context, id int64, deleteBy string) error
 	UpdateByID(ctx context.Context, id int64, user *model.SysUsers) error
+	UpdateLocale(ctx context.Context, id int64, locale string) error
+	// ResetPassword 重置密码并标记下次登录必须修改，同时使此前签发的 token 失效
+	ResetPassword(ctx context.Context, id int64, password, updateBy string, revokedAt int64) error
+	// RevokeTokens 使 revokedAt（unix 秒）及之前签发的 token 失效
+	RevokeTokens(ctx context.Context, id int64, revokedAt int64) error
 	Create(ctx context.Context, g *model.SysUsers) (*model.SysUsers, error)
 	FindByID(ctx context.Context, id int64) (*model.SysUsers, error)
 	FindByUsername(ctx context.Context, username string) (*model.SysUsers, error)
 	FindByPostId(ctx context.Context, postId int64) ([]*model.SysUsers, error)
+	FindByDeptIds(ctx context.Context, deptIds ...int64) ([]*model.SysUsers, error)
+	// FindByPostIds 岗位或多岗位包含指定岗位的用户
+	FindByPostIds(ctx context.Context, postIds ...int64) ([]*model.SysUsers, error)
+	// FindByRoleIds 角色或多角色包含指定角色的用户
+	FindByRoleIds(ctx context.Context, roleIds ...int64) ([]*model.SysUsers, error)
+	// UpdateReferences 更新用户的部门、岗位和角色
+	UpdateReferences(ctx context.Context, user *model.SysUsers) error
 	ListPage(ctx context.Context, page, size int32, condition UserListCondition) ([]*model.SysUsers, error)
 	Count(ctx context.Context, condition UserListCondition) (int32, error)
 	CountByRoleId(ctx context.Context, roleId int64) (int64, error)
@@ -61,16 +104,18 @@ type SysUserRepo interface {
 type SysUserUseCase struct {
 	userRepo   SysUserRepo
 	uploadRepo upload.OssRepo
+	tokenState *UserTokenStateUseCase
 
 	severConfig *conf.Server
 	log         *log.Helper
 }
 
 // NewSysUserUseCase new a SysUser use case.
-func NewSysUserUseCase(userRepo SysUserRepo, uploadRepo upload.OssRepo, severConfig *conf.Server, logger log.Logger) *SysUserUseCase {
+func NewSysUserUseCase(userRepo SysUserRepo, uploadRepo upload.OssRepo, tokenState *UserTokenStateUseCase, severConfig *conf.Server, logger log.Logger) *SysUserUseCase {
 	return &SysUserUseCase{
 		userRepo:    userRepo,
 		uploadRepo:  uploadRepo,
+		tokenState:  tokenState,
 		severConfig: severConfig,
 		log:         log.NewHelper(logger),
 	}
@@ -92,7 +137,7 @@ func (uc *SysUserUseCase) CreateSysUser(ctx context.Context, u *model.SysUsers)
 	if errors.Is(err, gorm.ErrRecordNotFound) {
 		return uc.userRepo.Create(ctx, u)
 	} else {
-		return user, pb.ErrorAccountExisted("账号已存在")
+		return user, i18n.WithID(pb.ErrorAccountExisted("账号已存在"), "user.accountExisted")
 	}
 }
 
@@ -111,11 +156,22 @@ func (uc *SysUserUseCase) UpdateSysUser(ctx context.Context, u *model.SysUsers)
 	u.Password = oldUser.Password
 	u.UpdateBy = claims.Nickname
 	u.CreateBy = oldUser.CreateBy
-	return uc.userRepo.UpdateByID(ctx, u.ID, u)
+	if err = uc.userRepo.UpdateByID(ctx, u.ID, u); err != nil {
+		return err
+	}
+	if newUser, err := uc.userRepo.FindByID(ctx, u.ID); err == nil {
+		RecordChange(ctx, ChangeEntityUser, u.ID, oldUser, newUser)
+	}
+	return nil
 }
 
 func (uc *SysUserUseCase) DeleteSysUser(ctx context.Context, id int64) error {
-	return uc.userRepo.Delete(ctx, id)
+	if err := uc.userRepo.Delete(ctx, id, operatorName(ctx)); err != nil {
+		return err
+	}
+	// 删除缓存后已签发的 token 在鉴权时读库，用户不存在即被拒绝
+	uc.tokenState.Invalidate(ctx, id)
+	return nil
 }
 
 func (uc *SysUserUseCase) FindSysUserById(ctx context.Context, id int64) (*model.SysUsers, error) {
@@ -154,10 +210,17 @@ func (uc *SysUserUseCase) UpdatePassword(ctx context.Context, id int64, newPwd,
 	if !util.BcryptCheck(oldPwd, user.Password) {
 		return ErrPasswordInvalid
 	}
+	if err = checkPassword(newPwd); err != nil {
+		return err
+	}
 
 	user.Password = util.BcryptHash(newPwd)
-	_, err = uc.userRepo.Save(ctx, user)
-	return err
+	user.MustChangePassword = false
+	if _, err = uc.userRepo.Save(ctx, user); err != nil {
+		return err
+	}
+	uc.tokenState.Invalidate(ctx, id)
+	return nil
 }
 
 func (uc *SysUserUseCase) FindByPostId(ctx context.Context, postId int64) ([]*model.SysUsers, error) {
@@ -183,7 +246,7 @@ func (uc *SysUserUseCase) UpdateAvatar(ctx context.Context) error {
 
 	ext := filepath.Ext(fileHeader.Filename)
 	if !common.IsAllowedFileExt(ext) {
-		return errors.New(401, "file type not allowed", "file type not allowed")
+		return i18n.WithID(errors.New(401, "file type not allowed", "file type not allowed"), "file.typeNotAllowed")
 	}
 
 	guid, _ := kgo.KStr.UuidV4()
@@ -209,7 +272,7 @@ func (uc *SysUserUseCase) UploadFile(ctx context.Context) (string, error) {
 
 	ext := filepath.Ext(fileHeader.Filename)
 	if !common.IsAllowedFileExt(ext) {
-		return "", errors.New(401, "file type not allowed", "file type not allowed")
+		return "", i18n.WithID(errors.New(401, "file type not allowed", "file type not allowed"), "file.typeNotAllowed")
 	}
 
 	guid, _ := kgo.KStr.UuidV4()
@@ -0,0 +1,487 @@
+package admin
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"math/big"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	"gorm.io/gorm"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+	"github.com/swordkee/kratos-vue-admin/pkg/util"
+)
+
+const (
+	// userImportMaxRows 单次导入的最大用户数
+	userImportMaxRows = 1000
+	// userImportPasswordLen 未填写密码时生成的随机密码长度
+	userImportPasswordLen = 12
+	// 导入用户的默认状态和性别：正常、未知
+	userImportStatus int32 = 1
+	userImportSex    int32 = 2
+	// userImportPasswordsExpire 随机生成的初始密码的下载有效期，下载一次后即删除
+	userImportPasswordsExpire = 10 * time.Minute
+	// userImportPasswordsKeyPrefix 初始密码的 redis key 前缀，后接导入人 id 和下载 token
+	userImportPasswordsKeyPrefix = "kva:user:import:passwords:"
+)
+
+// userImportPasswordsHeader 初始密码文件的表头
+var userImportPasswordsHeader = []string{"用户名", "初始密码"}
+
+const userImportPasswordChars = "ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnpqrstuvwxyz23456789"
+
+// userImportHeader 导入模板的表头，部门可以填写名称或以 / 分隔的完整路径，
+// 岗位编码和角色代码可以用逗号分隔填写多个，第一个为主岗位、主角色，密码为空时随机生成
+var userImportHeader = []string{"用户名", "昵称", "手机号", "邮箱", "部门", "岗位编码", "角色代码", "密码"}
+
+// userImportRequired 表头中必须存在的列
+var userImportRequired = userImportHeader[:7]
+
+// UserImportRow 导入文件中的一行用户
+type UserImportRow struct {
+	// Line 在文件中的行号，表头为第 1 行
+	Line     int
+	Username string
+	NickName string
+	Phone    string
+	Email    string
+	Dept     string
+	PostCode string
+	RoleKey  string
+	Password string
+}
+
+// UserImportResult 单行用户的校验和导入结果
+type UserImportResult struct {
+	Line     int
+	Username string
+	Errors   []string
+	Created  bool
+}
+
+// UserImportTemplate 导入模板，表头和一行示例
+func UserImportTemplate() [][]string {
+	return [][]string{
+		userImportHeader,
+		{"zhangsan", "张三", "13800000000", "zhangsan@example.com", "总公司/研发部", "dev", "common", ""},
+	}
+}
+
+// ParseUserImportTable 按表头解析导入的用户，忽略空行
+func ParseUserImportTable(rows [][]string) ([]*UserImportRow, error) {
+	if len(rows) == 0 {
+		return nil, i18n.WithID(errors.BadRequest("USER_IMPORT_INVALID", "导入内容为空"), "import.empty")
+	}
+	index := make(map[string]int, len(rows[0]))
+	for i, title := range rows[0] {
+		index[strings.TrimSpace(title)] = i
+	}
+	for _, title := range userImportRequired {
+		if _, ok := index[title]; !ok {
+			return nil, i18n.WithID(errors.BadRequest("USER_IMPORT_INVALID", "表头缺少列: "+title), "import.missingColumn", title)
+		}
+	}
+
+	list := make([]*UserImportRow, 0, len(rows)-1)
+	for n, row := range rows[1:] {
+		cell := func(title string) string {
+			if i, ok := index[title]; ok && i < len(row) {
+				return strings.TrimSpace(row[i])
+			}
+			return ""
+		}
+		if strings.TrimSpace(strings.Join(row, "")) == "" {
+			continue
+		}
+		list = append(list, &UserImportRow{
+			Line:     n + 2,
+			Username: cell("用户名"),
+			NickName: cell("昵称"),
+			Phone:    cell("手机号"),
+			Email:    cell("邮箱"),
+			Dept:     cell("部门"),
+			PostCode: cell("岗位编码"),
+			RoleKey:  cell("角色代码"),
+			Password: cell("密码"),
+		})
+	}
+	if len(list) == 0 {
+		return nil, i18n.WithID(errors.BadRequest("USER_IMPORT_INVALID", "导入内容为空"), "import.empty")
+	}
+	if len(list) > userImportMaxRows {
+		return nil, i18n.WithID(errors.BadRequest("USER_IMPORT_INVALID", "单次导入的用户数不能超过 "+strconv.Itoa(userImportMaxRows)), "user.importTooMany", strconv.Itoa(userImportMaxRows))
+	}
+	return list, nil
+}
+
+type SysUserImportUseCase struct {
+	userRepo   SysUserRepo
+	deptRepo   SysDeptRepo
+	postRepo   SysPostRepo
+	roleRepo   SysRoleRepo
+	casbinRepo CasbinRuleRepo
+	tx         Transaction
+	redis      RedisRepo
+	log        *log.Helper
+}
+
+func NewSysUserImportUseCase(userRepo SysUserRepo, deptRepo SysDeptRepo, postRepo SysPostRepo, roleRepo SysRoleRepo, casbinRepo CasbinRuleRepo, tx Transaction, redis RedisRepo, logger log.Logger) *SysUserImportUseCase {
+	return &SysUserImportUseCase{
+		userRepo:   userRepo,
+		deptRepo:   deptRepo,
+		postRepo:   postRepo,
+		roleRepo:   roleRepo,
+		casbinRepo: casbinRepo,
+		tx:         tx,
+		redis:      redis,
+		log:        log.NewHelper(log.With(logger, "module", "biz/userImport")),
+	}
+}
+
+// userImportRefs 导入时解析部门、岗位和角色用的索引
+type userImportRefs struct {
+	// deptByPath 完整路径 -> 部门 id，路径为各级部门名称以 / 连接
+	deptByPath map[string]int64
+	// deptByName 部门名称 -> 同名的部门 id
+	deptByName map[string][]int64
+	postByCode map[string]int64
+	roleByKey  map[string]int64
+	// roleKeyByID 角色 id -> 角色代码
+	roleKeyByID map[int64]string
+}
+
+func (uc *SysUserImportUseCase) loadRefs(ctx context.Context) (*userImportRefs, error) {
+	depts, err := uc.deptRepo.ListByNameStatusId(ctx, "", 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	posts, err := uc.postRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+	roles, err := uc.roleRepo.FindAll(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	refs := &userImportRefs{
+		deptByPath:  make(map[string]int64, len(depts)),
+		deptByName:  make(map[string][]int64, len(depts)),
+		postByCode:  make(map[string]int64, len(posts)),
+		roleByKey:   make(map[string]int64, len(roles)),
+		roleKeyByID: make(map[int64]string, len(roles)),
+	}
+	byID := make(map[int64]*model.SysDepts, len(depts))
+	for _, dept := range depts {
+		byID[dept.ID] = dept
+	}
+	for _, dept := range depts {
+		names := []string{dept.DeptName}
+		for pid, depth := dept.ParentID, 0; pid != 0 && depth < len(depts); depth++ {
+			parent, ok := byID[pid]
+			if !ok {
+				break
+			}
+			names = append([]string{parent.DeptName}, names...)
+			pid = parent.ParentID
+		}
+		refs.deptByPath[strings.Join(names, "/")] = dept.ID
+		refs.deptByName[dept.DeptName] = append(refs.deptByName[dept.DeptName], dept.ID)
+	}
+	for _, post := range posts {
+		refs.postByCode[post.PostCode] = post.ID
+	}
+	for _, role := range roles {
+		refs.roleByKey[role.RoleKey] = role.ID
+		refs.roleKeyByID[role.ID] = role.RoleKey
+	}
+	return refs, nil
+}
+
+// checkRole 导入的用户不能是超级管理员，超级管理员只能通过新增用户提交审批后创建；
+// 也不能拥有导入人所没有的权限，返回行的错误信息
+func (uc *SysUserImportUseCase) checkRole(claims *authz.TokenClaims, roleKey string, granted map[string]bool) (string, error) {
+	if roleKey == SuperAdminRoleKey {
+		return "不能导入超级管理员: " + roleKey, nil
+	}
+	holds, ok := granted[roleKey]
+	if !ok {
+		var err error
+		if holds, err = holdsPolicies(uc.casbinRepo, claims, roleKey); err != nil {
+			return "", err
+		}
+		granted[roleKey] = holds
+	}
+	if !holds {
+		return "不能授予权限高于自己的角色: " + roleKey, nil
+	}
+	return "", nil
+}
+
+// dept 按名称或完整路径查找部门，同名部门有多个时需要填写路径
+func (r *userImportRefs) dept(value string) (int64, string) {
+	if strings.Contains(value, "/") {
+		if id, ok := r.deptByPath[strings.Trim(value, "/ ")]; ok {
+			return id, ""
+		}
+		return 0, "部门不存在: " + value
+	}
+	ids := r.deptByName[value]
+	switch len(ids) {
+	case 0:
+		return 0, "部门不存在: " + value
+	case 1:
+		return ids[0], ""
+	default:
+		return 0, "部门名称重复，请填写部门路径: " + value
+	}
+}
+
+// lookup 按逗号分隔的编码查找 id，返回的 id 列表与编码顺序一致
+func lookup(value string, index map[string]int64, notFound string) ([]int64, []string) {
+	var ids []int64
+	var errs []string
+	for _, code := range strings.Split(value, ",") {
+		if code = strings.TrimSpace(code); code == "" {
+			continue
+		}
+		if id, ok := index[code]; ok {
+			ids = append(ids, id)
+		} else {
+			errs = append(errs, notFound+code)
+		}
+	}
+	return ids, errs
+}
+
+func joinIDs(ids []int64) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.FormatInt(id, 10)
+	}
+	return strings.Join(s, ",")
+}
+
+// randomPassword 生成符合 checkPassword 规则的随机密码
+func randomPassword() (string, error) {
+	b := make([]byte, userImportPasswordLen)
+	size := big.NewInt(int64(len(userImportPasswordChars)))
+	for {
+		for i := range b {
+			n, err := rand.Int(rand.Reader, size)
+			if err != nil {
+				return "", err
+			}
+			b[i] = userImportPasswordChars[n.Int64()]
+		}
+		if checkPassword(string(b)) == nil {
+			return string(b), nil
+		}
+	}
+}
+
+// ImportUsers 校验每一行并返回逐行的结果。dryRun 时不写入数据库；
+// 否则在同一事务中创建全部校验通过的用户，校验失败的行不影响其他行。
+// 随机生成的初始密码不在结果中返回，而是暂存后返回下载 token，由 TakePasswords 下载一次
+func (uc *SysUserImportUseCase) ImportUsers(ctx context.Context, rows []*UserImportRow, dryRun bool) ([]*UserImportResult, string, error) {
+	claims := authz.MustFromContext(ctx)
+	refs, err := uc.loadRefs(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	results := make([]*UserImportResult, len(rows))
+	users := make([]*model.SysUsers, len(rows))
+	// passwords 行号 -> 随机生成的初始密码
+	passwords := make(map[int]string)
+	lines := make(map[string]int, len(rows))
+	// granted 角色代码 -> 导入人是否拥有该角色的全部权限
+	granted := make(map[string]bool)
+	for i, row := range rows {
+		result := &UserImportResult{Line: row.Line, Username: row.Username}
+		results[i] = result
+
+		if line, ok := lines[row.Username]; ok && row.Username != "" {
+			result.Errors = append(result.Errors, "用户名与第 "+strconv.Itoa(line)+" 行重复")
+		} else {
+			lines[row.Username] = row.Line
+		}
+		if row.Username != "" {
+			_, err := uc.userRepo.FindByUsername(ctx, row.Username)
+			if err == nil {
+				result.Errors = append(result.Errors, "账号已存在")
+			} else if !errors.Is(err, gorm.ErrRecordNotFound) {
+				return nil, "", err
+			}
+		}
+
+		var deptID int64
+		if row.Dept == "" {
+			result.Errors = append(result.Errors, "部门不能为空")
+		} else if id, msg := refs.dept(row.Dept); msg != "" {
+			result.Errors = append(result.Errors, msg)
+		} else {
+			deptID = id
+		}
+		postIDs, errs := lookup(row.PostCode, refs.postByCode, "岗位不存在: ")
+		result.Errors = append(result.Errors, errs...)
+		if len(postIDs) == 0 && len(errs) == 0 {
+			result.Errors = append(result.Errors, "岗位编码不能为空")
+		}
+		roleIDs, errs := lookup(row.RoleKey, refs.roleByKey, "角色不存在: ")
+		result.Errors = append(result.Errors, errs...)
+		if len(roleIDs) == 0 && len(errs) == 0 {
+			result.Errors = append(result.Errors, "角色代码不能为空")
+		}
+		for _, roleID := range roleIDs {
+			if msg, err := uc.checkRole(claims, refs.roleKeyByID[roleID], granted); err != nil {
+				return nil, "", err
+			} else if msg != "" {
+				result.Errors = append(result.Errors, msg)
+			}
+		}
+
+		password := row.Password
+		if password == "" {
+			if password, err = randomPassword(); err != nil {
+				return nil, "", err
+			}
+		}
+		req := &pb.CreateSysUserRequest{
+			NickName: row.NickName,
+			Username: row.Username,
+			Password: password,
+			Phone:    row.Phone,
+			Email:    row.Email,
+			Sex:      userImportSex,
+			DeptId:   deptID,
+			Status:   userImportStatus,
+			PostIds:  joinIDs(postIDs),
+			RoleIds:  joinIDs(roleIDs),
+			Secret:   util.NewGoogleAuth().GetSecret(),
+		}
+		if err := req.ValidateAll(); err != nil {
+			result.Errors = append(result.Errors, validationMessages(err)...)
+		}
+		if len(result.Errors) > 0 {
+			continue
+		}
+		if row.Password == "" {
+			passwords[i] = password
+		}
+
+		now := time.Now()
+		users[i] = &model.SysUsers{
+			UUID:     uuid.NewString(),
+			Username: req.Username,
+			NickName: req.NickName,
+			Password: util.BcryptHash(req.Password),
+			Phone:    req.Phone,
+			Email:    req.Email,
+			Sex:      req.Sex,
+			DeptID:   req.DeptId,
+			PostID:   postIDs[0],
+			RoleID:   roleIDs[0],
+			PostIds:  req.PostIds,
+			RoleIds:  req.RoleIds,
+			Status:   req.Status,
+			Secret:   req.Secret,
+			// 随机生成的初始密码需要在首次登录时修改
+			MustChangePassword: row.Password == "",
+			CreateBy:           claims.Nickname,
+			UpdateBy:           claims.Nickname,
+			CreatedAt:          now,
+			UpdatedAt:          now,
+		}
+	}
+
+	if dryRun {
+		return results, "", nil
+	}
+	err = uc.tx.Transaction(ctx, func(ctx context.Context) error {
+		for _, user := range users {
+			if user == nil {
+				continue
+			}
+			if _, err := uc.userRepo.Create(ctx, user); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	table := [][]string{userImportPasswordsHeader}
+	for i, user := range users {
+		results[i].Created = user != nil
+		if password, ok := passwords[i]; ok {
+			table = append(table, []string{user.Username, password})
+		}
+	}
+	uc.log.WithContext(ctx).Infof("ImportUsers: %d rows by %s", len(rows), claims.Nickname)
+	if len(table) == 1 {
+		return results, "", nil
+	}
+	token, err := uc.savePasswords(ctx, claims.UserID, table)
+	if err != nil {
+		// 用户已创建，初始密码丢失时由管理员重置密码
+		uc.log.WithContext(ctx).Errorf("ImportUsers: save passwords error: %v", err)
+		return results, "", nil
+	}
+	return results, token, nil
+}
+
+// savePasswords 暂存随机生成的初始密码，只有导入人可以下载
+func (uc *SysUserImportUseCase) savePasswords(ctx context.Context, userID int64, table [][]string) (string, error) {
+	content, err := json.Marshal(table)
+	if err != nil {
+		return "", err
+	}
+	token := uuid.NewString()
+	key := userImportPasswordsKeyPrefix + strconv.FormatInt(userID, 10) + ":" + token
+	if err = uc.redis.Set(ctx, key, string(content), userImportPasswordsExpire); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// TakePasswords 取出导入时随机生成的初始密码，含表头；取出后即删除，只能下载一次
+func (uc *SysUserImportUseCase) TakePasswords(ctx context.Context, token string) ([][]string, error) {
+	claims := authz.MustFromContext(ctx)
+	key := userImportPasswordsKeyPrefix + strconv.FormatInt(claims.UserID, 10) + ":" + token
+	content, err := uc.redis.GetDel(ctx, key)
+	if err != nil {
+		return nil, err
+	}
+	if content == "" {
+		return nil, i18n.WithID(errors.NotFound("USER_IMPORT_PASSWORDS_NOT_FOUND", "初始密码已下载或已过期"), "user.importPasswordsNotFound")
+	}
+	var table [][]string
+	if err = json.Unmarshal([]byte(content), &table); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+// validationMessages 展开 proto 校验错误，每个字段一条
+func validationMessages(err error) []string {
+	if multi, ok := err.(interface{ AllErrors() []error }); ok {
+		msgs := make([]string, 0, len(multi.AllErrors()))
+		for _, e := range multi.AllErrors() {
+			msgs = append(msgs, e.Error())
+		}
+		return msgs
+	}
+	return []string{err.Error()}
+}
@@ -0,0 +1,153 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+	"github.com/swordkee/kratos-vue-admin/pkg/util"
+)
+
+// errResetOwnPassword、errRevokeOwnSessions 管理员对自己执行账号安全操作时的错误
+var (
+	errResetOwnPassword  = i18n.WithID(errors.BadRequest("USER_SECURITY_FORBIDDEN", "不能重置自己的密码，请使用修改密码"), "user.resetOwnPassword")
+	errRevokeOwnSessions = i18n.WithID(errors.BadRequest("USER_SECURITY_FORBIDDEN", "不能撤销自己的登录，请使用退出登录"), "user.revokeOwnSessions")
+)
+
+// 账号安全事件
+const (
+	UserEventPasswordReset   = "password_reset"
+	UserEventSessionsRevoked = "sessions_revoked"
+)
+
+// UserSecurityEvent 通知用户的账号安全事件，不包含密码
+type UserSecurityEvent struct {
+	Event    string    `json:"event"`
+	UserID   int64     `json:"userId"`
+	Username string    `json:"username"`
+	NickName string    `json:"nickName"`
+	Email    string    `json:"email"`
+	Phone    string    `json:"phone"`
+	Operator string    `json:"operator"`
+	Time     time.Time `json:"time"`
+}
+
+// UserNotifier 向用户发送账号安全事件的通知
+type UserNotifier interface {
+	Notify(ctx context.Context, event *UserSecurityEvent) error
+}
+
+// ResetPassword 管理员重置用户密码，password 为空时随机生成并返回。
+// 重置后用户下次登录必须修改密码，此前签发的 token 全部失效
+func (receiver *AuthUseCase) ResetPassword(ctx context.Context, userID int64, password string) (generated string, err error) {
+	claims, user, err := receiver.operator(ctx, userID, errResetOwnPassword)
+	if err != nil {
+		return "", err
+	}
+	if password == "" {
+		if password, err = randomPassword(); err != nil {
+			return "", err
+		}
+		generated = password
+	} else if err = checkPassword(password); err != nil {
+		return "", err
+	}
+	if err = receiver.userRepo.ResetPassword(ctx, userID, util.BcryptHash(password), claims.Nickname, time.Now().Unix()); err != nil {
+		return "", err
+	}
+	receiver.tokenState.Invalidate(ctx, userID)
+	receiver.log.WithContext(ctx).Infof("user %d reset password of user %d", claims.UserID, userID)
+	receiver.notify(ctx, UserEventPasswordReset, user, claims)
+	return generated, nil
+}
+
+// RevokeSessions 使用户此前签发的全部 token 失效，用户需要重新登录
+func (receiver *AuthUseCase) RevokeSessions(ctx context.Context, userID int64) error {
+	claims, user, err := receiver.operator(ctx, userID, errRevokeOwnSessions)
+	if err != nil {
+		return err
+	}
+	if err = receiver.userRepo.RevokeTokens(ctx, userID, time.Now().Unix()); err != nil {
+		return err
+	}
+	receiver.tokenState.Invalidate(ctx, userID)
+	receiver.log.WithContext(ctx).Infof("user %d revoked sessions of user %d", claims.UserID, userID)
+	receiver.notify(ctx, UserEventSessionsRevoked, user, claims)
+	return nil
+}
+
+// operator 校验管理操作的执行人和目标用户：不能在模拟登录期间操作，也不能对自己操作，
+// 对自己操作时返回 selfErr；与模拟登录相同，不能操作权限高于自己的用户
+func (receiver *AuthUseCase) operator(ctx context.Context, userID int64, selfErr *errors.Error) (*authz.TokenClaims, *model.SysUsers, error) {
+	claims, err := authz.FromContext(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+	if claims.IsImpersonated() {
+		return nil, nil, i18n.WithID(errors.Forbidden("IMPERSONATE_FORBIDDEN", "模拟登录期间不能管理其他用户的账号安全"), "impersonate.userSecurity")
+	}
+	if claims.UserID == userID {
+		return nil, nil, selfErr
+	}
+	user, err := receiver.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, nil, i18n.WithID(pb.ErrorUserNotFound("用户不存在"), "user.notFound")
+	}
+	if err = receiver.checkManageTarget(ctx, claims, user); err != nil {
+		return nil, nil, err
+	}
+	return claims, user, nil
+}
+
+// checkManageTarget 只有超级管理员可以管理超级管理员的账号安全；其他操作人必须拥有
+// 目标用户全部角色（包括多角色）和临时授权的权限
+func (receiver *AuthUseCase) checkManageTarget(ctx context.Context, claims *authz.TokenClaims, user *model.SysUsers) error {
+	roleIDs := util.Split2Int64Slice(user.RoleIds)
+	if user.RoleID > 0 {
+		roleIDs = append(roleIDs, user.RoleID)
+	}
+	var roles []*model.SysRoles
+	if len(roleIDs) > 0 {
+		var err error
+		if roles, err = receiver.roleRepo.FindByIDList(ctx, roleIDs...); err != nil {
+			return err
+		}
+	}
+	subjects := []string{authz.UserSubject(user.ID)}
+	for _, role := range roles {
+		if role.RoleKey == SuperAdminRoleKey && claims.RoleKey != SuperAdminRoleKey {
+			return i18n.WithID(errors.Forbidden("USER_SECURITY_FORBIDDEN", "不能管理超级管理员的账号安全"), "user.securitySuperAdmin")
+		}
+		subjects = append(subjects, role.RoleKey)
+	}
+	holds, err := holdsPolicies(receiver.casbinRepo, claims, subjects...)
+	if err != nil {
+		return err
+	}
+	if !holds {
+		return i18n.WithID(errors.Forbidden("USER_SECURITY_FORBIDDEN", "不能管理权限高于自己的用户的账号安全"), "user.securityHigherPermission")
+	}
+	return nil
+}
+
+// notify 通知失败只记录日志，不影响已完成的操作
+func (receiver *AuthUseCase) notify(ctx context.Context, event string, user *model.SysUsers, claims *authz.TokenClaims) {
+	err := receiver.notifier.Notify(ctx, &UserSecurityEvent{
+		Event:    event,
+		UserID:   user.ID,
+		Username: user.Username,
+		NickName: user.NickName,
+		Email:    user.Email,
+		Phone:    user.Phone,
+		Operator: claims.Nickname,
+		Time:     time.Now(),
+	})
+	if err != nil {
+		receiver.log.WithContext(ctx).Warnf("notify %s to user %d failed: %v", event, user.ID, err)
+	}
+}
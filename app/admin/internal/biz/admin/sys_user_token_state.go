@@ -0,0 +1,75 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+)
+
+// 用户 token 状态缓存，每次请求鉴权时读取；重置密码、撤销登录和修改密码后删除
+const (
+	userTokenStateKeyPrefix = "kva:user:token_state:"
+	userTokenStateExpire    = 10 * time.Minute
+)
+
+// UserTokenState 鉴权时校验的用户 token 状态
+type UserTokenState struct {
+	// TokenRevokedAt 该时间（unix 秒）及之前签发的 token 失效
+	TokenRevokedAt int64 `json:"tokenRevokedAt"`
+	// MustChangePassword 修改密码之前只允许调用修改密码等接口
+	MustChangePassword bool `json:"mustChangePassword"`
+}
+
+// UserTokenStateUseCase 用户 token 状态的读穿透缓存，Redis 不可用时直接读库
+type UserTokenStateUseCase struct {
+	userRepo SysUserRepo
+	redis    RedisRepo
+	log      *log.Helper
+}
+
+func NewUserTokenStateUseCase(userRepo SysUserRepo, redis RedisRepo, logger log.Logger) *UserTokenStateUseCase {
+	return &UserTokenStateUseCase{
+		userRepo: userRepo,
+		redis:    redis,
+		log:      log.NewHelper(log.With(logger, "module", "biz/userTokenState")),
+	}
+}
+
+// Load 先读缓存，未命中时读库并写回缓存
+func (uc *UserTokenStateUseCase) Load(ctx context.Context, userID int64) (*UserTokenState, error) {
+	key := userTokenStateKey(userID)
+	if cached := uc.redis.Get(ctx, key); cached != "" {
+		var state UserTokenState
+		if err := json.Unmarshal([]byte(cached), &state); err == nil {
+			return &state, nil
+		}
+	}
+	user, err := uc.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	state := &UserTokenState{
+		TokenRevokedAt:     user.TokenRevokedAt,
+		MustChangePassword: user.MustChangePassword,
+	}
+	if payload, err := json.Marshal(state); err == nil {
+		if err = uc.redis.Set(ctx, key, string(payload), userTokenStateExpire); err != nil {
+			uc.log.Warnf("写入用户 token 状态缓存失败, userID: %d, err: %v", userID, err)
+		}
+	}
+	return state, nil
+}
+
+// Invalidate 删除用户 token 状态的缓存，在状态写库之后调用
+func (uc *UserTokenStateUseCase) Invalidate(ctx context.Context, userID int64) {
+	if err := uc.redis.Del(ctx, userTokenStateKey(userID)); err != nil {
+		uc.log.Errorf("删除用户 token 状态缓存失败, userID: %d, err: %v", userID, err)
+	}
+}
+
+func userTokenStateKey(userID int64) string {
+	return userTokenStateKeyPrefix + strconv.FormatInt(userID, 10)
+}
@@ -2,7 +2,6 @@ package biz
 
 import (
 	"context"
-	"time"
 
 	"github.com/google/wire"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
@@ -12,6 +11,7 @@ import (
 // ProviderSet is biz providers.
 var ProviderSet = wire.NewSet(
 	admin.NewSysUserUseCase,
+	admin.NewUserTokenStateUseCase,
 	admin.NewAuthUseCase,
 	admin.NewSysMenusUseCase,
 	admin.NewSysDeptUseCase,
@@ -23,23 +23,28 @@ var ProviderSet = wire.NewSet(
 	admin.NewSysDictDatumUseCase,
 	admin.NewSysDictTypeUseCase,
 	admin.NewSysLogsUseCase,
+	admin.NewSysLogsWriter,
+	admin.NewSysLogsStream,
+	admin.NewSysTempGrantUseCase,
+	admin.NewSysChangeRequestUseCase,
+	admin.NewSysExportUseCase,
+	admin.NewSysLoginLogUseCase,
+	admin.NewSysTranslationUseCase,
+	admin.NewSysRecycleUseCase,
+	admin.NewSysUserImportUseCase,
 )
 
 // Transaction 事务接口类型别名（指向 admin.Transaction 以避免循环导入）
 type Transaction = admin.Transaction
 
-type RedisRepo interface {
-	SetHashKey(context.Context, string, string, interface{}) error
-	QueryHashKey(context.Context, string, string) (string, error)
-	DelHashKey(ctx context.Context, key string, field string) error
-	QueryHashLen(ctx context.Context, key string) error
-	Lock(context.Context, string, interface{}, time.Duration) (bool, error)
-	IncrHashKey(context.Context, string, string, int64) error
-	QueryHashAllKeyAndVal(ctx context.Context, key string) (map[string]string, error)
-	Set(ctx context.Context, key string, value string, expire time.Duration) error
-	Get(ctx context.Context, key string) string
-	SRem(ctx context.Context, key string, members ...interface{}) (int64, error)
-}
+// RedisRepo Redis 接口类型别名（指向 admin.RedisRepo 以避免循环导入）
+type RedisRepo = admin.RedisRepo
+
+// DeleteOptions 删除被引用数据时的处理方式
+type DeleteOptions = admin.DeleteOptions
+
+// RoleDeptChange 移动部门后角色数据权限的变化
+type RoleDeptChange = admin.RoleDeptChange
 
 type OssRepo interface {
 	UploadFile(file interface{}, filePath string) (string, error)
@@ -57,10 +62,19 @@ type SysUserRepo interface {
 	Save(ctx context.Context, user *model.SysUsers) (*model.SysUsers, error)
 	Delete(ctx context.Context, id int64) error
 	UpdateByID(ctx context.Context, id int64, user *model.SysUsers) error
+	UpdateLocale(ctx context.Context, id int64, locale string) error
+	// ResetPassword 重置密码并标记下次登录必须修改，同时使此前签发的 token 失效
+	ResetPassword(ctx context.Context, id int64, password, updateBy string, revokedAt int64) error
+	// RevokeTokens 使 revokedAt（unix 秒）及之前签发的 token 失效
+	RevokeTokens(ctx context.Context, id int64, revokedAt int64) error
 	Create(ctx context.Context, g *model.SysUsers) (*model.SysUsers, error)
 	FindByID(ctx context.Context, id int64) (*model.SysUsers, error)
 	FindByUsername(ctx context.Context, username string) (*model.SysUsers, error)
 	FindByPostId(ctx context.Context, postId int64) ([]*model.SysUsers, error)
+	FindByDeptIds(ctx context.Context, deptIds ...int64) ([]*model.SysUsers, error)
+	FindByPostIds(ctx context.Context, postIds ...int64) ([]*model.SysUsers, error)
+	FindByRoleIds(ctx context.Context, roleIds ...int64) ([]*model.SysUsers, error)
+	UpdateReferences(ctx context.Context, user *model.SysUsers) error
 	ListPage(ctx context.Context, page, size int32, condition UserListCondition) ([]*model.SysUsers, error)
 	Count(ctx context.Context, condition UserListCondition) (int32, error)
 	CountByRoleId(ctx context.Context, roleId int64) (int64, error)
@@ -77,6 +91,7 @@ type SysRoleRepo interface {
 	ListPage(ctx context.Context, name, key string, status int32, page, size int32) ([]*model.SysRoles, error)
 	Count(ctx context.Context, name, key string, status int32) (int32, error)
 	Update(ctx context.Context, role *model.SysRoles) error
+	DeleteRoleDepts(ctx context.Context, roleIds ...int64) error
 }
 
 type SysMenuRepo interface {
@@ -153,7 +168,17 @@ type SysDictDatumUseCase = admin.SysDictDatumUseCase
 type SysDictTypeUseCase = admin.SysDictTypeUseCase
 type SysRoleMenuUseCase = admin.SysRoleMenuUseCase
 type SysLogsUseCase = admin.SysLogsUseCase
+type SysLogsWriter = admin.SysLogsWriter
+type SysLogsStream = admin.SysLogsStream
+type SysTempGrantUseCase = admin.SysTempGrantUseCase
+type SysChangeRequestUseCase = admin.SysChangeRequestUseCase
+type SysExportUseCase = admin.SysExportUseCase
+type SysLoginLogUseCase = admin.SysLoginLogUseCase
+type SysTranslationUseCase = admin.SysTranslationUseCase
+type SysRecycleUseCase = admin.SysRecycleUseCase
+type SysUserImportUseCase = admin.SysUserImportUseCase
 
 // 函数别名
 var ConvertToDeptTree = admin.ConvertToDeptTree
 var ConvertToDeptTreeChildren = admin.ConvertToDeptTreeChildren
+var WithChangeRecorder = admin.WithChangeRecorder
@@ -2,14 +2,15 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"sync"
 
 	"github.com/casbin/casbin/v3"
 	"github.com/casbin/casbin/v3/model"
-	"github.com/casbin/casbin/v3/persist"
 	gormadapter "github.com/casbin/gorm-adapter/v3"
 	"github.com/go-kratos/kratos/v2/log"
+	go_redis "github.com/redis/go-redis/v9"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/dao"
 	"gorm.io/gorm"
@@ -19,6 +20,7 @@ type casbinRuleRepo struct {
 	query          *dao.Query
 	log            *log.Helper
 	syncedEnforcer *casbin.SyncedCachedEnforcer
+	watcher        *casbinWatcher
 }
 
 // 内置的 Casbin 模型配置（使用 server 目录方案）
@@ -36,15 +38,19 @@ g = _, _
 e = some(where (p.eft == allow))
 
 [matchers]
-m = r.sub == p.sub && keyMatch2(r.obj, p.obj) && r.act == p.act
+m = g(r.sub, p.sub) && keyMatch2(r.obj, p.obj) && r.act == p.act
 `
 
 var (
 	syncedCachedEnforcer *casbin.SyncedCachedEnforcer
+	casbinPolicyWatcher  *casbinWatcher
 	once                 sync.Once
 )
 
-func NewCasbinRuleRepo(db *gorm.DB, logger log.Logger) admin.CasbinRuleRepo {
+// NewCasbinRuleRepo 创建进程内唯一的权限引擎，鉴权中间件与权限管理共用，
+// 策略变更通过 Redis 发布订阅同步到其他实例，退出时取消订阅
+func NewCasbinRuleRepo(db *gorm.DB, rdb go_redis.UniversalClient, logger log.Logger) (admin.CasbinRuleRepo, func(), error) {
+	helper := log.NewHelper(logger)
 	once.Do(func() {
 		adapter, err := gormadapter.NewAdapterByDB(db)
 		if err != nil {
@@ -63,12 +69,39 @@ func NewCasbinRuleRepo(db *gorm.DB, logger log.Logger) admin.CasbinRuleRepo {
 		}
 		syncedCachedEnforcer.SetExpireTime(60 * 60)
 		_ = syncedCachedEnforcer.LoadPolicy()
+
+		casbinPolicyWatcher = newCasbinWatcher(rdb, helper)
+		if err = syncedCachedEnforcer.SetWatcher(casbinPolicyWatcher); err != nil {
+			panic("设置权限变更监听失败:\n" + err.Error())
+		}
+		_ = casbinPolicyWatcher.SetUpdateCallback(func(payload string) {
+			applyCasbinMessage(syncedCachedEnforcer, payload, helper)
+		})
 	})
 
-	return &casbinRuleRepo{
+	repo := &casbinRuleRepo{
 		query:          dao.Use(db),
-		log:            log.NewHelper(logger),
+		log:            helper,
 		syncedEnforcer: syncedCachedEnforcer,
+		watcher:        casbinPolicyWatcher,
+	}
+	return repo, repo.watcher.Close, nil
+}
+
+// applyCasbinMessage 其他实例已将变更写入数据库，本实例只从数据库重新加载策略，
+// 不能使用 SelfAddPolicies 等方法，开启自动保存时它们仍会通过适配器重复写入数据库
+func applyCasbinMessage(e *casbin.SyncedCachedEnforcer, payload string, logger *log.Helper) {
+	var m casbinMessage
+	if err := json.Unmarshal([]byte(payload), &m); err != nil {
+		logger.Errorf("解析权限变更消息失败: %v", err)
+		return
+	}
+	err := e.LoadPolicy()
+	if err == nil {
+		err = e.InvalidateCache()
+	}
+	if err != nil {
+		logger.Errorf("同步权限变更失败, method: %s, err: %v", m.Method, err)
 	}
 }
 
@@ -86,8 +119,8 @@ func (c *casbinRuleRepo) UpdateCasbin(ctx context.Context, roleKey string, rules
 	if !success {
 		return fmt.Errorf("存在相同api,添加失败,请联系管理员: %w", fmt.Errorf("api policy already exists"))
 	}
-	_ = c.syncedEnforcer.SavePolicy()
-	return nil
+	// 自动保存已将变更写入数据库，不再 SavePolicy 整表重写，避免覆盖其他实例并发写入的策略
+	return c.syncedEnforcer.InvalidateCache()
 }
 
 // UpdateCasbinApi 更新 API 路径
@@ -97,7 +130,14 @@ func (c *casbinRuleRepo) UpdateCasbinApi(ctx context.Context, oldPath string, ne
 		"v1": newPath,
 		"v2": newMethod,
 	})
-	return err
+	if err != nil {
+		return err
+	}
+	// 直接修改了数据库，需要重新加载本实例并通知其他实例
+	if err = c.syncedEnforcer.LoadPolicy(); err != nil {
+		return err
+	}
+	return c.watcher.Update()
 }
 
 // GetPolicyPathByRoleId 获取角色权限路径
@@ -106,18 +146,60 @@ func (c *casbinRuleRepo) GetPolicyPathByRoleId(roleKey string) [][]string {
 	return e
 }
 
-// ClearCasbin 清除权限
-func (c *casbinRuleRepo) ClearCasbin(v int, p ...string) error {
-	_, err := c.syncedEnforcer.RemoveFilteredPolicy(v, p...)
-	return err
+// Enforce 校验权限，供鉴权中间件使用
+func (c *casbinRuleRepo) Enforce(sub, obj, act string) (bool, error) {
+	return c.syncedEnforcer.Enforce(sub, obj, act)
+}
+
+// EnforceEx 使用与鉴权中间件相同的引擎校验权限，并返回命中的策略
+func (c *casbinRuleRepo) EnforceEx(sub, obj, act string) (bool, []string, error) {
+	return c.syncedEnforcer.EnforceEx(sub, obj, act)
+}
+
+// GetImplicitPolicy 获取角色继承后的有效策略
+func (c *casbinRuleRepo) GetImplicitPolicy(roleKey string) ([][]string, error) {
+	return c.syncedEnforcer.GetImplicitPermissionsForUser(roleKey)
+}
+
+// GetImplicitRoles 获取角色继承的全部角色
+func (c *casbinRuleRepo) GetImplicitRoles(roleKey string) ([]string, error) {
+	return c.syncedEnforcer.GetImplicitRolesForUser(roleKey)
+}
+
+// GetPolicyByApi 获取引用指定 api 的策略
+func (c *casbinRuleRepo) GetPolicyByApi(path, method string) ([][]string, error) {
+	return c.syncedEnforcer.GetFilteredPolicy(1, path, method)
 }
 
-// GetModel 获取 Casbin 模型
-func (c *casbinRuleRepo) GetModel() model.Model {
-	return c.syncedEnforcer.GetModel()
+// ReplaceSubjectPolicy 替换主体的全部策略和角色继承
+func (c *casbinRuleRepo) ReplaceSubjectPolicy(subject string, roles []string, rules [][]string) error {
+	if _, err := c.syncedEnforcer.RemoveFilteredPolicy(0, subject); err != nil {
+		return err
+	}
+	if _, err := c.syncedEnforcer.RemoveFilteredGroupingPolicy(0, subject); err != nil {
+		return err
+	}
+	if len(rules) > 0 {
+		if _, err := c.syncedEnforcer.AddPolicies(rules); err != nil {
+			return err
+		}
+	}
+	if len(roles) > 0 {
+		groupings := make([][]string, 0, len(roles))
+		for _, role := range roles {
+			groupings = append(groupings, []string{subject, role})
+		}
+		if _, err := c.syncedEnforcer.AddGroupingPolicies(groupings); err != nil {
+			return err
+		}
+	}
+	return c.syncedEnforcer.InvalidateCache()
 }
 
-// GetAdapter 获取 Casbin 适配器
-func (c *casbinRuleRepo) GetAdapter() persist.Adapter {
-	return c.syncedEnforcer.GetAdapter()
+// ClearCasbin 清除权限
+func (c *casbinRuleRepo) ClearCasbin(v int, p ...string) error {
+	if _, err := c.syncedEnforcer.RemoveFilteredPolicy(v, p...); err != nil {
+		return err
+	}
+	return c.syncedEnforcer.InvalidateCache()
 }
@@ -0,0 +1,132 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/casbin/casbin/v3/model"
+	"github.com/casbin/casbin/v3/persist"
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/google/uuid"
+	go_redis "github.com/redis/go-redis/v9"
+)
+
+// casbinWatcherChannel 策略变更的发布订阅频道
+const casbinWatcherChannel = "kva:casbin:policy"
+
+// 策略变更类型
+const (
+	casbinUpdate                  = "Update"
+	casbinUpdateForAddPolicy      = "UpdateForAddPolicy"
+	casbinUpdateForRemovePolicy   = "UpdateForRemovePolicy"
+	casbinUpdateForRemoveFiltered = "UpdateForRemoveFilteredPolicy"
+	casbinUpdateForSavePolicy     = "UpdateForSavePolicy"
+	casbinUpdateForAddPolicies    = "UpdateForAddPolicies"
+	casbinUpdateForRemovePolicies = "UpdateForRemovePolicies"
+)
+
+// casbinMessage 策略变更消息
+type casbinMessage struct {
+	ID          string     `json:"id"`
+	Method      string     `json:"method"`
+	Sec         string     `json:"sec,omitempty"`
+	Ptype       string     `json:"ptype,omitempty"`
+	FieldIndex  int        `json:"fieldIndex,omitempty"`
+	FieldValues []string   `json:"fieldValues,omitempty"`
+	Rules       [][]string `json:"rules,omitempty"`
+}
+
+// casbinWatcher 基于 Redis 发布订阅的策略变更通知，实现 persist.WatcherEx
+type casbinWatcher struct {
+	id       string
+	rdb      go_redis.UniversalClient
+	pubsub   *go_redis.PubSub
+	mu       sync.RWMutex
+	callback func(string)
+	log      *log.Helper
+}
+
+var _ persist.WatcherEx = (*casbinWatcher)(nil)
+
+func newCasbinWatcher(rdb go_redis.UniversalClient, logger *log.Helper) *casbinWatcher {
+	w := &casbinWatcher{
+		id:  uuid.NewString(),
+		rdb: rdb,
+		log: logger,
+	}
+	w.pubsub = rdb.Subscribe(context.Background(), casbinWatcherChannel)
+	go w.subscribe()
+	return w
+}
+
+// subscribe 接收其他实例发布的变更消息，忽略本实例自己发布的消息
+func (w *casbinWatcher) subscribe() {
+	for msg := range w.pubsub.Channel() {
+		var m casbinMessage
+		if err := json.Unmarshal([]byte(msg.Payload), &m); err != nil {
+			w.log.Errorf("解析权限变更消息失败: %v", err)
+			continue
+		}
+		if m.ID == w.id {
+			continue
+		}
+		w.mu.RLock()
+		callback := w.callback
+		w.mu.RUnlock()
+		if callback != nil {
+			callback(msg.Payload)
+		}
+	}
+}
+
+func (w *casbinWatcher) publish(m *casbinMessage) error {
+	m.ID = w.id
+	payload, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return w.rdb.Publish(context.Background(), casbinWatcherChannel, payload).Err()
+}
+
+// SetUpdateCallback 设置收到变更消息后的回调
+func (w *casbinWatcher) SetUpdateCallback(callback func(string)) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	w.callback = callback
+	return nil
+}
+
+// Update 通知其他实例重新加载全部策略
+func (w *casbinWatcher) Update() error {
+	return w.publish(&casbinMessage{Method: casbinUpdate})
+}
+
+// Close 取消订阅
+func (w *casbinWatcher) Close() {
+	_ = w.pubsub.Close()
+}
+
+func (w *casbinWatcher) UpdateForAddPolicy(sec, ptype string, params ...string) error {
+	return w.publish(&casbinMessage{Method: casbinUpdateForAddPolicy, Sec: sec, Ptype: ptype, Rules: [][]string{params}})
+}
+
+func (w *casbinWatcher) UpdateForRemovePolicy(sec, ptype string, params ...string) error {
+	return w.publish(&casbinMessage{Method: casbinUpdateForRemovePolicy, Sec: sec, Ptype: ptype, Rules: [][]string{params}})
+}
+
+func (w *casbinWatcher) UpdateForRemoveFilteredPolicy(sec, ptype string, fieldIndex int, fieldValues ...string) error {
+	return w.publish(&casbinMessage{Method: casbinUpdateForRemoveFiltered, Sec: sec, Ptype: ptype, FieldIndex: fieldIndex, FieldValues: fieldValues})
+}
+
+func (w *casbinWatcher) UpdateForSavePolicy(model.Model) error {
+	return w.publish(&casbinMessage{Method: casbinUpdateForSavePolicy})
+}
+
+func (w *casbinWatcher) UpdateForAddPolicies(sec string, ptype string, rules ...[]string) error {
+	return w.publish(&casbinMessage{Method: casbinUpdateForAddPolicies, Sec: sec, Ptype: ptype, Rules: rules})
+}
+
+func (w *casbinWatcher) UpdateForRemovePolicies(sec string, ptype string, rules ...[]string) error {
+	return w.publish(&casbinMessage{Method: casbinUpdateForRemovePolicies, Sec: sec, Ptype: ptype, Rules: rules})
+}
@@ -22,28 +22,28 @@ func NewSysDictTypeRepo(query *dao.Query, logger log.Logger) admin.SysDictTypeRe
 }
 
 func (p *sysDictTypeRepo) Create(ctx context.Context, post *model.SysDictTypes) error {
-	q := p.query.SysDictTypes
+	q := QueryFrom(ctx, p.query).SysDictTypes
 	return q.WithContext(ctx).Create(post)
 }
 
 func (p *sysDictTypeRepo) Save(ctx context.Context, post *model.SysDictTypes) error {
-	q := p.query.SysDictTypes
+	q := QueryFrom(ctx, p.query).SysDictTypes
 	return q.WithContext(ctx).Save(post)
 }
 
 func (p *sysDictTypeRepo) Delete(ctx context.Context, ids []int64) error {
-	q := p.query.SysDictTypes
+	q := QueryFrom(ctx, p.query).SysDictTypes
 	_, err := q.WithContext(ctx).Where(q.DictID.In(ids...)).Delete()
 	return err
 }
 
 func (p *sysDictTypeRepo) FindByID(ctx context.Context, id int64) (*model.SysDictTypes, error) {
-	q := p.query.SysDictTypes
+	q := QueryFrom(ctx, p.query).SysDictTypes
 	return q.WithContext(ctx).Where(q.DictID.Eq(id)).First()
 }
 
 func (p *sysDictTypeRepo) ListPage(ctx context.Context, dictName, dictType string, status int32, page, size int32) ([]*model.SysDictTypes, error) {
-	q := p.query.SysDictTypes
+	q := QueryFrom(ctx, p.query).SysDictTypes
 	db := q.WithContext(ctx)
 	if dictName != "" {
 		db = db.Where(q.DictName.Like(buildLikeValue(dictName)))
@@ -59,7 +59,7 @@ func (p *sysDictTypeRepo) ListPage(ctx context.Context, dictName, dictType strin
 }
 
 func (p *sysDictTypeRepo) ListPageCount(ctx context.Context, dictName, dictType string, status int32) (int32, error) {
-	q := p.query.SysDictTypes
+	q := QueryFrom(ctx, p.query).SysDictTypes
 	db := q.WithContext(ctx)
 	if dictName != "" {
 		db = db.Where(q.DictName.Like(buildLikeValue(dictName)))
@@ -75,11 +75,11 @@ func (p *sysDictTypeRepo) ListPageCount(ctx context.Context, dictName, dictType
 }
 
 func (p *sysDictTypeRepo) FindByIDList(ctx context.Context, ids ...int64) ([]*model.SysDictTypes, error) {
-	q := p.query.SysDictTypes
+	q := QueryFrom(ctx, p.query).SysDictTypes
 	return q.WithContext(ctx).Where(q.DictID.In(ids...)).Find()
 }
 
 func (p *sysDictTypeRepo) FindAll(ctx context.Context) ([]*model.SysDictTypes, error) {
-	q := p.query.SysDictTypes
+	q := QueryFrom(ctx, p.query).SysDictTypes
 	return q.WithContext(ctx).Find()
 }
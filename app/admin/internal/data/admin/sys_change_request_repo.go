@@ -0,0 +1,78 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/dao"
+
+	admin "github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+type sysChangeRequestRepo struct {
+	query *dao.Query
+	log   *log.Helper
+}
+
+func NewSysChangeRequestRepo(query *dao.Query, logger log.Logger) admin.SysChangeRequestRepo {
+	return &sysChangeRequestRepo{
+		query: query,
+		log:   log.NewHelper(logger),
+	}
+}
+
+func (r *sysChangeRequestRepo) Create(ctx context.Context, cr *model.SysChangeRequests) error {
+	q := r.query.SysChangeRequests
+	// 审批时间在审批前为空
+	return q.WithContext(ctx).Omit(q.ReviewedAt).Create(cr)
+}
+
+func (r *sysChangeRequestRepo) FindByID(ctx context.Context, id int64) (*model.SysChangeRequests, error) {
+	q := r.query.SysChangeRequests
+	return q.WithContext(ctx).Where(q.ID.Eq(id)).First()
+}
+
+func (r *sysChangeRequestRepo) FindExpired(ctx context.Context, now time.Time) ([]*model.SysChangeRequests, error) {
+	q := r.query.SysChangeRequests
+	return q.WithContext(ctx).Where(q.Status.Eq(admin.ChangeRequestStatusPending), q.ExpireAt.Lte(now)).Find()
+}
+
+func (r *sysChangeRequestRepo) UpdateStatus(ctx context.Context, cr *model.SysChangeRequests, from int32) (bool, error) {
+	q := r.query.SysChangeRequests
+	info, err := q.WithContext(ctx).
+		Select(q.Status, q.ReviewUserID, q.ReviewBy, q.ReviewComment, q.ReviewedAt, q.ErrorMessage, q.UpdatedAt).
+		Where(q.ID.Eq(cr.ID), q.Status.Eq(from)).
+		Updates(cr)
+	if err != nil {
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
+
+func (r *sysChangeRequestRepo) ListPage(ctx context.Context, operation string, status int32, page, size int32) ([]*model.SysChangeRequests, error) {
+	q := r.query.SysChangeRequests
+	db := q.WithContext(ctx)
+	if operation != "" {
+		db = db.Where(q.Operation.Eq(operation))
+	}
+	if status != 0 {
+		db = db.Where(q.Status.Eq(status))
+	}
+	limit, offset := convertPageSize(page, size)
+	return db.Order(q.ID.Desc()).Limit(limit).Offset(offset).Find()
+}
+
+func (r *sysChangeRequestRepo) Count(ctx context.Context, operation string, status int32) (int32, error) {
+	q := r.query.SysChangeRequests
+	db := q.WithContext(ctx)
+	if operation != "" {
+		db = db.Where(q.Operation.Eq(operation))
+	}
+	if status != 0 {
+		db = db.Where(q.Status.Eq(status))
+	}
+	count, err := db.Count()
+	return int32(count), err
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+
 	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
 	admin "github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/dao"
@@ -42,7 +44,7 @@ func (d *sysDeptRepo) UpdateByID(ctx context.Context, id int64, dept *model.SysD
 	if id == 0 {
 		return fmt.Errorf("dept can not update without id: %w", fmt.Errorf("invalid id"))
 	}
-	q := d.query.SysDepts
+	q := QueryFrom(ctx, d.query).SysDepts
 	dataMap := make(map[string]interface{})
 	dataMap["parent_id"] = dept.ParentID
 	dataMap["dept_name"] = dept.DeptName
@@ -55,15 +57,20 @@ func (d *sysDeptRepo) UpdateByID(ctx context.Context, id int64, dept *model.SysD
 	return err
 }
 
-func (d *sysDeptRepo) Delete(ctx context.Context, id int64) error {
-	q := d.query.SysDepts
+func (d *sysDeptRepo) Delete(ctx context.Context, id int64, deleteBy string) error {
+	q := QueryFrom(ctx, d.query).SysDepts
+	if deleteBy != "" {
+		if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Update(q.UpdateBy, deleteBy); err != nil {
+			return err
+		}
+	}
 	_, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Delete()
 	return err
 
 }
 
 func (d *sysDeptRepo) FindByID(ctx context.Context, id int64) (*model.SysDepts, error) {
-	q := d.query.SysDepts
+	q := QueryFrom(ctx, d.query).SysDepts
 	return q.WithContext(ctx).Where(q.ID.Eq(id)).First()
 }
 
@@ -127,6 +134,63 @@ func (d *sysDeptRepo) GetRoleDeptId(ctx context.Context, roleId int32) ([]int32,
 	return deptIds, nil
 }
 
+func (d *sysDeptRepo) ListForUpdate(ctx context.Context) ([]*model.SysDepts, error) {
+	q := QueryFrom(ctx, d.query).SysDepts
+	return q.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).Find()
+}
+
+func (d *sysDeptRepo) UpdateParent(ctx context.Context, id, parentID int64, updateBy string) error {
+	q := QueryFrom(ctx, d.query).SysDepts
+	_, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Updates(map[string]interface{}{
+		"parent_id": parentID,
+		"update_by": updateBy,
+	})
+	return err
+}
+
+func (d *sysDeptRepo) UpdatePaths(ctx context.Context, paths map[int64]string) error {
+	q := QueryFrom(ctx, d.query).SysDepts
+	for id, path := range paths {
+		if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Update(q.DeptPath, path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (d *sysDeptRepo) ListRoleDepts(ctx context.Context) ([]*model.SysRoleDepts, error) {
+	q := QueryFrom(ctx, d.query).SysRoleDepts
+	return q.WithContext(ctx).Find()
+}
+
+func (d *sysDeptRepo) DeleteRoleDepts(ctx context.Context, roleID int64, deptIDs ...int64) error {
+	q := QueryFrom(ctx, d.query).SysRoleDepts
+	_, err := q.WithContext(ctx).Where(q.RoleID.Eq(roleID), q.DeptID.In(deptIDs...)).Delete()
+	return err
+}
+
+func (d *sysDeptRepo) CreateRoleDepts(ctx context.Context, rows ...*model.SysRoleDepts) error {
+	q := QueryFrom(ctx, d.query).SysRoleDepts
+	return q.WithContext(ctx).CreateInBatches(rows, 100)
+}
+
+func (d *sysDeptRepo) DeleteRoleDeptsByDeptIds(ctx context.Context, deptIDs ...int64) error {
+	q := QueryFrom(ctx, d.query).SysRoleDepts
+	_, err := q.WithContext(ctx).Where(q.DeptID.In(deptIDs...)).Delete()
+	return err
+}
+
+func (d *sysDeptRepo) DeleteByIDs(ctx context.Context, deleteBy string, ids ...int64) error {
+	q := QueryFrom(ctx, d.query).SysDepts
+	if deleteBy != "" {
+		if _, err := q.WithContext(ctx).Where(q.ID.In(ids...)).Update(q.UpdateBy, deleteBy); err != nil {
+			return err
+		}
+	}
+	_, err := q.WithContext(ctx).Where(q.ID.In(ids...)).Delete()
+	return err
+}
+
 func (d *sysDeptRepo) FindByIDList(ctx context.Context, ids ...int64) ([]*model.SysDepts, error) {
 	q := d.query.SysDepts
 	return q.WithContext(ctx).Where(q.ID.In(ids...)).Find()
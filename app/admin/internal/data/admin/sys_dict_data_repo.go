@@ -23,28 +23,28 @@ func NewSysDictDataRepo(query *dao.Query, logger log.Logger) admin.SysDictDataRe
 }
 
 func (p *sysDictDataRepo) Create(ctx context.Context, post *model.SysDictData) error {
-	q := p.query.SysDictData
+	q := QueryFrom(ctx, p.query).SysDictData
 	return q.WithContext(ctx).Create(post)
 }
 
 func (p *sysDictDataRepo) Save(ctx context.Context, post *model.SysDictData) error {
-	q := p.query.SysDictData
+	q := QueryFrom(ctx, p.query).SysDictData
 	return q.WithContext(ctx).Save(post)
 }
 
 func (p *sysDictDataRepo) Delete(ctx context.Context, ids []int64) error {
-	q := p.query.SysDictData
+	q := QueryFrom(ctx, p.query).SysDictData
 	_, err := q.WithContext(ctx).Where(q.DictCode.In(ids...)).Delete()
 	return err
 }
 
 func (p *sysDictDataRepo) FindByID(ctx context.Context, id int64) (*model.SysDictData, error) {
-	q := p.query.SysDictData
+	q := QueryFrom(ctx, p.query).SysDictData
 	return q.WithContext(ctx).Where(q.DictCode.Eq(id)).First()
 }
 
 func (p *sysDictDataRepo) ListPage(ctx context.Context, dictLabel, dictType string, status int32, page, size int32) ([]*model.SysDictData, error) {
-	q := p.query.SysDictData
+	q := QueryFrom(ctx, p.query).SysDictData
 	db := q.WithContext(ctx)
 	if dictLabel != "" {
 		db = db.Where(q.DictLabel.Like(buildLikeValue(dictLabel)))
@@ -60,7 +60,7 @@ func (p *sysDictDataRepo) ListPage(ctx context.Context, dictLabel, dictType stri
 }
 
 func (p *sysDictDataRepo) ListPageCount(ctx context.Context, dictLabel, dictType string, status int32) (int32, error) {
-	q := p.query.SysDictData
+	q := QueryFrom(ctx, p.query).SysDictData
 	db := q.WithContext(ctx)
 	if dictLabel != "" {
 		db = db.Where(q.DictLabel.Like(buildLikeValue(dictLabel)))
@@ -76,11 +76,16 @@ func (p *sysDictDataRepo) ListPageCount(ctx context.Context, dictLabel, dictType
 }
 
 func (p *sysDictDataRepo) FindByIDList(ctx context.Context, ids ...int64) ([]*model.SysDictData, error) {
-	q := p.query.SysDictData
+	q := QueryFrom(ctx, p.query).SysDictData
 	return q.WithContext(ctx).Where(q.DictCode.In(ids...)).Find()
 }
 
 func (p *sysDictDataRepo) FindAll(ctx context.Context) ([]*model.SysDictData, error) {
-	q := p.query.SysDictData
+	q := QueryFrom(ctx, p.query).SysDictData
 	return q.WithContext(ctx).Find()
 }
+
+func (p *sysDictDataRepo) FindByType(ctx context.Context, dictType string) ([]*model.SysDictData, error) {
+	q := QueryFrom(ctx, p.query).SysDictData
+	return q.WithContext(ctx).Where(q.DictType.Eq(dictType)).Order(q.DictSort).Find()
+}
@@ -0,0 +1,76 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/dao"
+
+	admin "github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+type sysExportTaskRepo struct {
+	query *dao.Query
+	log   *log.Helper
+}
+
+func NewSysExportTaskRepo(query *dao.Query, logger log.Logger) admin.SysExportTaskRepo {
+	return &sysExportTaskRepo{
+		query: query,
+		log:   log.NewHelper(logger),
+	}
+}
+
+func (r *sysExportTaskRepo) Create(ctx context.Context, task *model.SysExportTasks) error {
+	return r.query.SysExportTasks.WithContext(ctx).Create(task)
+}
+
+func (r *sysExportTaskRepo) Update(ctx context.Context, task *model.SysExportTasks) error {
+	q := r.query.SysExportTasks
+	_, err := q.WithContext(ctx).
+		Select(q.Status, q.Rows, q.FilePath, q.ErrorMessage, q.UpdatedAt).
+		Where(q.ID.Eq(task.ID)).
+		Updates(task)
+	return err
+}
+
+func (r *sysExportTaskRepo) FindByID(ctx context.Context, id int64) (*model.SysExportTasks, error) {
+	q := r.query.SysExportTasks
+	return q.WithContext(ctx).Where(q.ID.Eq(id)).First()
+}
+
+func (r *sysExportTaskRepo) ListPage(ctx context.Context, userID int64, page, size int32) ([]*model.SysExportTasks, error) {
+	q := r.query.SysExportTasks
+	limit, offset := convertPageSize(page, size)
+	return q.WithContext(ctx).Where(q.CreateUserID.Eq(userID)).Order(q.ID.Desc()).Limit(limit).Offset(offset).Find()
+}
+
+func (r *sysExportTaskRepo) Count(ctx context.Context, userID int64) (int32, error) {
+	q := r.query.SysExportTasks
+	count, err := q.WithContext(ctx).Where(q.CreateUserID.Eq(userID)).Count()
+	return int32(count), err
+}
+
+func (r *sysExportTaskRepo) FindExpired(ctx context.Context, now time.Time) ([]*model.SysExportTasks, error) {
+	q := r.query.SysExportTasks
+	// 导出中的任务不清理，避免删除正在写入的文件
+	return q.WithContext(ctx).Where(q.ExpireAt.Lte(now), q.Status.Neq(admin.ExportTaskStatusRunning)).Find()
+}
+
+func (r *sysExportTaskRepo) Delete(ctx context.Context, id int64) error {
+	q := r.query.SysExportTasks
+	_, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Delete()
+	return err
+}
+
+func (r *sysExportTaskRepo) FailRunning(ctx context.Context, node string, createdBefore time.Time, message string) (int64, error) {
+	q := r.query.SysExportTasks
+	db := q.WithContext(ctx).Where(q.Status.Eq(admin.ExportTaskStatusRunning), q.CreatedAt.Lt(createdBefore))
+	if node != "" {
+		db = db.Where(q.Node.Eq(node))
+	}
+	info, err := db.UpdateSimple(q.Status.Value(admin.ExportTaskStatusFailed), q.ErrorMessage.Value(message), q.UpdatedAt.Value(time.Now()))
+	return info.RowsAffected, err
+}
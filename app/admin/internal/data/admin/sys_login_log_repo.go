@@ -0,0 +1,91 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/dao"
+
+	admin "github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+type sysLoginLogRepo struct {
+	query *dao.Query
+	log   *log.Helper
+}
+
+func NewSysLoginLogRepo(query *dao.Query, logger log.Logger) admin.SysLoginLogRepo {
+	return &sysLoginLogRepo{
+		query: query,
+		log:   log.NewHelper(logger),
+	}
+}
+
+func (r *sysLoginLogRepo) Create(ctx context.Context, record *model.SysLoginLogs) error {
+	return r.query.SysLoginLogs.WithContext(ctx).Create(record)
+}
+
+func (r *sysLoginLogRepo) ListPage(ctx context.Context, cond *admin.SysLoginLogCondition, page, size int32) ([]*model.SysLoginLogs, int64, error) {
+	q := r.query.SysLoginLogs
+	db := q.WithContext(ctx)
+	if cond.Username != "" {
+		db = db.Where(q.Username.Eq(cond.Username))
+	}
+	if cond.IP != "" {
+		db = db.Where(q.IP.Eq(cond.IP))
+	}
+	if cond.Status != 0 {
+		db = db.Where(q.Status.Eq(cond.Status))
+	}
+	if !cond.StartTime.IsZero() {
+		db = db.Where(q.CreatedAt.Gte(cond.StartTime))
+	}
+	if !cond.EndTime.IsZero() {
+		db = db.Where(q.CreatedAt.Lte(cond.EndTime))
+	}
+	count, err := db.Count()
+	if err != nil {
+		return nil, 0, err
+	}
+	limit, offset := convertPageSize(page, size)
+	list, err := db.Order(q.ID.Desc()).Limit(limit).Offset(offset).Find()
+	return list, count, err
+}
+
+func (r *sysLoginLogRepo) Clean(ctx context.Context, startTime, endTime time.Time) (int64, error) {
+	q := r.query.SysLoginLogs
+	info, err := q.WithContext(ctx).Where(q.CreatedAt.Gte(startTime), q.CreatedAt.Lte(endTime)).Delete()
+	return info.RowsAffected, err
+}
+
+// LastLogins 先按用户取最大 id，再查询对应记录
+func (r *sysLoginLogRepo) LastLogins(ctx context.Context, userIDs []int64) (map[int64]*model.SysLoginLogs, error) {
+	q := r.query.SysLoginLogs
+	var rows []struct{ ID int64 }
+	err := q.WithContext(ctx).
+		Select(q.ID.Max().As("id")).
+		Where(q.UserID.In(userIDs...), q.Status.Eq(admin.LoginStatusSuccess)).
+		Group(q.UserID).
+		Scan(&rows)
+	if err != nil {
+		return nil, err
+	}
+	result := make(map[int64]*model.SysLoginLogs, len(rows))
+	if len(rows) == 0 {
+		return result, nil
+	}
+	ids := make([]int64, len(rows))
+	for i, row := range rows {
+		ids[i] = row.ID
+	}
+	list, err := q.WithContext(ctx).Where(q.ID.In(ids...)).Find()
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range list {
+		result[record.UserID] = record
+	}
+	return result, nil
+}
@@ -2,12 +2,17 @@ package admin
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
 	admin "github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/dao"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"gorm.io/gen"
+	"gorm.io/gen/field"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
 )
 
 type sysLogsRepo struct {
@@ -20,13 +25,49 @@ func NewSysLogsRepo(query *dao.Query, logger log.Logger) admin.SysLogsRepo {
 }
 
 func (s *sysLogsRepo) Create(ctx context.Context, g *model.SysLogs) error {
-	q := s.query.SysLogs
-	return q.WithContext(ctx).Create(g)
+	return s.CreateInBatches(ctx, []*model.SysLogs{g}, 1)
+}
+
+func (s *sysLogsRepo) CreateInBatches(ctx context.Context, logs []*model.SysLogs, batchSize int) error {
+	return s.query.Transaction(func(tx *dao.Query) error {
+		return createLogs(ctx, tx, logs, batchSize)
+	})
+}
+
+// createLogs 写入操作记录，变更历史已在请求结束时单独写入，这里只回填 log_id
+func createLogs(ctx context.Context, tx *dao.Query, logs []*model.SysLogs, batchSize int) error {
+	if err := tx.SysLogs.WithContext(ctx).Omit(field.AssociationFields).CreateInBatches(logs, batchSize); err != nil {
+		return err
+	}
+	c := tx.SysLogChanges
+	for _, record := range logs {
+		ids := make([]int64, 0, len(record.Changes))
+		for _, change := range record.Changes {
+			if change.ID != 0 {
+				ids = append(ids, change.ID)
+			}
+		}
+		if len(ids) == 0 {
+			continue
+		}
+		if _, err := c.WithContext(ctx).Where(c.ID.In(ids...)).Update(c.LogID, record.ID); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *sysLogsRepo) CreateChanges(ctx context.Context, changes []model.SysLogChanges) error {
+	list := make([]*model.SysLogChanges, len(changes))
+	for i := range changes {
+		list[i] = &changes[i]
+	}
+	return s.query.SysLogChanges.WithContext(ctx).Create(list...)
 }
 
 func (s *sysLogsRepo) FindByID(ctx context.Context, id int64) (*model.SysLogs, error) {
 	q := s.query.SysLogs
-	return q.WithContext(ctx).Where(q.ID.Eq(id)).First()
+	return q.WithContext(ctx).Preload(q.Changes).Where(q.ID.Eq(id)).First()
 }
 
 func (r *sysLogsRepo) Count(ctx context.Context) (int64, error) {
@@ -56,6 +97,107 @@ func (r *sysLogsRepo) FindByPage(ctx context.Context, offset, limit int) (result
 	return result, count, nil
 }
 
+// ListPage 按条件分页查询操作记录，关联 sys_users 获取用户名和昵称
+// 过滤条件均为等值或前缀匹配，配合 (字段, created_at) 复合索引使用
+func (r *sysLogsRepo) ListPage(ctx context.Context, cond *admin.SysLogsCondition, page, size int32) ([]*admin.SysLogsWithUser, int64, error) {
+	q := r.query.SysLogs
+	u := r.query.SysUsers
+	db := q.WithContext(ctx).LeftJoin(u, u.ID.EqCol(q.UserID)).Where(r.conditions(cond)...)
+
+	count, err := db.Count()
+	if err != nil {
+		return nil, 0, err
+	}
+
+	orderCol, ok := q.GetFieldByName(cond.SortField)
+	if !ok {
+		orderCol = q.ID
+	}
+	if cond.SortAsc {
+		db = db.Order(orderCol)
+	} else {
+		db = db.Order(orderCol.Desc())
+	}
+	// 非唯一字段排序时按 id 保证分页稳定
+	if cond.SortField != "" && cond.SortField != "id" {
+		db = db.Order(q.ID.Desc())
+	}
+
+	limit, offset := convertPageSize(page, size)
+	var list []*admin.SysLogsWithUser
+	err = db.Select(q.ALL, u.Username, u.NickName).Limit(limit).Offset(offset).Scan(&list)
+	return list, count, err
+}
+
+// ListAfter 按 id 升序读取 id 大于 afterID 的记录，不统计总数，用于导出时按游标分页
+func (r *sysLogsRepo) ListAfter(ctx context.Context, cond *admin.SysLogsCondition, afterID int64, size int) ([]*admin.SysLogsWithUser, error) {
+	q := r.query.SysLogs
+	u := r.query.SysUsers
+	var list []*admin.SysLogsWithUser
+	err := q.WithContext(ctx).LeftJoin(u, u.ID.EqCol(q.UserID)).Where(r.conditions(cond)...).Where(q.ID.Gt(afterID)).
+		Select(q.ALL, u.Username, u.NickName).Order(q.ID).Limit(size).Scan(&list)
+	return list, err
+}
+
+func (r *sysLogsRepo) MaxID(ctx context.Context) (int64, error) {
+	q := r.query.SysLogs
+	record, err := q.WithContext(ctx).Unscoped().Select(q.ID).Order(q.ID.Desc()).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return record.ID, nil
+}
+
+// conditions 按查询条件过滤，关联 sys_users 按用户名和昵称过滤
+func (r *sysLogsRepo) conditions(cond *admin.SysLogsCondition) []gen.Condition {
+	q := r.query.SysLogs
+	u := r.query.SysUsers
+	var conds []gen.Condition
+	if cond.MaxID > 0 {
+		conds = append(conds, q.ID.Lte(cond.MaxID))
+	}
+	if cond.Username != "" {
+		conds = append(conds, u.Username.Eq(cond.Username))
+	}
+	if cond.Nickname != "" {
+		conds = append(conds, u.NickName.Like(buildLikeValue(cond.Nickname)))
+	}
+	if cond.Operation != "" {
+		conds = append(conds, q.Operation.Like(cond.Operation+"%"))
+	}
+	if cond.Path != "" {
+		conds = append(conds, q.Path.Like(cond.Path+"%"))
+	}
+	if cond.Method != "" {
+		conds = append(conds, q.Method.Eq(cond.Method))
+	}
+	if cond.IP != "" {
+		conds = append(conds, q.IP.Eq(cond.IP))
+	}
+	if cond.Status != 0 {
+		conds = append(conds, q.Status.Eq(cond.Status))
+	}
+	if cond.Failed {
+		conds = append(conds, q.Status.Gte(400))
+	}
+	if cond.Reason != "" {
+		conds = append(conds, q.Reason.Eq(cond.Reason))
+	}
+	if cond.TraceID != "" {
+		conds = append(conds, q.TraceID.Eq(cond.TraceID))
+	}
+	if !cond.StartTime.IsZero() {
+		conds = append(conds, q.CreatedAt.Gte(cond.StartTime))
+	}
+	if !cond.EndTime.IsZero() {
+		conds = append(conds, q.CreatedAt.Lte(cond.EndTime))
+	}
+	return conds
+}
+
 func (s *sysLogsRepo) Delete(ctx context.Context, id int64) error {
 	q := s.query.SysLogs
 	_, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Delete()
@@ -117,3 +259,119 @@ func (r *sysLogsRepo) FindByTimeRange(ctx context.Context, startTime, endTime st
 	records, err = condition.Offset(offset).Limit(limit).Order(q.ID.Desc()).Find()
 	return records, count, err
 }
+
+// logChainHeadID 审计链头只有一行
+const logChainHeadID = 1
+
+// CreateChained 在事务中锁定审计链头，多实例并发写入时保证序号连续
+func (s *sysLogsRepo) CreateChained(ctx context.Context, logs []*model.SysLogs, hash func(*model.SysLogs) string) error {
+	if len(logs) == 0 {
+		return nil
+	}
+	return s.query.Transaction(func(tx *dao.Query) error {
+		c := tx.SysLogChains
+		err := c.WithContext(ctx).Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&model.SysLogChains{ID: logChainHeadID, UpdatedAt: time.Now()})
+		if err != nil {
+			return err
+		}
+		head, err := c.WithContext(ctx).Clauses(clause.Locking{Strength: "UPDATE"}).
+			Where(c.ID.Eq(logChainHeadID)).First()
+		if err != nil {
+			return err
+		}
+		// created_at 精度为毫秒，写入前截断以保证读取后哈希一致
+		now := time.Now().Truncate(time.Millisecond)
+		for _, record := range logs {
+			head.Seq++
+			record.Seq = head.Seq
+			record.PrevHash = head.Hash
+			record.CreatedAt = now
+			record.UpdatedAt = now
+			record.Hash = hash(record)
+			head.Hash = record.Hash
+		}
+		if err = createLogs(ctx, tx, logs, len(logs)); err != nil {
+			return err
+		}
+		_, err = c.WithContext(ctx).Where(c.ID.Eq(logChainHeadID)).Updates(map[string]interface{}{
+			"seq":        head.Seq,
+			"hash":       head.Hash,
+			"updated_at": now,
+		})
+		return err
+	})
+}
+
+func (s *sysLogsRepo) ChainHead(ctx context.Context) (*model.SysLogChains, error) {
+	c := s.query.SysLogChains
+	head, err := c.WithContext(ctx).Where(c.ID.Eq(logChainHeadID)).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &model.SysLogChains{ID: logChainHeadID}, nil
+	}
+	return head, err
+}
+
+func (s *sysLogsRepo) ScanChained(ctx context.Context, fromSeq int64, limit int) ([]*model.SysLogs, error) {
+	q := s.query.SysLogs
+	return q.WithContext(ctx).Unscoped().Where(q.Seq.Gte(fromSeq)).Order(q.Seq).Limit(limit).Find()
+}
+
+func (s *sysLogsRepo) FindBySeq(ctx context.Context, seq int64) (*model.SysLogs, error) {
+	q := s.query.SysLogs
+	return q.WithContext(ctx).Unscoped().Where(q.Seq.Eq(seq)).First()
+}
+
+func (s *sysLogsRepo) MaxSeqBefore(ctx context.Context, endTime time.Time) (int64, error) {
+	q := s.query.SysLogs
+	record, err := q.WithContext(ctx).Unscoped().
+		Where(q.Seq.Gt(0), q.CreatedAt.Lte(endTime)).Order(q.Seq.Desc()).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+	return record.Seq, nil
+}
+
+func (s *sysLogsRepo) ListCheckpoints(ctx context.Context) ([]*model.SysLogCheckpoints, error) {
+	c := s.query.SysLogCheckpoints
+	return c.WithContext(ctx).Order(c.EndSeq).Find()
+}
+
+func (s *sysLogsRepo) LastCheckpoint(ctx context.Context) (*model.SysLogCheckpoints, error) {
+	c := s.query.SysLogCheckpoints
+	cp, err := c.WithContext(ctx).Order(c.EndSeq.Desc()).First()
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return nil, nil
+	}
+	return cp, err
+}
+
+// Archive 检查点与删除在同一事务中，序号范围内的记录物理删除
+func (s *sysLogsRepo) Archive(ctx context.Context, cp *model.SysLogCheckpoints) error {
+	return s.query.Transaction(func(tx *dao.Query) error {
+		if err := tx.SysLogCheckpoints.WithContext(ctx).Create(cp); err != nil {
+			return err
+		}
+		q := tx.SysLogs
+		_, err := q.WithContext(ctx).Unscoped().Where(q.Seq.Between(cp.StartSeq, cp.EndSeq)).Delete()
+		return err
+	})
+}
+
+// ListChanges 按 (entity, entity_id) 索引查询，操作记录归档后变更历史仍保留
+func (s *sysLogsRepo) ListChanges(ctx context.Context, entity string, entityID int64, page, size int32) ([]*admin.SysLogChangeWithUser, int64, error) {
+	c := s.query.SysLogChanges
+	u := s.query.SysUsers
+	db := c.WithContext(ctx).LeftJoin(u, u.ID.EqCol(c.UserID)).Where(c.Entity.Eq(entity), c.EntityID.Eq(entityID))
+	count, err := db.Count()
+	if err != nil {
+		return nil, 0, err
+	}
+	limit, offset := convertPageSize(page, size)
+	var list []*admin.SysLogChangeWithUser
+	err = db.Select(c.ALL, u.Username, u.NickName).Order(c.ID.Desc()).Limit(limit).Offset(offset).Scan(&list)
+	return list, count, err
+}
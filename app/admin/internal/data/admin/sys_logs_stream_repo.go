@@ -0,0 +1,72 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-kratos/kratos/v2/log"
+	go_redis "github.com/redis/go-redis/v9"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+// logsStreamChannel 操作记录实时推送的发布订阅频道
+const logsStreamChannel = "kva:logs:stream"
+
+type sysLogsStreamRepo struct {
+	rdb go_redis.UniversalClient
+	log *log.Helper
+}
+
+func NewSysLogsStreamRepo(rdb go_redis.UniversalClient, logger log.Logger) admin.SysLogsStreamRepo {
+	return &sysLogsStreamRepo{
+		rdb: rdb,
+		log: log.NewHelper(log.With(logger, "module", "data/logsStream")),
+	}
+}
+
+// Publish 每条记录一条消息，通过 pipeline 一次发送
+func (r *sysLogsStreamRepo) Publish(ctx context.Context, logs []*model.SysLogs) error {
+	pipe := r.rdb.Pipeline()
+	for _, l := range logs {
+		payload, err := json.Marshal(l)
+		if err != nil {
+			return err
+		}
+		pipe.Publish(ctx, logsStreamChannel, payload)
+	}
+	_, err := pipe.Exec(ctx)
+	return err
+}
+
+// Subscribe 连接断开时由 go-redis 自动重连，ctx 取消后关闭订阅和通道
+func (r *sysLogsStreamRepo) Subscribe(ctx context.Context) <-chan *model.SysLogs {
+	pubsub := r.rdb.Subscribe(ctx, logsStreamChannel)
+	out := make(chan *model.SysLogs)
+	go func() {
+		defer close(out)
+		defer pubsub.Close()
+		ch := pubsub.Channel()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case msg, ok := <-ch:
+				if !ok {
+					return
+				}
+				var l model.SysLogs
+				if err := json.Unmarshal([]byte(msg.Payload), &l); err != nil {
+					r.log.Errorf("解析操作记录消息失败: %v", err)
+					continue
+				}
+				select {
+				case out <- &l:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return out
+}
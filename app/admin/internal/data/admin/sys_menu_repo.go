@@ -38,8 +38,13 @@ func (m *sysMenuRepo) Save(ctx context.Context, menu *model.SysMenus) error {
 	return q.WithContext(ctx).Save(menu)
 }
 
-func (m *sysMenuRepo) Delete(ctx context.Context, id int64) error {
-	q := m.query.SysMenus
+func (m *sysMenuRepo) Delete(ctx context.Context, id int64, deleteBy string) error {
+	q := QueryFrom(ctx, m.query).SysMenus
+	if deleteBy != "" {
+		if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Update(q.UpdateBy, deleteBy); err != nil {
+			return err
+		}
+	}
 	_, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Delete()
 	return err
 }
@@ -63,12 +68,34 @@ func (m *sysMenuRepo) GetAllChildren(ctx context.Context, id int64) ([]int64, er
 	return allChildrenMenusIds, nil
 }
 
-func (m *sysMenuRepo) DeleteMultiple(ctx context.Context, ids []int64) error {
-	q := m.query.SysMenus
+func (m *sysMenuRepo) DeleteMultiple(ctx context.Context, ids []int64, deleteBy string) error {
+	q := QueryFrom(ctx, m.query).SysMenus
+	if deleteBy != "" {
+		if _, err := q.WithContext(ctx).Where(q.ID.In(ids...)).Update(q.UpdateBy, deleteBy); err != nil {
+			return err
+		}
+	}
 	_, err := q.WithContext(ctx).Where(q.ID.In(ids...)).Delete()
 	return err
 }
 
+func (m *sysMenuRepo) DeleteBtns(ctx context.Context, menuIds ...int64) error {
+	q := QueryFrom(ctx, m.query).SysMenuBtns
+	_, err := q.WithContext(ctx).Where(q.MenuID.In(menuIds...)).Delete()
+	return err
+}
+
+func (m *sysMenuRepo) DeleteRoleRefs(ctx context.Context, menuIds ...int64) error {
+	query := QueryFrom(ctx, m.query)
+	rm := query.SysRoleMenus
+	if _, err := rm.WithContext(ctx).Where(rm.MenuID.In(menuIds...)).Delete(); err != nil {
+		return err
+	}
+	rb := query.SysRoleBtns
+	_, err := rb.WithContext(ctx).Where(rb.MenuID.In(menuIds...)).Delete()
+	return err
+}
+
 func (m *sysMenuRepo) FindByID(ctx context.Context, id int64) (*model.SysMenus, error) {
 	q := m.query.SysMenus
 	return q.WithContext(ctx).Where(q.ID.Eq(id)).First()
@@ -79,6 +106,12 @@ func (m *sysMenuRepo) ListAll(ctx context.Context) ([]*model.SysMenus, error) {
 	return q.WithContext(ctx).Find()
 }
 
+// ListAllBtns 查询全部菜单按钮
+func (m *sysMenuRepo) ListAllBtns(ctx context.Context) ([]*model.SysMenuBtns, error) {
+	q := m.query.SysMenuBtns
+	return q.WithContext(ctx).Find()
+}
+
 func (m *sysMenuRepo) FindByNameStatus(ctx context.Context, name string, status int32) ([]*model.SysMenus, error) {
 	q := m.query.SysMenus
 	db := q.WithContext(ctx)
@@ -32,8 +32,13 @@ func (p *sysPostRepo) Save(ctx context.Context, post *model.SysPosts) error {
 	return q.WithContext(ctx).Save(post)
 }
 
-func (p *sysPostRepo) Delete(ctx context.Context, ids []int64) error {
-	q := p.query.SysPosts
+func (p *sysPostRepo) Delete(ctx context.Context, ids []int64, deleteBy string) error {
+	q := QueryFrom(ctx, p.query).SysPosts
+	if deleteBy != "" {
+		if _, err := q.WithContext(ctx).Where(q.ID.In(ids...)).Update(q.UpdateBy, deleteBy); err != nil {
+			return err
+		}
+	}
 	_, err := q.WithContext(ctx).Where(q.ID.In(ids...)).Delete()
 	return err
 }
@@ -0,0 +1,169 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm"
+
+	admin "github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/dao"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+// recycleTable 回收站实体对应的表，name 为名称列，key 为恢复时需要唯一的列，parent 为上级列
+type recycleTable struct {
+	model  func() interface{}
+	name   string
+	key    string
+	parent string
+}
+
+var recycleTables = map[string]recycleTable{
+	admin.RecycleEntityUser: {model: func() interface{} { return &model.SysUsers{} }, name: "nick_name", key: "username"},
+	admin.RecycleEntityRole: {model: func() interface{} { return &model.SysRoles{} }, name: "role_name", key: "role_key"},
+	admin.RecycleEntityDept: {model: func() interface{} { return &model.SysDepts{} }, name: "dept_name", parent: "parent_id"},
+	admin.RecycleEntityMenu: {model: func() interface{} { return &model.SysMenus{} }, name: "menu_name", parent: "parent_id"},
+	admin.RecycleEntityPost: {model: func() interface{} { return &model.SysPosts{} }, name: "post_name", key: "post_code"},
+}
+
+type recycleRow struct {
+	ID        int64
+	Name      string
+	Key       string
+	ParentID  int64
+	UpdateBy  string
+	DeletedAt time.Time
+}
+
+type sysRecycleRepo struct {
+	query *dao.Query
+	log   *log.Helper
+}
+
+func NewSysRecycleRepo(query *dao.Query, logger log.Logger) admin.SysRecycleRepo {
+	return &sysRecycleRepo{
+		query: query,
+		log:   log.NewHelper(logger),
+	}
+}
+
+// table 实体对应的表，包含已删除的记录，在事务中时使用事务的连接
+func (r *sysRecycleRepo) table(ctx context.Context, entity string) (*gorm.DB, recycleTable, error) {
+	t, ok := recycleTables[entity]
+	if !ok {
+		return nil, t, fmt.Errorf("unsupported recycle entity: %s", entity)
+	}
+	db := QueryFrom(ctx, r.query).SysUsers.WithContext(ctx).UnderlyingDB().Session(&gorm.Session{NewDB: true})
+	return db.Model(t.model()).Unscoped(), t, nil
+}
+
+func (r *sysRecycleRepo) find(db *gorm.DB, t recycleTable) ([]*admin.RecycleItem, error) {
+	key, parent := "''", "0"
+	if t.key != "" {
+		key = t.key
+	}
+	if t.parent != "" {
+		parent = t.parent
+	}
+	var rows []*recycleRow
+	err := db.Select(fmt.Sprintf("id, %s AS name, %s AS `key`, %s AS parent_id, update_by, deleted_at", t.name, key, parent)).
+		Order("deleted_at DESC").Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+	items := make([]*admin.RecycleItem, len(rows))
+	for i, row := range rows {
+		items[i] = &admin.RecycleItem{
+			ID:        row.ID,
+			Name:      row.Name,
+			Key:       row.Key,
+			ParentID:  row.ParentID,
+			DeleteBy:  row.UpdateBy,
+			DeletedAt: row.DeletedAt,
+		}
+	}
+	return items, nil
+}
+
+func (r *sysRecycleRepo) ListDeleted(ctx context.Context, entity string, page, size int32) ([]*admin.RecycleItem, int64, error) {
+	db, t, err := r.table(ctx, entity)
+	if err != nil {
+		return nil, 0, err
+	}
+	db = db.Where("deleted_at IS NOT NULL")
+	var count int64
+	if err = db.Session(&gorm.Session{}).Count(&count).Error; err != nil {
+		return nil, 0, err
+	}
+	limit, offset := convertPageSize(page, size)
+	items, err := r.find(db.Limit(limit).Offset(offset), t)
+	return items, count, err
+}
+
+func (r *sysRecycleRepo) FindDeleted(ctx context.Context, entity string, ids ...int64) ([]*admin.RecycleItem, error) {
+	db, t, err := r.table(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	return r.find(db.Where("id IN ? AND deleted_at IS NOT NULL", ids), t)
+}
+
+func (r *sysRecycleRepo) FindActiveKeys(ctx context.Context, entity string, keys ...string) ([]string, error) {
+	db, t, err := r.table(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	var taken []string
+	if t.key == "" {
+		return taken, nil
+	}
+	err = db.Where(t.key+" IN ? AND deleted_at IS NULL", keys).Distinct().Pluck(t.key, &taken).Error
+	return taken, err
+}
+
+func (r *sysRecycleRepo) FindActiveIDs(ctx context.Context, entity string, ids ...int64) ([]int64, error) {
+	db, _, err := r.table(ctx, entity)
+	if err != nil {
+		return nil, err
+	}
+	var active []int64
+	err = db.Where("id IN ? AND deleted_at IS NULL", ids).Pluck("id", &active).Error
+	return active, err
+}
+
+func (r *sysRecycleRepo) FindDeletedUsers(ctx context.Context, ids ...int64) ([]*model.SysUsers, error) {
+	q := QueryFrom(ctx, r.query).SysUsers
+	return q.WithContext(ctx).Unscoped().Where(q.ID.In(ids...), q.DeletedAt.IsNotNull()).Find()
+}
+
+func (r *sysRecycleRepo) Restore(ctx context.Context, entity, updateBy string, ids ...int64) error {
+	db, _, err := r.table(ctx, entity)
+	if err != nil {
+		return err
+	}
+	return db.Where("id IN ? AND deleted_at IS NOT NULL", ids).Updates(map[string]interface{}{
+		"deleted_at": nil,
+		"update_by":  updateBy,
+	}).Error
+}
+
+func (r *sysRecycleRepo) Purge(ctx context.Context, entity string, ids ...int64) (int64, error) {
+	db, t, err := r.table(ctx, entity)
+	if err != nil {
+		return 0, err
+	}
+	result := db.Where("id IN ? AND deleted_at IS NOT NULL", ids).Delete(t.model())
+	return result.RowsAffected, result.Error
+}
+
+func (r *sysRecycleRepo) PurgeBefore(ctx context.Context, entity string, before time.Time) (int64, error) {
+	db, t, err := r.table(ctx, entity)
+	if err != nil {
+		return 0, err
+	}
+	result := db.Where("deleted_at IS NOT NULL AND deleted_at < ?", before).Delete(t.model())
+	return result.RowsAffected, result.Error
+}
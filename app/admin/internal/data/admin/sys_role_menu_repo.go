@@ -29,12 +29,29 @@ func NewSysRoleMenuRepo(query *dao.Query, logger log.Logger) admin.SysRoleMenuRe
 }
 
 func (s *sysRoleMenuRepo) Create(ctx context.Context, roleMenus ...*model.SysRoleMenus) error {
-	q := s.query.SysRoleMenus
+	q := QueryFrom(ctx, s.query).SysRoleMenus
 	return q.WithContext(ctx).Create(roleMenus...)
 }
 
 func (s *sysRoleMenuRepo) DeleteByRoleId(ctx context.Context, roleIDs ...int64) error {
-	q := s.query.SysRoleMenus
+	q := QueryFrom(ctx, s.query).SysRoleMenus
+	_, err := q.WithContext(ctx).Where(q.RoleID.In(roleIDs...)).Delete()
+	return err
+}
+
+// FindRoleBtns 查询角色的按钮权限
+func (s *sysRoleMenuRepo) FindRoleBtns(ctx context.Context, roleID int64) ([]*model.SysRoleBtns, error) {
+	q := s.query.SysRoleBtns
+	return q.WithContext(ctx).Where(q.RoleID.Eq(roleID)).Find()
+}
+
+func (s *sysRoleMenuRepo) CreateRoleBtns(ctx context.Context, roleBtns ...*model.SysRoleBtns) error {
+	q := QueryFrom(ctx, s.query).SysRoleBtns
+	return q.WithContext(ctx).Create(roleBtns...)
+}
+
+func (s *sysRoleMenuRepo) DeleteRoleBtnsByRoleId(ctx context.Context, roleIDs ...int64) error {
+	q := QueryFrom(ctx, s.query).SysRoleBtns
 	_, err := q.WithContext(ctx).Where(q.RoleID.In(roleIDs...)).Delete()
 	return err
 }
@@ -54,6 +71,20 @@ func (s *sysRoleMenuRepo) GetPermission(ctx context.Context, roleID int64) ([]st
 	return result, err
 }
 
+// FindGrantedMenus 查询角色授权的全部菜单，包含目录、菜单和按钮，只返回 id 和类型
+func (s *sysRoleMenuRepo) FindGrantedMenus(ctx context.Context, roleID int64) ([]*model.SysMenus, error) {
+	query := QueryFrom(ctx, s.query)
+	roleMenu := query.SysRoleMenus
+	menu := query.SysMenus
+
+	return menu.WithContext(ctx).
+		Select(menu.ID, menu.MenuType).
+		LeftJoin(roleMenu, menu.ID.EqCol(roleMenu.MenuID)).
+		Where(roleMenu.RoleID.Eq(roleID)).
+		Order(menu.ID).
+		Find()
+}
+
 // FindMenuByRoleId 查询菜单路径
 func (s *sysRoleMenuRepo) FindMenuByRoleId(ctx context.Context, roleID int64) ([]*model.SysMenus, error) {
 	query := s.query
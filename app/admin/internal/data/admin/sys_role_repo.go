@@ -23,27 +23,38 @@ func NewSysRoleRepo(query *dao.Query, logger log.Logger) admin.SysRoleRepo {
 }
 
 func (r *sysRoleRepo) Create(ctx context.Context, role *model.SysRoles) error {
-	q := r.query.SysRoles
+	q := QueryFrom(ctx, r.query).SysRoles
 	return q.WithContext(ctx).Create(role)
 }
 
 func (r *sysRoleRepo) Save(ctx context.Context, role *model.SysRoles) error {
-	q := r.query.SysRoles
+	q := QueryFrom(ctx, r.query).SysRoles
 	return q.WithContext(ctx).Save(role)
 }
 
-func (r *sysRoleRepo) Delete(ctx context.Context, ids ...int64) error {
-	q := r.query.SysRoles
+func (r *sysRoleRepo) Delete(ctx context.Context, deleteBy string, ids ...int64) error {
+	q := QueryFrom(ctx, r.query).SysRoles
+	if deleteBy != "" {
+		if _, err := q.WithContext(ctx).Where(q.ID.In(ids...)).Update(q.UpdateBy, deleteBy); err != nil {
+			return err
+		}
+	}
 	_, err := q.WithContext(ctx).Where(q.ID.In(ids...)).Delete()
 	return err
 }
 
 func (r *sysRoleRepo) Update(ctx context.Context, role *model.SysRoles) error {
-	q := r.query.SysRoles
+	q := QueryFrom(ctx, r.query).SysRoles
 	_, err := q.WithContext(ctx).Select(q.UpdatedAt, q.RoleSort, q.DefaultRouter, q.RoleName, q.RoleKey, q.Status, q.DataScope, q.Remark).Where(q.ID.Eq(role.ID)).Updates(role)
 	return err
 }
 
+func (r *sysRoleRepo) DeleteRoleDepts(ctx context.Context, roleIds ...int64) error {
+	q := QueryFrom(ctx, r.query).SysRoleDepts
+	_, err := q.WithContext(ctx).Where(q.RoleID.In(roleIds...)).Delete()
+	return err
+}
+
 func (r *sysRoleRepo) FindByID(ctx context.Context, id int64) (*model.SysRoles, error) {
 	q := r.query.SysRoles
 	return q.WithContext(ctx).Where(q.ID.Eq(id)).First()
@@ -0,0 +1,85 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/dao"
+
+	admin "github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+type sysTempGrantRepo struct {
+	query *dao.Query
+	log   *log.Helper
+}
+
+func NewSysTempGrantRepo(query *dao.Query, logger log.Logger) admin.SysTempGrantRepo {
+	return &sysTempGrantRepo{
+		query: query,
+		log:   log.NewHelper(logger),
+	}
+}
+
+func (r *sysTempGrantRepo) Create(ctx context.Context, grant *model.SysTempGrants) error {
+	q := r.query.SysTempGrants
+	return q.WithContext(ctx).Create(grant)
+}
+
+func (r *sysTempGrantRepo) FindByID(ctx context.Context, id int64) (*model.SysTempGrants, error) {
+	q := r.query.SysTempGrants
+	return q.WithContext(ctx).Where(q.ID.Eq(id)).First()
+}
+
+func (r *sysTempGrantRepo) FindActiveByUserID(ctx context.Context, userID int64) ([]*model.SysTempGrants, error) {
+	q := r.query.SysTempGrants
+	return q.WithContext(ctx).Where(q.UserID.Eq(userID), q.Status.Eq(admin.TempGrantStatusActive)).Find()
+}
+
+func (r *sysTempGrantRepo) FindDue(ctx context.Context, now time.Time) ([]*model.SysTempGrants, error) {
+	q := r.query.SysTempGrants
+	return q.WithContext(ctx).
+		Where(q.Status.Eq(admin.TempGrantStatusPending), q.StartTime.Lte(now)).
+		Or(q.Status.Eq(admin.TempGrantStatusActive), q.EndTime.Lte(now)).
+		Find()
+}
+
+func (r *sysTempGrantRepo) UpdateStatus(ctx context.Context, grant *model.SysTempGrants, from int32) (bool, error) {
+	q := r.query.SysTempGrants
+	info, err := q.WithContext(ctx).
+		Select(q.Status, q.RevokeBy, q.EndTime, q.UpdatedAt).
+		Where(q.ID.Eq(grant.ID), q.Status.Eq(from)).
+		Updates(grant)
+	if err != nil {
+		return false, err
+	}
+	return info.RowsAffected > 0, nil
+}
+
+func (r *sysTempGrantRepo) ListPage(ctx context.Context, userID int64, status []int32, page, size int32) ([]*model.SysTempGrants, error) {
+	q := r.query.SysTempGrants
+	db := q.WithContext(ctx)
+	if userID != 0 {
+		db = db.Where(q.UserID.Eq(userID))
+	}
+	if len(status) > 0 {
+		db = db.Where(q.Status.In(status...))
+	}
+	limit, offset := convertPageSize(page, size)
+	return db.Order(q.ID.Desc()).Limit(limit).Offset(offset).Find()
+}
+
+func (r *sysTempGrantRepo) Count(ctx context.Context, userID int64, status []int32) (int32, error) {
+	q := r.query.SysTempGrants
+	db := q.WithContext(ctx)
+	if userID != 0 {
+		db = db.Where(q.UserID.Eq(userID))
+	}
+	if len(status) > 0 {
+		db = db.Where(q.Status.In(status...))
+	}
+	count, err := db.Count()
+	return int32(count), err
+}
@@ -0,0 +1,69 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"gorm.io/gorm/clause"
+
+	admin "github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/dao"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+)
+
+type sysTranslationRepo struct {
+	query *dao.Query
+	log   *log.Helper
+}
+
+func NewSysTranslationRepo(query *dao.Query, logger log.Logger) admin.SysTranslationRepo {
+	return &sysTranslationRepo{
+		query: query,
+		log:   log.NewHelper(logger),
+	}
+}
+
+func (r *sysTranslationRepo) ListPage(ctx context.Context, cond *admin.SysTranslationCondition, page, size int32) ([]*model.SysTranslations, int64, error) {
+	q := r.query.SysTranslations
+	db := q.WithContext(ctx)
+	if cond.Module != "" {
+		db = db.Where(q.Module.Eq(cond.Module))
+	}
+	if cond.Locale != "" {
+		db = db.Where(q.Locale.Eq(cond.Locale))
+	}
+	if cond.KeyPrefix != "" {
+		db = db.Where(q.TransKey.Like(cond.KeyPrefix + "%"))
+	}
+	count, err := db.Count()
+	if err != nil {
+		return nil, 0, err
+	}
+	limit, offset := convertPageSize(page, size)
+	list, err := db.Order(q.Module, q.TransKey, q.Locale).Limit(limit).Offset(offset).Find()
+	return list, count, err
+}
+
+func (r *sysTranslationRepo) FindByID(ctx context.Context, id int64) (*model.SysTranslations, error) {
+	q := r.query.SysTranslations
+	return q.WithContext(ctx).Where(q.ID.Eq(id)).First()
+}
+
+func (r *sysTranslationRepo) FindByModuleLocale(ctx context.Context, module, locale string) ([]*model.SysTranslations, error) {
+	q := r.query.SysTranslations
+	return q.WithContext(ctx).Where(q.Module.Eq(module), q.Locale.Eq(locale)).Find()
+}
+
+func (r *sysTranslationRepo) Upsert(ctx context.Context, items []*model.SysTranslations) error {
+	q := r.query.SysTranslations
+	return q.WithContext(ctx).Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: q.Module.ColumnName().String()}, {Name: q.TransKey.ColumnName().String()}, {Name: q.Locale.ColumnName().String()}},
+		DoUpdates: clause.AssignmentColumns([]string{q.Value.ColumnName().String(), q.UpdateBy.ColumnName().String(), q.UpdatedAt.ColumnName().String()}),
+	}).CreateInBatches(items, 100)
+}
+
+func (r *sysTranslationRepo) Delete(ctx context.Context, id int64) error {
+	q := r.query.SysTranslations
+	_, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Delete()
+	return err
+}
@@ -3,6 +3,7 @@ package admin
 import (
 	"context"
 	"fmt"
+	"strconv"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
@@ -29,7 +30,7 @@ func NewSysUserRepo(query *dao.Query, db *gorm.DB, logger log.Logger) admin.SysU
 }
 
 func (r *SysUserRepo) Create(ctx context.Context, g *model.SysUsers) (*model.SysUsers, error) {
-	q := r.query.SysUsers
+	q := QueryFrom(ctx, r.query).SysUsers
 	err := q.WithContext(ctx).Clauses().Create(g)
 	return g, err
 }
@@ -40,8 +41,13 @@ func (r *SysUserRepo) Save(ctx context.Context, g *model.SysUsers) (*model.SysUs
 	return g, err
 }
 
-func (r *SysUserRepo) Delete(ctx context.Context, id int64) error {
-	q := r.query.SysUsers
+func (r *SysUserRepo) Delete(ctx context.Context, id int64, deleteBy string) error {
+	q := QueryFrom(ctx, r.query).SysUsers
+	if deleteBy != "" {
+		if _, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Update(q.UpdateBy, deleteBy); err != nil {
+			return err
+		}
+	}
 	_, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Delete()
 	return err
 }
@@ -98,6 +104,35 @@ func (r *SysUserRepo) FindByPostId(ctx context.Context, postId int64) ([]*model.
 	return q.WithContext(ctx).Where(q.PostID.Eq(postId)).Find()
 }
 
+func (r *SysUserRepo) FindByDeptIds(ctx context.Context, deptIds ...int64) ([]*model.SysUsers, error) {
+	q := QueryFrom(ctx, r.query).SysUsers
+	return q.WithContext(ctx).Where(q.DeptID.In(deptIds...)).Find()
+}
+
+func (r *SysUserRepo) FindByPostIds(ctx context.Context, postIds ...int64) ([]*model.SysUsers, error) {
+	q := QueryFrom(ctx, r.query).SysUsers
+	db := q.WithContext(ctx).Where(q.PostID.In(postIds...))
+	for _, id := range postIds {
+		db = db.Or(q.PostIds.FindInSetWith(strconv.FormatInt(id, 10)))
+	}
+	return db.Find()
+}
+
+func (r *SysUserRepo) FindByRoleIds(ctx context.Context, roleIds ...int64) ([]*model.SysUsers, error) {
+	q := QueryFrom(ctx, r.query).SysUsers
+	db := q.WithContext(ctx).Where(q.RoleID.In(roleIds...))
+	for _, id := range roleIds {
+		db = db.Or(q.RoleIds.FindInSetWith(strconv.FormatInt(id, 10)))
+	}
+	return db.Find()
+}
+
+func (r *SysUserRepo) UpdateReferences(ctx context.Context, user *model.SysUsers) error {
+	q := QueryFrom(ctx, r.query).SysUsers
+	_, err := q.WithContext(ctx).Select(q.DeptID, q.PostID, q.PostIds, q.RoleID, q.RoleIds).Where(q.ID.Eq(user.ID)).Updates(user)
+	return err
+}
+
 func (r *SysUserRepo) CountByRoleId(ctx context.Context, roleId int64) (int64, error) {
 	q := r.query.SysUsers
 	return q.WithContext(ctx).Where(q.RoleID.Eq(roleId)).Count()
@@ -112,6 +147,32 @@ func (r *SysUserRepo) UpdateByID(ctx context.Context, id int64, user *model.SysU
 	return err
 }
 
+// UpdateLocale 修改语言偏好，允许置空
+func (r *SysUserRepo) UpdateLocale(ctx context.Context, id int64, locale string) error {
+	q := r.query.SysUsers
+	_, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Update(q.Locale, locale)
+	return err
+}
+
+// ResetPassword 重置密码并标记下次登录必须修改，同时使此前签发的 token 失效
+func (r *SysUserRepo) ResetPassword(ctx context.Context, id int64, password, updateBy string, revokedAt int64) error {
+	q := r.query.SysUsers
+	_, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Updates(map[string]interface{}{
+		"password":             password,
+		"must_change_password": true,
+		"token_revoked_at":     revokedAt,
+		"update_by":            updateBy,
+	})
+	return err
+}
+
+// RevokeTokens 使 revokedAt 及之前签发的 token 失效
+func (r *SysUserRepo) RevokeTokens(ctx context.Context, id int64, revokedAt int64) error {
+	q := r.query.SysUsers
+	_, err := q.WithContext(ctx).Where(q.ID.Eq(id)).Update(q.TokenRevokedAt, revokedAt)
+	return err
+}
+
 // ==================== JWT 黑名单相关方法 ====================
 
 // AddJwtToBlacklist 将 JWT 加入黑名单
@@ -0,0 +1,23 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/dao"
+)
+
+// contextTxKey 用于在 context 中传递 GORM Gen 的事务 Query
+type contextTxKey struct{}
+
+// WithTxQuery 将带事务的 Query 存入 context
+func WithTxQuery(ctx context.Context, tx *dao.Query) context.Context {
+	return context.WithValue(ctx, contextTxKey{}, tx)
+}
+
+// QueryFrom 在事务中时返回带事务的 Query，否则返回 q
+func QueryFrom(ctx context.Context, q *dao.Query) *dao.Query {
+	if tx, ok := ctx.Value(contextTxKey{}).(*dao.Query); ok {
+		return tx
+	}
+	return q
+}
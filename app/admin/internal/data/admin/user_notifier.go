@@ -0,0 +1,62 @@
+package admin
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	admin "github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
+)
+
+// defaultNotifyTimeout 推送账号安全事件的默认超时
+const defaultNotifyTimeout = 5 * time.Second
+
+// userNotifier 将账号安全事件推送到配置的 webhook，由外部服务转发邮件或短信
+type userNotifier struct {
+	webhook string
+	client  *http.Client
+	log     *log.Helper
+}
+
+func NewUserNotifier(c *conf.Auth, logger log.Logger) admin.UserNotifier {
+	timeout := c.GetNotify().GetTimeout().AsDuration()
+	if timeout <= 0 {
+		timeout = defaultNotifyTimeout
+	}
+	return &userNotifier{
+		webhook: c.GetNotify().GetWebhook(),
+		client:  &http.Client{Timeout: timeout},
+		log:     log.NewHelper(log.With(logger, "module", "data/userNotifier")),
+	}
+}
+
+func (n *userNotifier) Notify(ctx context.Context, event *admin.UserSecurityEvent) error {
+	if n.webhook == "" {
+		n.log.WithContext(ctx).Infof("user %d %s by %s, webhook not configured", event.UserID, event.Event, event.Operator)
+		return nil
+	}
+	body, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.webhook, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := n.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook returned %s", resp.Status)
+	}
+	return nil
+}
@@ -27,6 +27,7 @@ var ProviderSet = wire.NewSet(
 	NewRedisRepo,
 	admin.NewSysUserRepo,
 	admin.NewSysLogsRepo,
+	admin.NewSysLogsStreamRepo,
 	admin.NewSysMenuRepo,
 	admin.NewSysDeptRepo,
 	admin.NewSysPostRepo,
@@ -34,6 +35,13 @@ var ProviderSet = wire.NewSet(
 	admin.NewSysRoleRepo,
 	admin.NewSysRoleMenuRepo,
 	admin.NewCasbinRuleRepo,
+	admin.NewSysTempGrantRepo,
+	admin.NewSysChangeRequestRepo,
+	admin.NewSysExportTaskRepo,
+	admin.NewSysLoginLogRepo,
+	admin.NewSysTranslationRepo,
+	admin.NewSysRecycleRepo,
+	admin.NewUserNotifier,
 	admin.NewSysDictDataRepo,
 	admin.NewSysDictTypeRepo,
 )
@@ -51,9 +59,6 @@ type Data struct {
 	rdb   go_redis.UniversalClient
 }
 
-// contextTxKey 用于在 context 中传递 GORM Gen 的事务 Query
-type contextTxKey struct{}
-
 func toGormLogLevel(d conf.GormLogLevel) gormLogger.LogLevel {
 	switch d {
 	case conf.GormLogLevel_silent:
@@ -114,17 +119,12 @@ func (d *Data) Transaction(ctx context.Context, fn func(ctx context.Context) err
 		// 创建带事务的 dao.Query
 		txQuery := dao.Use(tx)
 		// 将带事务的 Query 存入 context，供后续 repo 操作使用
-		ctx = context.WithValue(ctx, contextTxKey{}, txQuery)
-		return fn(ctx)
+		return fn(admin.WithTxQuery(ctx, txQuery))
 	})
 }
 
 func (d *Data) Query(ctx context.Context) *dao.Query {
-	tx, ok := ctx.Value(contextTxKey{}).(*dao.Query)
-	if ok {
-		return tx
-	}
-	return d.query
+	return admin.QueryFrom(ctx, d.query)
 }
 
 func convertPageSize(page, size int32) (limit, offset int) {
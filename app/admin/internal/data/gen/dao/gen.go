@@ -17,71 +17,95 @@ import (
 
 func Use(db *gorm.DB, opts ...gen.DOOption) *Query {
 	return &Query{
-		db:           db,
-		CasbinRule:   newCasbinRule(db, opts...),
-		JwtBlackList: newJwtBlackList(db, opts...),
-		SysApis:      newSysApis(db, opts...),
-		SysDepts:     newSysDepts(db, opts...),
-		SysDictData:  newSysDictData(db, opts...),
-		SysDictTypes: newSysDictTypes(db, opts...),
-		SysDiscovery: newSysDiscovery(db, opts...),
-		SysJobs:      newSysJobs(db, opts...),
-		SysLogs:      newSysLogs(db, opts...),
-		SysMenuBtns:  newSysMenuBtns(db, opts...),
-		SysMenus:     newSysMenus(db, opts...),
-		SysPosts:     newSysPosts(db, opts...),
-		SysRoleBtns:  newSysRoleBtns(db, opts...),
-		SysRoleDepts: newSysRoleDepts(db, opts...),
-		SysRoleMenus: newSysRoleMenus(db, opts...),
-		SysRoles:     newSysRoles(db, opts...),
-		SysUsers:     newSysUsers(db, opts...),
+		db:                db,
+		CasbinRule:        newCasbinRule(db, opts...),
+		JwtBlackList:      newJwtBlackList(db, opts...),
+		SysApis:           newSysApis(db, opts...),
+		SysChangeRequests: newSysChangeRequests(db, opts...),
+		SysDepts:          newSysDepts(db, opts...),
+		SysDictData:       newSysDictData(db, opts...),
+		SysDictTypes:      newSysDictTypes(db, opts...),
+		SysDiscovery:      newSysDiscovery(db, opts...),
+		SysExportTasks:    newSysExportTasks(db, opts...),
+		SysJobs:           newSysJobs(db, opts...),
+		SysLogChains:      newSysLogChains(db, opts...),
+		SysLogChanges:     newSysLogChanges(db, opts...),
+		SysLogCheckpoints: newSysLogCheckpoints(db, opts...),
+		SysLoginLogs:      newSysLoginLogs(db, opts...),
+		SysLogs:           newSysLogs(db, opts...),
+		SysMenuBtns:       newSysMenuBtns(db, opts...),
+		SysMenus:          newSysMenus(db, opts...),
+		SysPosts:          newSysPosts(db, opts...),
+		SysRoleBtns:       newSysRoleBtns(db, opts...),
+		SysRoleDepts:      newSysRoleDepts(db, opts...),
+		SysRoleMenus:      newSysRoleMenus(db, opts...),
+		SysRoles:          newSysRoles(db, opts...),
+		SysTempGrants:     newSysTempGrants(db, opts...),
+		SysTranslations:   newSysTranslations(db, opts...),
+		SysUsers:          newSysUsers(db, opts...),
 	}
 }
 
 type Query struct {
 	db *gorm.DB
 
-	CasbinRule   casbinRule
-	JwtBlackList jwtBlackList
-	SysApis      sysApis
-	SysDepts     sysDepts
-	SysDictData  sysDictData
-	SysDictTypes sysDictTypes
-	SysDiscovery sysDiscovery
-	SysJobs      sysJobs
-	SysLogs      sysLogs
-	SysMenuBtns  sysMenuBtns
-	SysMenus     sysMenus
-	SysPosts     sysPosts
-	SysRoleBtns  sysRoleBtns
-	SysRoleDepts sysRoleDepts
-	SysRoleMenus sysRoleMenus
-	SysRoles     sysRoles
-	SysUsers     sysUsers
+	CasbinRule        casbinRule
+	JwtBlackList      jwtBlackList
+	SysApis           sysApis
+	SysChangeRequests sysChangeRequests
+	SysDepts          sysDepts
+	SysDictData       sysDictData
+	SysDictTypes      sysDictTypes
+	SysDiscovery      sysDiscovery
+	SysExportTasks    sysExportTasks
+	SysJobs           sysJobs
+	SysLogChains      sysLogChains
+	SysLogChanges     sysLogChanges
+	SysLogCheckpoints sysLogCheckpoints
+	SysLoginLogs      sysLoginLogs
+	SysLogs           sysLogs
+	SysMenuBtns       sysMenuBtns
+	SysMenus          sysMenus
+	SysPosts          sysPosts
+	SysRoleBtns       sysRoleBtns
+	SysRoleDepts      sysRoleDepts
+	SysRoleMenus      sysRoleMenus
+	SysRoles          sysRoles
+	SysTempGrants     sysTempGrants
+	SysTranslations   sysTranslations
+	SysUsers          sysUsers
 }
 
 func (q *Query) Available() bool { return q.db != nil }
 
 func (q *Query) clone(db *gorm.DB) *Query {
 	return &Query{
-		db:           db,
-		CasbinRule:   q.CasbinRule.clone(db),
-		JwtBlackList: q.JwtBlackList.clone(db),
-		SysApis:      q.SysApis.clone(db),
-		SysDepts:     q.SysDepts.clone(db),
-		SysDictData:  q.SysDictData.clone(db),
-		SysDictTypes: q.SysDictTypes.clone(db),
-		SysDiscovery: q.SysDiscovery.clone(db),
-		SysJobs:      q.SysJobs.clone(db),
-		SysLogs:      q.SysLogs.clone(db),
-		SysMenuBtns:  q.SysMenuBtns.clone(db),
-		SysMenus:     q.SysMenus.clone(db),
-		SysPosts:     q.SysPosts.clone(db),
-		SysRoleBtns:  q.SysRoleBtns.clone(db),
-		SysRoleDepts: q.SysRoleDepts.clone(db),
-		SysRoleMenus: q.SysRoleMenus.clone(db),
-		SysRoles:     q.SysRoles.clone(db),
-		SysUsers:     q.SysUsers.clone(db),
+		db:                db,
+		CasbinRule:        q.CasbinRule.clone(db),
+		JwtBlackList:      q.JwtBlackList.clone(db),
+		SysApis:           q.SysApis.clone(db),
+		SysChangeRequests: q.SysChangeRequests.clone(db),
+		SysDepts:          q.SysDepts.clone(db),
+		SysDictData:       q.SysDictData.clone(db),
+		SysDictTypes:      q.SysDictTypes.clone(db),
+		SysDiscovery:      q.SysDiscovery.clone(db),
+		SysExportTasks:    q.SysExportTasks.clone(db),
+		SysJobs:           q.SysJobs.clone(db),
+		SysLogChains:      q.SysLogChains.clone(db),
+		SysLogChanges:     q.SysLogChanges.clone(db),
+		SysLogCheckpoints: q.SysLogCheckpoints.clone(db),
+		SysLoginLogs:      q.SysLoginLogs.clone(db),
+		SysLogs:           q.SysLogs.clone(db),
+		SysMenuBtns:       q.SysMenuBtns.clone(db),
+		SysMenus:          q.SysMenus.clone(db),
+		SysPosts:          q.SysPosts.clone(db),
+		SysRoleBtns:       q.SysRoleBtns.clone(db),
+		SysRoleDepts:      q.SysRoleDepts.clone(db),
+		SysRoleMenus:      q.SysRoleMenus.clone(db),
+		SysRoles:          q.SysRoles.clone(db),
+		SysTempGrants:     q.SysTempGrants.clone(db),
+		SysTranslations:   q.SysTranslations.clone(db),
+		SysUsers:          q.SysUsers.clone(db),
 	}
 }
 
@@ -95,66 +119,90 @@ func (q *Query) WriteDB() *Query {
 
 func (q *Query) ReplaceDB(db *gorm.DB) *Query {
 	return &Query{
-		db:           db,
-		CasbinRule:   q.CasbinRule.replaceDB(db),
-		JwtBlackList: q.JwtBlackList.replaceDB(db),
-		SysApis:      q.SysApis.replaceDB(db),
-		SysDepts:     q.SysDepts.replaceDB(db),
-		SysDictData:  q.SysDictData.replaceDB(db),
-		SysDictTypes: q.SysDictTypes.replaceDB(db),
-		SysDiscovery: q.SysDiscovery.replaceDB(db),
-		SysJobs:      q.SysJobs.replaceDB(db),
-		SysLogs:      q.SysLogs.replaceDB(db),
-		SysMenuBtns:  q.SysMenuBtns.replaceDB(db),
-		SysMenus:     q.SysMenus.replaceDB(db),
-		SysPosts:     q.SysPosts.replaceDB(db),
-		SysRoleBtns:  q.SysRoleBtns.replaceDB(db),
-		SysRoleDepts: q.SysRoleDepts.replaceDB(db),
-		SysRoleMenus: q.SysRoleMenus.replaceDB(db),
-		SysRoles:     q.SysRoles.replaceDB(db),
-		SysUsers:     q.SysUsers.replaceDB(db),
+		db:                db,
+		CasbinRule:        q.CasbinRule.replaceDB(db),
+		JwtBlackList:      q.JwtBlackList.replaceDB(db),
+		SysApis:           q.SysApis.replaceDB(db),
+		SysChangeRequests: q.SysChangeRequests.replaceDB(db),
+		SysDepts:          q.SysDepts.replaceDB(db),
+		SysDictData:       q.SysDictData.replaceDB(db),
+		SysDictTypes:      q.SysDictTypes.replaceDB(db),
+		SysDiscovery:      q.SysDiscovery.replaceDB(db),
+		SysExportTasks:    q.SysExportTasks.replaceDB(db),
+		SysJobs:           q.SysJobs.replaceDB(db),
+		SysLogChains:      q.SysLogChains.replaceDB(db),
+		SysLogChanges:     q.SysLogChanges.replaceDB(db),
+		SysLogCheckpoints: q.SysLogCheckpoints.replaceDB(db),
+		SysLoginLogs:      q.SysLoginLogs.replaceDB(db),
+		SysLogs:           q.SysLogs.replaceDB(db),
+		SysMenuBtns:       q.SysMenuBtns.replaceDB(db),
+		SysMenus:          q.SysMenus.replaceDB(db),
+		SysPosts:          q.SysPosts.replaceDB(db),
+		SysRoleBtns:       q.SysRoleBtns.replaceDB(db),
+		SysRoleDepts:      q.SysRoleDepts.replaceDB(db),
+		SysRoleMenus:      q.SysRoleMenus.replaceDB(db),
+		SysRoles:          q.SysRoles.replaceDB(db),
+		SysTempGrants:     q.SysTempGrants.replaceDB(db),
+		SysTranslations:   q.SysTranslations.replaceDB(db),
+		SysUsers:          q.SysUsers.replaceDB(db),
 	}
 }
 
 type queryCtx struct {
-	CasbinRule   *casbinRuleDo
-	JwtBlackList *jwtBlackListDo
-	SysApis      *sysApisDo
-	SysDepts     *sysDeptsDo
-	SysDictData  *sysDictDataDo
-	SysDictTypes *sysDictTypesDo
-	SysDiscovery *sysDiscoveryDo
-	SysJobs      *sysJobsDo
-	SysLogs      *sysLogsDo
-	SysMenuBtns  *sysMenuBtnsDo
-	SysMenus     *sysMenusDo
-	SysPosts     *sysPostsDo
-	SysRoleBtns  *sysRoleBtnsDo
-	SysRoleDepts *sysRoleDeptsDo
-	SysRoleMenus *sysRoleMenusDo
-	SysRoles     *sysRolesDo
-	SysUsers     *sysUsersDo
+	CasbinRule        *casbinRuleDo
+	JwtBlackList      *jwtBlackListDo
+	SysApis           *sysApisDo
+	SysChangeRequests *sysChangeRequestsDo
+	SysDepts          *sysDeptsDo
+	SysDictData       *sysDictDataDo
+	SysDictTypes      *sysDictTypesDo
+	SysDiscovery      *sysDiscoveryDo
+	SysExportTasks    *sysExportTasksDo
+	SysJobs           *sysJobsDo
+	SysLogChains      *sysLogChainsDo
+	SysLogChanges     *sysLogChangesDo
+	SysLogCheckpoints *sysLogCheckpointsDo
+	SysLoginLogs      *sysLoginLogsDo
+	SysLogs           *sysLogsDo
+	SysMenuBtns       *sysMenuBtnsDo
+	SysMenus          *sysMenusDo
+	SysPosts          *sysPostsDo
+	SysRoleBtns       *sysRoleBtnsDo
+	SysRoleDepts      *sysRoleDeptsDo
+	SysRoleMenus      *sysRoleMenusDo
+	SysRoles          *sysRolesDo
+	SysTempGrants     *sysTempGrantsDo
+	SysTranslations   *sysTranslationsDo
+	SysUsers          *sysUsersDo
 }
 
 func (q *Query) WithContext(ctx context.Context) *queryCtx {
 	return &queryCtx{
-		CasbinRule:   q.CasbinRule.WithContext(ctx),
-		JwtBlackList: q.JwtBlackList.WithContext(ctx),
-		SysApis:      q.SysApis.WithContext(ctx),
-		SysDepts:     q.SysDepts.WithContext(ctx),
-		SysDictData:  q.SysDictData.WithContext(ctx),
-		SysDictTypes: q.SysDictTypes.WithContext(ctx),
-		SysDiscovery: q.SysDiscovery.WithContext(ctx),
-		SysJobs:      q.SysJobs.WithContext(ctx),
-		SysLogs:      q.SysLogs.WithContext(ctx),
-		SysMenuBtns:  q.SysMenuBtns.WithContext(ctx),
-		SysMenus:     q.SysMenus.WithContext(ctx),
-		SysPosts:     q.SysPosts.WithContext(ctx),
-		SysRoleBtns:  q.SysRoleBtns.WithContext(ctx),
-		SysRoleDepts: q.SysRoleDepts.WithContext(ctx),
-		SysRoleMenus: q.SysRoleMenus.WithContext(ctx),
-		SysRoles:     q.SysRoles.WithContext(ctx),
-		SysUsers:     q.SysUsers.WithContext(ctx),
+		CasbinRule:        q.CasbinRule.WithContext(ctx),
+		JwtBlackList:      q.JwtBlackList.WithContext(ctx),
+		SysApis:           q.SysApis.WithContext(ctx),
+		SysChangeRequests: q.SysChangeRequests.WithContext(ctx),
+		SysDepts:          q.SysDepts.WithContext(ctx),
+		SysDictData:       q.SysDictData.WithContext(ctx),
+		SysDictTypes:      q.SysDictTypes.WithContext(ctx),
+		SysDiscovery:      q.SysDiscovery.WithContext(ctx),
+		SysExportTasks:    q.SysExportTasks.WithContext(ctx),
+		SysJobs:           q.SysJobs.WithContext(ctx),
+		SysLogChains:      q.SysLogChains.WithContext(ctx),
+		SysLogChanges:     q.SysLogChanges.WithContext(ctx),
+		SysLogCheckpoints: q.SysLogCheckpoints.WithContext(ctx),
+		SysLoginLogs:      q.SysLoginLogs.WithContext(ctx),
+		SysLogs:           q.SysLogs.WithContext(ctx),
+		SysMenuBtns:       q.SysMenuBtns.WithContext(ctx),
+		SysMenus:          q.SysMenus.WithContext(ctx),
+		SysPosts:          q.SysPosts.WithContext(ctx),
+		SysRoleBtns:       q.SysRoleBtns.WithContext(ctx),
+		SysRoleDepts:      q.SysRoleDepts.WithContext(ctx),
+		SysRoleMenus:      q.SysRoleMenus.WithContext(ctx),
+		SysRoles:          q.SysRoles.WithContext(ctx),
+		SysTempGrants:     q.SysTempGrants.WithContext(ctx),
+		SysTranslations:   q.SysTranslations.WithContext(ctx),
+		SysUsers:          q.SysUsers.WithContext(ctx),
 	}
 }
 
@@ -41,6 +41,19 @@ func newSysLogs(db *gorm.DB, opts ...gen.DOOption) sysLogs {
 	_sysLogs.Body = field.NewString(tableName, "body")
 	_sysLogs.Resp = field.NewString(tableName, "resp")
 	_sysLogs.UserID = field.NewInt64(tableName, "user_id")
+	_sysLogs.ImpersonatorID = field.NewInt64(tableName, "impersonator_id")
+	_sysLogs.Operation = field.NewString(tableName, "operation")
+	_sysLogs.Reason = field.NewString(tableName, "reason")
+	_sysLogs.ErrorCode = field.NewInt32(tableName, "error_code")
+	_sysLogs.TraceID = field.NewString(tableName, "trace_id")
+	_sysLogs.Seq = field.NewInt64(tableName, "seq")
+	_sysLogs.PrevHash = field.NewString(tableName, "prev_hash")
+	_sysLogs.Hash = field.NewString(tableName, "hash")
+	_sysLogs.Changes = sysLogsHasManyChanges{
+		db: db.Session(&gorm.Session{}),
+
+		RelationField: field.NewRelation("Changes", "model.SysLogChanges"),
+	}
 
 	_sysLogs.fillFieldMap()
 
@@ -50,21 +63,30 @@ func newSysLogs(db *gorm.DB, opts ...gen.DOOption) sysLogs {
 type sysLogs struct {
 	sysLogsDo sysLogsDo
 
-	ALL          field.Asterisk
-	ID           field.Int64
-	CreatedAt    field.Time
-	UpdatedAt    field.Time
-	DeletedAt    field.Field
-	IP           field.String // 请求ip
-	Method       field.String // 请求方法
-	Path         field.String // 请求路径
-	Status       field.Int64  // 请求状态
-	Latency      field.Int64  // 延迟
-	Agent        field.String // 代理
-	ErrorMessage field.String // 错误信息
-	Body         field.String // 请求Body
-	Resp         field.String // 响应Body
-	UserID       field.Int64  // 用户id
+	ALL            field.Asterisk
+	ID             field.Int64
+	CreatedAt      field.Time
+	UpdatedAt      field.Time
+	DeletedAt      field.Field
+	IP             field.String // 请求ip
+	Method         field.String // 请求方法
+	Path           field.String // 请求路径
+	Status         field.Int64  // 请求状态
+	Latency        field.Int64  // 延迟
+	Agent          field.String // 代理
+	ErrorMessage   field.String // 错误信息
+	Body           field.String // 请求Body
+	Resp           field.String // 响应Body
+	UserID         field.Int64  // 用户id
+	ImpersonatorID field.Int64  // 模拟登录操作人id
+	Operation      field.String // 操作
+	Reason         field.String // 错误原因
+	ErrorCode      field.Int32  // 错误码
+	TraceID        field.String // 链路id
+	Seq            field.Int64  // 审计链序号，0 表示未开启审计时写入
+	PrevHash       field.String // 上一条记录的哈希
+	Hash           field.String // 本条记录的哈希
+	Changes        sysLogsHasManyChanges
 
 	fieldMap map[string]field.Expr
 }
@@ -95,6 +117,14 @@ func (s *sysLogs) updateTableName(table string) *sysLogs {
 	s.Body = field.NewString(table, "body")
 	s.Resp = field.NewString(table, "resp")
 	s.UserID = field.NewInt64(table, "user_id")
+	s.ImpersonatorID = field.NewInt64(table, "impersonator_id")
+	s.Operation = field.NewString(table, "operation")
+	s.Reason = field.NewString(table, "reason")
+	s.ErrorCode = field.NewInt32(table, "error_code")
+	s.TraceID = field.NewString(table, "trace_id")
+	s.Seq = field.NewInt64(table, "seq")
+	s.PrevHash = field.NewString(table, "prev_hash")
+	s.Hash = field.NewString(table, "hash")
 
 	s.fillFieldMap()
 
@@ -117,7 +147,7 @@ func (s *sysLogs) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sysLogs) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 14)
+	s.fieldMap = make(map[string]field.Expr, 23)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["created_at"] = s.CreatedAt
 	s.fieldMap["updated_at"] = s.UpdatedAt
@@ -132,6 +162,15 @@ func (s *sysLogs) fillFieldMap() {
 	s.fieldMap["body"] = s.Body
 	s.fieldMap["resp"] = s.Resp
 	s.fieldMap["user_id"] = s.UserID
+	s.fieldMap["impersonator_id"] = s.ImpersonatorID
+	s.fieldMap["operation"] = s.Operation
+	s.fieldMap["reason"] = s.Reason
+	s.fieldMap["error_code"] = s.ErrorCode
+	s.fieldMap["trace_id"] = s.TraceID
+	s.fieldMap["seq"] = s.Seq
+	s.fieldMap["prev_hash"] = s.PrevHash
+	s.fieldMap["hash"] = s.Hash
+
 }
 
 func (s sysLogs) clone(db *gorm.DB) sysLogs {
@@ -144,6 +183,77 @@ func (s sysLogs) replaceDB(db *gorm.DB) sysLogs {
 	return s
 }
 
+type sysLogsHasManyChanges struct {
+	db *gorm.DB
+
+	field.RelationField
+}
+
+func (a sysLogsHasManyChanges) Where(conds ...field.Expr) *sysLogsHasManyChanges {
+	if len(conds) == 0 {
+		return &a
+	}
+
+	exprs := make([]clause.Expression, 0, len(conds))
+	for _, cond := range conds {
+		exprs = append(exprs, cond.BeCond().(clause.Expression))
+	}
+	a.db = a.db.Clauses(clause.Where{Exprs: exprs})
+	return &a
+}
+
+func (a sysLogsHasManyChanges) WithContext(ctx context.Context) *sysLogsHasManyChanges {
+	a.db = a.db.WithContext(ctx)
+	return &a
+}
+
+func (a sysLogsHasManyChanges) Session(session *gorm.Session) *sysLogsHasManyChanges {
+	a.db = a.db.Session(session)
+	return &a
+}
+
+func (a sysLogsHasManyChanges) Model(m *model.SysLogs) *sysLogsHasManyChangesTx {
+	return &sysLogsHasManyChangesTx{a.db.Model(m).Association(a.Name())}
+}
+
+type sysLogsHasManyChangesTx struct{ tx *gorm.Association }
+
+func (a sysLogsHasManyChangesTx) Find() (result []*model.SysLogChanges, err error) {
+	return result, a.tx.Find(&result)
+}
+
+func (a sysLogsHasManyChangesTx) Append(values ...*model.SysLogChanges) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Append(targetValues...)
+}
+
+func (a sysLogsHasManyChangesTx) Replace(values ...*model.SysLogChanges) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Replace(targetValues...)
+}
+
+func (a sysLogsHasManyChangesTx) Delete(values ...*model.SysLogChanges) (err error) {
+	targetValues := make([]interface{}, len(values))
+	for i, v := range values {
+		targetValues[i] = v
+	}
+	return a.tx.Delete(targetValues...)
+}
+
+func (a sysLogsHasManyChangesTx) Clear() error {
+	return a.tx.Clear()
+}
+
+func (a sysLogsHasManyChangesTx) Count() int64 {
+	return a.tx.Count()
+}
+
 type sysLogsDo struct{ gen.DO }
 
 func (s sysLogsDo) Debug() *sysLogsDo {
@@ -50,6 +50,9 @@ func newSysUsers(db *gorm.DB, opts ...gen.DOOption) sysUsers {
 	_sysUsers.UpdatedAt = field.NewTime(tableName, "updated_at")
 	_sysUsers.DeletedAt = field.NewField(tableName, "deleted_at")
 	_sysUsers.Secret = field.NewString(tableName, "secret")
+	_sysUsers.Locale = field.NewString(tableName, "locale")
+	_sysUsers.MustChangePassword = field.NewBool(tableName, "must_change_password")
+	_sysUsers.TokenRevokedAt = field.NewInt64(tableName, "token_revoked_at")
 
 	_sysUsers.fillFieldMap()
 
@@ -59,30 +62,33 @@ func newSysUsers(db *gorm.DB, opts ...gen.DOOption) sysUsers {
 type sysUsers struct {
 	sysUsersDo sysUsersDo
 
-	ALL       field.Asterisk
-	ID        field.Int64  // 主键id
-	UUID      field.String // 用户UUID
-	Username  field.String // 用户名(登入)
-	NickName  field.String // 昵称
-	Password  field.String // 密码
-	Phone     field.String // 手机
-	RoleID    field.Int64  // 角色id
-	Salt      field.String // 盐
-	Avatar    field.String // 头像
-	Sex       field.Int32  // 性别 0-未知 1-男 2-女
-	Email     field.String // 邮箱
-	DeptID    field.Int64  // 部门id
-	PostID    field.Int64  // 岗位id
-	Remark    field.String // 备注
-	Status    field.Int32  // 1=正常 2=异常
-	RoleIds   field.String // 多角色
-	PostIds   field.String // 多岗位
-	CreateBy  field.String // 创建人
-	UpdateBy  field.String // 更新人
-	CreatedAt field.Time   // 创建时间
-	UpdatedAt field.Time   // 更新时间
-	DeletedAt field.Field  // 删除时间
-	Secret    field.String // google密钥
+	ALL                field.Asterisk
+	ID                 field.Int64  // 主键id
+	UUID               field.String // 用户UUID
+	Username           field.String // 用户名(登入)
+	NickName           field.String // 昵称
+	Password           field.String // 密码
+	Phone              field.String // 手机
+	RoleID             field.Int64  // 角色id
+	Salt               field.String // 盐
+	Avatar             field.String // 头像
+	Sex                field.Int32  // 性别 0-未知 1-男 2-女
+	Email              field.String // 邮箱
+	DeptID             field.Int64  // 部门id
+	PostID             field.Int64  // 岗位id
+	Remark             field.String // 备注
+	Status             field.Int32  // 1=正常 2=异常
+	RoleIds            field.String // 多角色
+	PostIds            field.String // 多岗位
+	CreateBy           field.String // 创建人
+	UpdateBy           field.String // 更新人
+	CreatedAt          field.Time   // 创建时间
+	UpdatedAt          field.Time   // 更新时间
+	DeletedAt          field.Field  // 删除时间
+	Secret             field.String // google密钥
+	Locale             field.String // 语言偏好，为空时按浏览器语言
+	MustChangePassword field.Bool   // 下次登录必须修改密码
+	TokenRevokedAt     field.Int64  // 该时间（unix 秒）及之前签发的token失效
 
 	fieldMap map[string]field.Expr
 }
@@ -122,6 +128,9 @@ func (s *sysUsers) updateTableName(table string) *sysUsers {
 	s.UpdatedAt = field.NewTime(table, "updated_at")
 	s.DeletedAt = field.NewField(table, "deleted_at")
 	s.Secret = field.NewString(table, "secret")
+	s.Locale = field.NewString(table, "locale")
+	s.MustChangePassword = field.NewBool(table, "must_change_password")
+	s.TokenRevokedAt = field.NewInt64(table, "token_revoked_at")
 
 	s.fillFieldMap()
 
@@ -144,7 +153,7 @@ func (s *sysUsers) GetFieldByName(fieldName string) (field.OrderExpr, bool) {
 }
 
 func (s *sysUsers) fillFieldMap() {
-	s.fieldMap = make(map[string]field.Expr, 23)
+	s.fieldMap = make(map[string]field.Expr, 26)
 	s.fieldMap["id"] = s.ID
 	s.fieldMap["uuid"] = s.UUID
 	s.fieldMap["username"] = s.Username
@@ -168,6 +177,9 @@ func (s *sysUsers) fillFieldMap() {
 	s.fieldMap["updated_at"] = s.UpdatedAt
 	s.fieldMap["deleted_at"] = s.DeletedAt
 	s.fieldMap["secret"] = s.Secret
+	s.fieldMap["locale"] = s.Locale
+	s.fieldMap["must_change_password"] = s.MustChangePassword
+	s.fieldMap["token_revoked_at"] = s.TokenRevokedAt
 }
 
 func (s sysUsers) clone(db *gorm.DB) sysUsers {
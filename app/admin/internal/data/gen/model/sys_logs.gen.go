@@ -14,20 +14,29 @@ const TableNameSysLogs = "sys_logs"
 
 // SysLogs mapped from table <sys_logs>
 type SysLogs struct {
-	ID           int64          `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
-	CreatedAt    time.Time      `gorm:"column:created_at" json:"created_at"`
-	UpdatedAt    time.Time      `gorm:"column:updated_at" json:"updated_at"`
-	DeletedAt    gorm.DeletedAt `gorm:"column:deleted_at" json:"deleted_at"`
-	IP           string         `gorm:"column:ip;comment:请求ip" json:"ip"`
-	Method       string         `gorm:"column:method;comment:请求方法" json:"method"`
-	Path         string         `gorm:"column:path;comment:请求路径" json:"path"`
-	Status       int64          `gorm:"column:status;comment:请求状态" json:"status"`
-	Latency      int64          `gorm:"column:latency;comment:延迟" json:"latency"`
-	Agent        string         `gorm:"column:agent;comment:代理" json:"agent"`
-	ErrorMessage string         `gorm:"column:error_message;comment:错误信息" json:"error_message"`
-	Body         string         `gorm:"column:body;comment:请求Body" json:"body"`
-	Resp         string         `gorm:"column:resp;comment:响应Body" json:"resp"`
-	UserID       int64          `gorm:"column:user_id;comment:用户id" json:"user_id"`
+	ID             int64           `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
+	CreatedAt      time.Time       `gorm:"column:created_at" json:"created_at"`
+	UpdatedAt      time.Time       `gorm:"column:updated_at" json:"updated_at"`
+	DeletedAt      gorm.DeletedAt  `gorm:"column:deleted_at" json:"deleted_at"`
+	IP             string          `gorm:"column:ip;comment:请求ip" json:"ip"`
+	Method         string          `gorm:"column:method;comment:请求方法" json:"method"`
+	Path           string          `gorm:"column:path;comment:请求路径" json:"path"`
+	Status         int64           `gorm:"column:status;comment:请求状态" json:"status"`
+	Latency        int64           `gorm:"column:latency;comment:延迟" json:"latency"`
+	Agent          string          `gorm:"column:agent;comment:代理" json:"agent"`
+	ErrorMessage   string          `gorm:"column:error_message;comment:错误信息" json:"error_message"`
+	Body           string          `gorm:"column:body;comment:请求Body" json:"body"`
+	Resp           string          `gorm:"column:resp;comment:响应Body" json:"resp"`
+	UserID         int64           `gorm:"column:user_id;comment:用户id" json:"user_id"`
+	ImpersonatorID int64           `gorm:"column:impersonator_id;comment:模拟登录操作人id" json:"impersonator_id"`
+	Operation      string          `gorm:"column:operation;comment:操作" json:"operation"`
+	Reason         string          `gorm:"column:reason;comment:错误原因" json:"reason"`
+	ErrorCode      int32           `gorm:"column:error_code;comment:错误码" json:"error_code"`
+	TraceID        string          `gorm:"column:trace_id;comment:链路id" json:"trace_id"`
+	Seq            int64           `gorm:"column:seq;comment:审计链序号，0 表示未开启审计时写入" json:"seq"`
+	PrevHash       string          `gorm:"column:prev_hash;comment:上一条记录的哈希" json:"prev_hash"`
+	Hash           string          `gorm:"column:hash;comment:本条记录的哈希" json:"hash"`
+	Changes        []SysLogChanges `gorm:"foreignKey:LogID" json:"changes"`
 }
 
 // TableName SysLogs's table name
@@ -14,29 +14,32 @@ const TableNameSysUsers = "sys_users"
 
 // SysUsers mapped from table <sys_users>
 type SysUsers struct {
-	ID        int64          `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键id" json:"id"`
-	UUID      string         `gorm:"column:uuid;not null;comment:用户UUID" json:"uuid"`
-	Username  string         `gorm:"column:username;not null;comment:用户名(登入)" json:"username"`
-	NickName  string         `gorm:"column:nick_name;not null;comment:昵称" json:"nick_name"`
-	Password  string         `gorm:"column:password;not null;comment:密码" json:"password"`
-	Phone     string         `gorm:"column:phone;not null;comment:手机" json:"phone"`
-	RoleID    int64          `gorm:"column:role_id;not null;comment:角色id" json:"role_id"`
-	Salt      string         `gorm:"column:salt;not null;comment:盐" json:"salt"`
-	Avatar    string         `gorm:"column:avatar;not null;comment:头像" json:"avatar"`
-	Sex       int32          `gorm:"column:sex;not null;comment:性别 0-未知 1-男 2-女" json:"sex"`
-	Email     string         `gorm:"column:email;not null;comment:邮箱" json:"email"`
-	DeptID    int64          `gorm:"column:dept_id;not null;comment:部门id" json:"dept_id"`
-	PostID    int64          `gorm:"column:post_id;not null;comment:岗位id" json:"post_id"`
-	Remark    string         `gorm:"column:remark;not null;comment:备注" json:"remark"`
-	Status    int32          `gorm:"column:status;not null;default:1;comment:1=正常 2=异常" json:"status"`
-	RoleIds   string         `gorm:"column:role_ids;not null;comment:多角色" json:"role_ids"`
-	PostIds   string         `gorm:"column:post_ids;not null;comment:多岗位" json:"post_ids"`
-	CreateBy  string         `gorm:"column:create_by;not null;comment:创建人" json:"create_by"`
-	UpdateBy  string         `gorm:"column:update_by;not null;comment:更新人" json:"update_by"`
-	CreatedAt time.Time      `gorm:"column:created_at;comment:创建时间" json:"created_at"`
-	UpdatedAt time.Time      `gorm:"column:updated_at;comment:更新时间" json:"updated_at"`
-	DeletedAt gorm.DeletedAt `gorm:"column:deleted_at;comment:删除时间" json:"deleted_at"`
-	Secret    string         `gorm:"column:secret;not null;comment:google密钥" json:"secret"`
+	ID                 int64          `gorm:"column:id;primaryKey;autoIncrement:true;comment:主键id" json:"id"`
+	UUID               string         `gorm:"column:uuid;not null;comment:用户UUID" json:"uuid"`
+	Username           string         `gorm:"column:username;not null;comment:用户名(登入)" json:"username"`
+	NickName           string         `gorm:"column:nick_name;not null;comment:昵称" json:"nick_name"`
+	Password           string         `gorm:"column:password;not null;comment:密码" json:"password"`
+	Phone              string         `gorm:"column:phone;not null;comment:手机" json:"phone"`
+	RoleID             int64          `gorm:"column:role_id;not null;comment:角色id" json:"role_id"`
+	Salt               string         `gorm:"column:salt;not null;comment:盐" json:"salt"`
+	Avatar             string         `gorm:"column:avatar;not null;comment:头像" json:"avatar"`
+	Sex                int32          `gorm:"column:sex;not null;comment:性别 0-未知 1-男 2-女" json:"sex"`
+	Email              string         `gorm:"column:email;not null;comment:邮箱" json:"email"`
+	DeptID             int64          `gorm:"column:dept_id;not null;comment:部门id" json:"dept_id"`
+	PostID             int64          `gorm:"column:post_id;not null;comment:岗位id" json:"post_id"`
+	Remark             string         `gorm:"column:remark;not null;comment:备注" json:"remark"`
+	Status             int32          `gorm:"column:status;not null;default:1;comment:1=正常 2=异常" json:"status"`
+	RoleIds            string         `gorm:"column:role_ids;not null;comment:多角色" json:"role_ids"`
+	PostIds            string         `gorm:"column:post_ids;not null;comment:多岗位" json:"post_ids"`
+	CreateBy           string         `gorm:"column:create_by;not null;comment:创建人" json:"create_by"`
+	UpdateBy           string         `gorm:"column:update_by;not null;comment:更新人" json:"update_by"`
+	CreatedAt          time.Time      `gorm:"column:created_at;comment:创建时间" json:"created_at"`
+	UpdatedAt          time.Time      `gorm:"column:updated_at;comment:更新时间" json:"updated_at"`
+	DeletedAt          gorm.DeletedAt `gorm:"column:deleted_at;comment:删除时间" json:"deleted_at"`
+	Secret             string         `gorm:"column:secret;not null;comment:google密钥" json:"secret"`
+	Locale             string         `gorm:"column:locale;not null;comment:语言偏好，为空时按浏览器语言" json:"locale"`
+	MustChangePassword bool           `gorm:"column:must_change_password;not null;comment:下次登录必须修改密码" json:"must_change_password"`
+	TokenRevokedAt     int64          `gorm:"column:token_revoked_at;not null;comment:该时间（unix 秒）及之前签发的token失效" json:"token_revoked_at"`
 }
 
 // TableName SysUsers's table name
@@ -2,9 +2,11 @@ package data
 
 import (
 	"context"
+	"errors"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/redis/go-redis/v9"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
 )
 
@@ -67,6 +69,18 @@ func (r RedisRepo) Get(ctx context.Context, key string) string {
 	return r.data.rdb.Get(ctx, key).Val()
 }
 
+func (r RedisRepo) GetDel(ctx context.Context, key string) (string, error) {
+	val, err := r.data.rdb.GetDel(ctx, key).Result()
+	if errors.Is(err, redis.Nil) {
+		return "", nil
+	}
+	return val, err
+}
+
+func (r RedisRepo) Del(ctx context.Context, keys ...string) error {
+	return r.data.rdb.Del(ctx, keys...).Err()
+}
+
 func (r RedisRepo) SRem(ctx context.Context, key string, members ...interface{}) (int64, error) {
 	return r.data.rdb.SRem(ctx, key, members).Result()
 }
@@ -2,6 +2,7 @@ package authz
 
 import (
 	"context"
+	"strconv"
 	"time"
 
 	"github.com/go-kratos/kratos/v2/errors"
@@ -22,9 +23,28 @@ type TokenClaims struct {
 	RoleID   int64  `json:"role_id"`
 	RoleKey  string `json:"role_key"`
 	Nickname string `json:"nickname"`
+	// ImpersonatorID 模拟登录时的真实操作人id，正常登录为0
+	ImpersonatorID int64 `json:"impersonator_id,omitempty"`
+	// Locale 用户的语言偏好，为空时按 Accept-Language
+	Locale string `json:"locale,omitempty"`
 	jwtV5.RegisteredClaims
 }
 
+// IsImpersonated 是否为模拟登录的token
+func (c *TokenClaims) IsImpersonated() bool {
+	return c.ImpersonatorID > 0
+}
+
+// IssuedBefore token 是否在 unix 秒 t 及之前签发，没有签发时间的旧 token 视为最早签发
+func (c *TokenClaims) IssuedBefore(t int64) bool {
+	return c.IssuedAt == nil || c.IssuedAt.Unix() <= t
+}
+
+// UserSubject 用户级 casbin 主体，临时授权的策略挂在该主体上
+func UserSubject(userID int64) string {
+	return "user:" + strconv.FormatInt(userID, 10)
+}
+
 type securityUser struct {
 	Path        string
 	Method      string
@@ -87,14 +107,34 @@ func MustFromContext(ctx context.Context) *TokenClaims {
 	return claims
 }
 
-func NewToken(key string, expireAt time.Time, userID, roleID int64, roleKey, nickname string) (string, error) {
+func NewToken(key string, expireAt time.Time, userID, roleID int64, roleKey, nickname, locale string) (string, error) {
 	claims := jwtV5.NewWithClaims(jwtV5.SigningMethodHS256, &TokenClaims{
 		UserID:   userID,
 		RoleID:   roleID,
 		Nickname: nickname,
 		RoleKey:  roleKey,
+		Locale:   locale,
+		RegisteredClaims: jwtV5.RegisteredClaims{
+			Issuer:    "admin",
+			IssuedAt:  jwtV5.NewNumericDate(time.Now()),
+			ExpiresAt: jwtV5.NewNumericDate(expireAt),
+		},
+	})
+	return claims.SignedString([]byte(key))
+}
+
+// NewImpersonateToken 生成模拟登录token，同时携带被模拟用户和真实操作人id，语言沿用真实操作人的偏好
+func NewImpersonateToken(key string, expireAt time.Time, userID, roleID int64, roleKey, nickname string, impersonatorID int64, locale string) (string, error) {
+	claims := jwtV5.NewWithClaims(jwtV5.SigningMethodHS256, &TokenClaims{
+		UserID:         userID,
+		RoleID:         roleID,
+		Nickname:       nickname,
+		RoleKey:        roleKey,
+		ImpersonatorID: impersonatorID,
+		Locale:         locale,
 		RegisteredClaims: jwtV5.RegisteredClaims{
 			Issuer:    "admin",
+			IssuedAt:  jwtV5.NewNumericDate(time.Now()),
 			ExpiresAt: jwtV5.NewNumericDate(expireAt),
 		},
 	})
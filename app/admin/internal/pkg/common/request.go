@@ -0,0 +1,93 @@
+package common
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"strings"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+)
+
+// trustedProxies 可信的反向代理网段，为空时不信任任何转发头
+var trustedProxies atomic.Pointer[[]*net.IPNet]
+
+// SetTrustedProxies 设置可信的反向代理，元素为 IP 或 CIDR
+func SetTrustedProxies(proxies []string) error {
+	nets := make([]*net.IPNet, 0, len(proxies))
+	for _, proxy := range proxies {
+		proxy = strings.TrimSpace(proxy)
+		if !strings.Contains(proxy, "/") {
+			ip := net.ParseIP(proxy)
+			if ip == nil {
+				return fmt.Errorf("invalid trusted proxy: %s", proxy)
+			}
+			bits := 8 * net.IPv4len
+			if ip.To4() == nil {
+				bits = 8 * net.IPv6len
+			}
+			proxy = fmt.Sprintf("%s/%d", proxy, bits)
+		}
+		_, ipNet, err := net.ParseCIDR(proxy)
+		if err != nil {
+			return fmt.Errorf("invalid trusted proxy: %s", proxy)
+		}
+		nets = append(nets, ipNet)
+	}
+	trustedProxies.Store(&nets)
+	return nil
+}
+
+func isTrustedProxy(ip net.IP) bool {
+	nets := trustedProxies.Load()
+	if nets == nil || ip == nil {
+		return false
+	}
+	for _, ipNet := range *nets {
+		if ipNet.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+// ClientIP 获取客户端 IP。只有直连地址是可信代理时才采用 X-Forwarded-For，
+// 从右向左取第一个不是可信代理的地址，其次是 X-Real-IP；转发头中不是合法 IP 的值被忽略
+func ClientIP(req *http.Request) string {
+	if req == nil {
+		return ""
+	}
+	remote := req.RemoteAddr
+	if host, _, err := net.SplitHostPort(remote); err == nil {
+		remote = host
+	}
+	if !isTrustedProxy(net.ParseIP(remote)) {
+		return remote
+	}
+	if forwarded := req.Header.Values("X-Forwarded-For"); len(forwarded) > 0 {
+		hops := strings.Split(strings.Join(forwarded, ","), ",")
+		for i := len(hops) - 1; i >= 0; i-- {
+			ip := net.ParseIP(strings.TrimSpace(hops[i]))
+			if ip == nil {
+				break
+			}
+			if !isTrustedProxy(ip) {
+				return ip.String()
+			}
+		}
+	}
+	if ip := net.ParseIP(strings.TrimSpace(req.Header.Get("X-Real-IP"))); ip != nil {
+		return ip.String()
+	}
+	return remote
+}
+
+// RequestInfo 从 context 中获取当前 HTTP 请求的客户端 IP 和 User-Agent
+func RequestInfo(ctx context.Context) (ip, userAgent string) {
+	req, ok := http.RequestFromServerContext(ctx)
+	if !ok {
+		return "", ""
+	}
+	return ClientIP(req), req.Header.Get("User-Agent")
+}
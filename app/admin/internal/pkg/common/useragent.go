@@ -0,0 +1,80 @@
+package common
+
+import (
+	"strings"
+)
+
+// uaRule User-Agent 匹配规则，按顺序匹配第一个包含 token 的规则，
+// version 不为空时从 version 之后读取版本号，否则从 token 之后读取
+type uaRule struct {
+	token   string
+	name    string
+	version string
+}
+
+// browserRules Edge、Opera 等基于 Chrome 的浏览器需在 Chrome 之前匹配
+var browserRules = []uaRule{
+	{"Edg/", "Edge", ""},
+	{"Edge/", "Edge", ""},
+	{"OPR/", "Opera", ""},
+	{"Opera", "Opera", ""},
+	{"MicroMessenger/", "WeChat", ""},
+	{"DingTalk/", "DingTalk", ""},
+	{"Firefox/", "Firefox", ""},
+	{"Chrome/", "Chrome", ""},
+	{"CriOS/", "Chrome", ""},
+	{"Safari/", "Safari", "Version/"},
+	{"MSIE ", "IE", ""},
+	{"Trident/", "IE", ""},
+	{"curl/", "curl", ""},
+	{"PostmanRuntime/", "Postman", ""},
+}
+
+// osRules Android 的 User-Agent 同时包含 Linux，需在 Linux 之前匹配
+var osRules = []uaRule{
+	{"Windows NT", "Windows", ""},
+	{"iPhone", "iOS", ""},
+	{"iPad", "iPadOS", ""},
+	{"Mac OS X", "macOS", ""},
+	{"Android", "Android", ""},
+	{"HarmonyOS", "HarmonyOS", ""},
+	{"CrOS", "ChromeOS", ""},
+	{"Linux", "Linux", ""},
+}
+
+// ParseUserAgent 解析浏览器和操作系统名称，带主版本号，无法识别时返回 Unknown
+func ParseUserAgent(ua string) (browser, os string) {
+	browser, os = "Unknown", "Unknown"
+	for _, rule := range browserRules {
+		if !strings.Contains(ua, rule.token) {
+			continue
+		}
+		browser = rule.name
+		token := rule.version
+		if token == "" {
+			token = rule.token
+		}
+		if idx := strings.Index(ua, token); idx != -1 && strings.HasSuffix(token, "/") {
+			if v := majorVersion(ua[idx+len(token):]); v != "" {
+				browser += " " + v
+			}
+		}
+		break
+	}
+	for _, rule := range osRules {
+		if strings.Contains(ua, rule.token) {
+			os = rule.name
+			break
+		}
+	}
+	return
+}
+
+// majorVersion 取版本号中第一个点之前的部分
+func majorVersion(s string) string {
+	end := 0
+	for end < len(s) && s[end] >= '0' && s[end] <= '9' {
+		end++
+	}
+	return s[:end]
+}
@@ -0,0 +1,33 @@
+package export
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"strconv"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// csvMaxRows 读取 csv 的最大行数，与 xlsx 一致
+const csvMaxRows = xlsxMaxRows
+
+// ReadCSV 读取 csv 的所有行，忽略 UTF-8 BOM，各行的列数可以不同
+func ReadCSV(data []byte) ([][]string, error) {
+	r := csv.NewReader(bytes.NewReader(bytes.TrimPrefix(data, []byte("\xEF\xBB\xBF"))))
+	r.FieldsPerRecord = -1
+	var rows [][]string
+	for {
+		row, err := r.Read()
+		if err == io.EOF {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, i18n.NewError("import.csvInvalid", "不是有效的 csv 文件: "+err.Error(), err.Error())
+		}
+		if len(rows) >= csvMaxRows {
+			return nil, i18n.NewError("import.tooManyRows", "csv 文件行数超过 "+strconv.Itoa(csvMaxRows), strconv.Itoa(csvMaxRows))
+		}
+		rows = append(rows, row)
+	}
+}
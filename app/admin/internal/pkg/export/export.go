@@ -0,0 +1,87 @@
+package export
+
+import (
+	"encoding/csv"
+	"io"
+	"strings"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// 导出文件格式
+const (
+	FormatCSV  = "csv"
+	FormatXLSX = "xlsx"
+)
+
+// Writer 按行写入导出文件
+type Writer interface {
+	Write(row []string) error
+	// Close 写入文件结尾，不关闭底层的 io.Writer
+	Close() error
+}
+
+// NormalizeFormat 格式为空时默认 xlsx，不支持的格式返回错误
+func NormalizeFormat(format string) (string, error) {
+	switch strings.ToLower(format) {
+	case "", FormatXLSX:
+		return FormatXLSX, nil
+	case FormatCSV:
+		return FormatCSV, nil
+	default:
+		return "", i18n.NewError("export.formatUnsupported", "不支持的导出格式: "+format, format)
+	}
+}
+
+// ContentType 返回格式对应的 Content-Type
+func ContentType(format string) string {
+	if format == FormatCSV {
+		return "text/csv; charset=utf-8"
+	}
+	return "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet"
+}
+
+// NewWriter 创建对应格式的 Writer
+func NewWriter(format string, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatCSV:
+		return newCSVWriter(w)
+	case FormatXLSX:
+		return newXLSXWriter(w)
+	default:
+		return nil, i18n.NewError("export.formatUnsupported", "不支持的导出格式: "+format, format)
+	}
+}
+
+type csvWriter struct {
+	w *csv.Writer
+}
+
+// newCSVWriter 写入 UTF-8 BOM，Excel 打开时不会乱码
+func newCSVWriter(w io.Writer) (*csvWriter, error) {
+	if _, err := io.WriteString(w, "\xEF\xBB\xBF"); err != nil {
+		return nil, err
+	}
+	return &csvWriter{w: csv.NewWriter(w)}, nil
+}
+
+func (c *csvWriter) Write(row []string) error {
+	cells := make([]string, len(row))
+	for i, cell := range row {
+		cells[i] = escapeFormula(cell)
+	}
+	return c.w.Write(cells)
+}
+
+func (c *csvWriter) Close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// escapeFormula 以公式字符开头的单元格加单引号，避免在 Excel 中被当作公式执行
+func escapeFormula(cell string) string {
+	if cell != "" && strings.ContainsRune("=+-@\t\r", rune(cell[0])) {
+		return "'" + cell
+	}
+	return cell
+}
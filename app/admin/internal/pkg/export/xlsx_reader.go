@@ -0,0 +1,253 @@
+package export
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"io"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// 读取 xlsx 的限制，防止超大文件或伪造的单元格引用占用过多内存
+const (
+	// xlsxMaxRows 最大行数
+	xlsxMaxRows = 100000
+	// xlsxMaxColumns xlsx 规范允许的最大列数，即最后一列为 XFD
+	xlsxMaxColumns = 16384
+	// xlsxMaxCells 单元格总数上限，包括行中补齐的空单元格，共享字符串的个数也不能超过该值
+	xlsxMaxCells = 2000000
+	// xlsxMaxPartSize 单个 xml 部件解压后的最大字节数
+	xlsxMaxPartSize = 256 << 20
+)
+
+type xlsxText struct {
+	T    string `xml:"t"`
+	Runs []struct {
+		T string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t *xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.T
+	}
+	var b strings.Builder
+	for _, r := range t.Runs {
+		b.WriteString(r.T)
+	}
+	return b.String()
+}
+
+type xlsxCell struct {
+	Ref  string   `xml:"r,attr"`
+	Type string   `xml:"t,attr"`
+	V    string   `xml:"v"`
+	Is   xlsxText `xml:"is"`
+}
+
+// ReadXLSX 读取 xlsx 第一个工作表的所有行，单元格统一按文本返回，行尾的空单元格会被省略
+func ReadXLSX(data []byte) ([][]string, error) {
+	zr, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, i18n.NewError("import.xlsxInvalid", "不是有效的 xlsx 文件")
+	}
+	files := make(map[string]*zip.File, len(zr.File))
+	for _, f := range zr.File {
+		files[f.Name] = f
+	}
+
+	var shared []string
+	if f, ok := files["xl/sharedStrings.xml"]; ok {
+		err = walkXMLPart(f, func(d *xml.Decoder, tok xml.Token) error {
+			start, ok := tok.(xml.StartElement)
+			if !ok || start.Name.Local != "si" {
+				return nil
+			}
+			if len(shared) >= xlsxMaxCells {
+				return tooManyCells()
+			}
+			var item xlsxText
+			if err := d.DecodeElement(&item, &start); err != nil {
+				return parseFailed(f)
+			}
+			shared = append(shared, item.String())
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	f := firstSheet(files)
+	if f == nil {
+		return nil, i18n.NewError("import.xlsxNoSheet", "xlsx 文件中没有工作表")
+	}
+	// 逐个单元格读取，遇到超出限制的行或单元格立即停止
+	var rows [][]string
+	var row []string
+	cells, index := 0, 0
+	err = walkXMLPart(f, func(d *xml.Decoder, tok xml.Token) error {
+		switch t := tok.(type) {
+		case xml.StartElement:
+			switch t.Name.Local {
+			case "row":
+				if len(rows) >= xlsxMaxRows {
+					return i18n.NewError("import.tooManyRows", "xlsx 文件行数超过 "+strconv.Itoa(xlsxMaxRows), strconv.Itoa(xlsxMaxRows))
+				}
+				row, index = []string{}, 0
+			case "c":
+				var c xlsxCell
+				if err := d.DecodeElement(&c, &t); err != nil {
+					return parseFailed(f)
+				}
+				col := columnIndex(c.Ref)
+				if col < 0 {
+					col = index
+				}
+				index++
+				if col >= xlsxMaxColumns {
+					return i18n.NewError("import.xlsxCellRefInvalid", "xlsx 单元格引用超出最大列 XFD: "+c.Ref, c.Ref)
+				}
+				if cells+col >= xlsxMaxCells {
+					return tooManyCells()
+				}
+				value := c.V
+				switch c.Type {
+				case "s":
+					idx, err := strconv.Atoi(c.V)
+					if err != nil || idx < 0 || idx >= len(shared) {
+						return i18n.NewError("import.xlsxSharedStringInvalid", "xlsx 共享字符串索引无效: "+c.V, c.V)
+					}
+					value = shared[idx]
+				case "inlineStr":
+					value = c.Is.String()
+				}
+				for len(row) <= col {
+					row = append(row, "")
+				}
+				row[col] = value
+			}
+		case xml.EndElement:
+			if t.Name.Local == "row" {
+				cells += len(row)
+				rows = append(rows, row)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	if rows == nil {
+		rows = [][]string{}
+	}
+	return rows, nil
+}
+
+// firstSheet 优先按 workbook 中的顺序取第一个工作表，找不到时按文件名取
+func firstSheet(files map[string]*zip.File) *zip.File {
+	if wb, ok := files["xl/workbook.xml"]; ok {
+		var workbook struct {
+			Sheets []struct {
+				ID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+			} `xml:"sheets>sheet"`
+		}
+		var rels struct {
+			Items []struct {
+				ID     string `xml:"Id,attr"`
+				Target string `xml:"Target,attr"`
+			} `xml:"Relationship"`
+		}
+		if readXMLPart(wb, &workbook) == nil && len(workbook.Sheets) > 0 {
+			if rf, ok := files["xl/_rels/workbook.xml.rels"]; ok && readXMLPart(rf, &rels) == nil {
+				for _, rel := range rels.Items {
+					if rel.ID != workbook.Sheets[0].ID {
+						continue
+					}
+					name := path.Join("xl", rel.Target)
+					if strings.HasPrefix(rel.Target, "/") {
+						name = strings.TrimPrefix(rel.Target, "/")
+					}
+					if f, ok := files[name]; ok {
+						return f
+					}
+				}
+			}
+		}
+	}
+	var names []string
+	for name := range files {
+		if strings.HasPrefix(name, "xl/worksheets/") && strings.HasSuffix(name, ".xml") {
+			names = append(names, name)
+		}
+	}
+	if len(names) == 0 {
+		return nil
+	}
+	sort.Strings(names)
+	return files[names[0]]
+}
+
+func readXMLPart(f *zip.File, v any) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	if err = xml.NewDecoder(io.LimitReader(rc, xlsxMaxPartSize)).Decode(v); err != nil {
+		return parseFailed(f)
+	}
+	return nil
+}
+
+// walkXMLPart 流式读取 xml 部件，逐个 token 调用 fn，不会一次性把整个部件载入内存；
+// fn 可以用 d 解码当前元素，返回错误时停止读取
+func walkXMLPart(f *zip.File, fn func(d *xml.Decoder, tok xml.Token) error) error {
+	rc, err := f.Open()
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	d := xml.NewDecoder(io.LimitReader(rc, xlsxMaxPartSize))
+	for {
+		tok, err := d.Token()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return parseFailed(f)
+		}
+		if err = fn(d, tok); err != nil {
+			return err
+		}
+	}
+}
+
+func parseFailed(f *zip.File) error {
+	return i18n.NewError("import.xlsxParseFailed", "解析 xlsx 文件失败: "+f.Name, f.Name)
+}
+
+func tooManyCells() error {
+	return i18n.NewError("import.xlsxTooManyCells", "xlsx 文件单元格数超过 "+strconv.Itoa(xlsxMaxCells), strconv.Itoa(xlsxMaxCells))
+}
+
+// columnIndex 将单元格引用（如 AB12）转换为从 0 开始的列号，没有列字母时返回 -1，
+// 超过最大列数时返回 xlsxMaxColumns
+func columnIndex(ref string) int {
+	col := 0
+	for _, ch := range ref {
+		if ch < 'A' || ch > 'Z' {
+			break
+		}
+		col = col*26 + int(ch-'A'+1)
+		if col > xlsxMaxColumns {
+			return xlsxMaxColumns
+		}
+	}
+	return col - 1
+}
@@ -0,0 +1,172 @@
+package i18n
+
+import (
+	"embed"
+	stderrors "errors"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"gopkg.in/yaml.v3"
+)
+
+//go:embed locales/*.yaml
+var localeFS embed.FS
+
+// catalog 单个语言的信息目录
+type catalog struct {
+	// Messages 按信息 ID 查找的错误信息译文，{0}、{1} 依次替换为参数，{cause} 替换为原因错误的译文
+	Messages map[string]string `yaml:"messages"`
+	// Reasons 错误没有信息 ID 或 ID 没有译文时，按错误原因给出的通用译文
+	Reasons map[string]string `yaml:"reasons"`
+	// Labels 按 ID 查找的界面文字，如导出文件的列名
+	Labels map[string]string `yaml:"labels"`
+}
+
+var catalogs = loadCatalogs()
+
+func loadCatalogs() map[string]*catalog {
+	result := make(map[string]*catalog, len(Locales))
+	for _, locale := range Locales {
+		data, err := localeFS.ReadFile("locales/" + locale + ".yaml")
+		if err != nil {
+			panic(err)
+		}
+		c := &catalog{}
+		if err = yaml.Unmarshal(data, c); err != nil {
+			panic("i18n: 解析语言目录 " + locale + " 失败: " + err.Error())
+		}
+		result[locale] = c
+	}
+	return result
+}
+
+// MetadataID 错误元数据中的信息 ID，参数依次保存在 arg0、arg1 ... 中
+const MetadataID = "messageId"
+
+// WithID 为 kratos 错误附加信息 ID 和参数，保留原有的元数据；
+// 错误本身的信息作为默认语言的文字
+func WithID(err *errors.Error, id string, args ...string) *errors.Error {
+	md := make(map[string]string, len(err.Metadata)+len(args)+1)
+	for k, v := range err.Metadata {
+		md[k] = v
+	}
+	md[MetadataID] = id
+	for i, arg := range args {
+		md["arg"+strconv.Itoa(i)] = arg
+	}
+	return err.WithMetadata(md)
+}
+
+// Error 带信息 ID 的普通错误，供不依赖 kratos 错误的包使用；
+// 直接返回或作为 kratos 错误的 cause 时均可被 LocalizeError 翻译
+type Error struct {
+	ID   string
+	Text string // 默认语言的文字
+	Args []string
+}
+
+// NewError 创建带信息 ID 的普通错误
+func NewError(id, text string, args ...string) *Error {
+	return &Error{ID: id, Text: text, Args: args}
+}
+
+func (e *Error) Error() string {
+	return e.Text
+}
+
+// metadataArgs 取出 WithID 保存的参数
+func metadataArgs(md map[string]string) []string {
+	var args []string
+	for i := 0; ; i++ {
+		arg, ok := md["arg"+strconv.Itoa(i)]
+		if !ok {
+			return args
+		}
+		args = append(args, arg)
+	}
+}
+
+// format 按信息 ID 查找译文并替换参数，没有译文时返回 false
+func (c *catalog) format(id string, args []string, cause error) (string, bool) {
+	text, ok := c.Messages[id]
+	if !ok {
+		return "", false
+	}
+	for i, arg := range args {
+		text = strings.ReplaceAll(text, "{"+strconv.Itoa(i)+"}", arg)
+	}
+	if cause != nil && strings.Contains(text, "{cause}") {
+		text = strings.ReplaceAll(text, "{cause}", c.causeText(cause))
+	}
+	return text, true
+}
+
+// causeText 翻译原因错误，不能翻译时返回原文
+func (c *catalog) causeText(cause error) string {
+	var e *Error
+	if stderrors.As(cause, &e) {
+		if text, ok := c.format(e.ID, e.Args, nil); ok {
+			return text
+		}
+	}
+	return cause.Error()
+}
+
+// Label 按 ID 查找界面文字，当前语言没有时使用默认语言，都没有时返回 ID
+func Label(locale, id string) string {
+	if v, ok := catalogs[locale].Labels[id]; ok {
+		return v
+	}
+	if v, ok := catalogs[DefaultLocale].Labels[id]; ok {
+		return v
+	}
+	return id
+}
+
+// LocalizeError 按错误携带的信息 ID 翻译错误信息，带信息 ID 的普通错误转换为 kratos 错误后翻译，
+// 其余错误原样返回；没有译文且信息与目标语言的文字不一致时（如英文界面收到中文信息），退回按错误原因翻译
+func LocalizeError(locale string, err error) error {
+	c, ok := catalogs[locale]
+	if !ok {
+		return err
+	}
+	var (
+		se      *errors.Error
+		message string
+		found   bool
+	)
+	if stderrors.As(err, &se) {
+		if id := se.Metadata[MetadataID]; id != "" {
+			message, found = c.format(id, metadataArgs(se.Metadata), se.Unwrap())
+		}
+	} else {
+		var e *Error
+		if !stderrors.As(err, &e) {
+			return err
+		}
+		se = errors.FromError(err)
+		message, found = c.format(e.ID, e.Args, nil)
+	}
+	if !found {
+		if containsHan(se.Message) == (locale == LocaleZhCN) {
+			return err
+		}
+		if message, found = c.Reasons[se.Reason]; !found {
+			return err
+		}
+	}
+	localized := errors.Clone(se)
+	localized.Message = message
+	return localized
+}
+
+func containsHan(s string) bool {
+	for _, r := range s {
+		if unicode.Is(unicode.Han, r) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,107 @@
+package i18n
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+)
+
+// 支持的语言，源数据（字典标签、菜单标题、错误信息）均为简体中文
+const (
+	LocaleZhCN = "zh-CN"
+	LocaleEnUS = "en-US"
+
+	DefaultLocale = LocaleZhCN
+)
+
+// Locales 支持的语言列表
+var Locales = []string{LocaleZhCN, LocaleEnUS}
+
+// Normalize 将 zh、zh_cn、en-GB 等写法归一到支持的语言，不支持时返回 false
+func Normalize(locale string) (string, bool) {
+	tag := strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+	if tag == "" {
+		return "", false
+	}
+	lang, _, _ := strings.Cut(tag, "-")
+	switch lang {
+	case "zh":
+		return LocaleZhCN, true
+	case "en":
+		return LocaleEnUS, true
+	}
+	return "", false
+}
+
+// ParseAcceptLanguage 按 q 值从 Accept-Language 中选出第一个支持的语言，没有时返回默认语言
+func ParseAcceptLanguage(header string) string {
+	type candidate struct {
+		locale string
+		q      float64
+		index  int
+	}
+	var candidates []candidate
+	for i, part := range strings.Split(header, ",") {
+		tag, params, _ := strings.Cut(part, ";")
+		locale, ok := Normalize(tag)
+		if !ok {
+			continue
+		}
+		q := 1.0
+		for _, p := range strings.Split(params, ";") {
+			k, v, found := strings.Cut(strings.TrimSpace(p), "=")
+			if !found || k != "q" {
+				continue
+			}
+			if f, err := strconv.ParseFloat(v, 64); err == nil {
+				q = f
+			}
+		}
+		if q <= 0 {
+			continue
+		}
+		candidates = append(candidates, candidate{locale: locale, q: q, index: i})
+	}
+	if len(candidates) == 0 {
+		return DefaultLocale
+	}
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].q > candidates[j].q
+	})
+	return candidates[0].locale
+}
+
+type localeKey struct{}
+
+// holder 保存在 context 中的当前语言，鉴权后可以用用户偏好覆盖
+type holder struct {
+	locale atomic.Value
+}
+
+// NewContext 在 context 中保存请求语言
+func NewContext(ctx context.Context, locale string) context.Context {
+	h := &holder{}
+	h.locale.Store(locale)
+	return context.WithValue(ctx, localeKey{}, h)
+}
+
+// SetLocale 覆盖 context 中的请求语言，不支持的语言或 context 中没有语言时忽略
+func SetLocale(ctx context.Context, locale string) {
+	h, ok := ctx.Value(localeKey{}).(*holder)
+	if !ok {
+		return
+	}
+	if locale, ok = Normalize(locale); ok {
+		h.locale.Store(locale)
+	}
+}
+
+// FromContext 当前请求的语言，没有时返回默认语言
+func FromContext(ctx context.Context) string {
+	if h, ok := ctx.Value(localeKey{}).(*holder); ok {
+		return h.locale.Load().(string)
+	}
+	return DefaultLocale
+}
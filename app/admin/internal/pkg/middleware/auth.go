@@ -2,6 +2,8 @@ package middleware
 
 import (
 	"context"
+	stdhttp "net/http"
+	"strconv"
 	"strings"
 	"time"
 
@@ -10,13 +12,17 @@ import (
 	"github.com/go-kratos/kratos/v2/middleware"
 	"github.com/go-kratos/kratos/v2/middleware/auth/jwt"
 	"github.com/go-kratos/kratos/v2/middleware/selector"
+	"github.com/go-kratos/kratos/v2/transport"
 	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
 	jwtV5 "github.com/golang-jwt/jwt/v5"
-	"github.com/swordkee/kratos-casbin/authz/casbin"
+	"google.golang.org/protobuf/proto"
+	"gorm.io/gorm"
 
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
 )
 
 func AuthWhiteListMatcher() selector.MatchFunc {
@@ -32,58 +38,242 @@ func AuthWhiteListMatcher() selector.MatchFunc {
 	}
 }
 
-func Auth(s *conf.Auth, repo admin.CasbinRuleRepo, userRepo admin.SysUserRepo) middleware.Middleware {
+func Auth(s *conf.Auth, repo admin.CasbinRuleRepo, userRepo admin.SysUserRepo, tokenState *admin.UserTokenStateUseCase) middleware.Middleware {
 	return selector.Server(
-		jwt.Server(
+		jwtErrorID(jwt.Server(
 			func(token *jwtV5.Token) (interface{}, error) { return []byte(s.JwtKey), nil },
 			jwt.WithSigningMethod(jwtV5.SigningMethodHS256),
 			jwt.WithClaims(func() jwtV5.Claims { return &authz.TokenClaims{} }),
-		),
-		// JWT 黑名单和 IP 黑名单检查中间件
+		)),
+		UserLocale(),
+		// JWT 黑名单、IP 黑名单和用户 token 状态检查中间件
 		func(handler middleware.Handler) middleware.Handler {
 			return func(ctx context.Context, req interface{}) (interface{}, error) {
-				// 获取客户端 IP
-				clientIP := ""
-				if httpReq, ok := kratoshttp.RequestFromServerContext(ctx); ok {
-					clientIP = getClientIP(httpReq)
+				if err := checkSession(ctx, userRepo, tokenState); err != nil {
+					return nil, err
 				}
-				
-				// 检查 IP 是否在黑名单中
-				if clientIP != "" {
-					inBlacklist, err := userRepo.IsIpInBlacklist(ctx, clientIP)
-					if err != nil {
-						log.Errorf("Failed to check IP blacklist: %v", err)
-					} else if inBlacklist {
-						return nil, errors.Forbidden("IP_BLACKLISTED", "您的IP已被封禁")
-					}
-				}
-				
-				// 检查 JWT 是否在黑名单中
-				rawToken := ""
-				if tr, ok := kratoshttp.RequestFromServerContext(ctx); ok {
-					authHeader := tr.Header.Get("Authorization")
-					if strings.HasPrefix(authHeader, "Bearer ") {
-						rawToken = strings.TrimPrefix(authHeader, "Bearer ")
-					}
-				}
-				
-				if rawToken != "" {
-					inBlacklist, err := userRepo.IsJwtInBlacklist(ctx, rawToken)
-					if err != nil {
-						log.Errorf("Failed to check JWT blacklist: %v", err)
-					} else if inBlacklist {
-						return nil, errors.Unauthorized("JWT_BLACKLISTED", "Token已被撤销")
-					}
-				}
-				
 				return handler(ctx, req)
 			}
 		},
-		casbin.Server(
-			casbin.WithCasbinModel(repo.GetModel()),
-			casbin.WithCasbinPolicy(repo.GetAdapter()),
-			casbin.WithSecurityUserCreator(authz.NewSecurityUser),
-			casbin.WithAutoLoadPolicy(true, 30*time.Second),
-		),
+		Impersonation(s),
+		Authorize(repo),
 	).Match(AuthWhiteListMatcher()).Build()
 }
+
+// checkSession 检查客户端 IP 和 JWT 是否在黑名单中，以及用户的 token 状态
+func checkSession(ctx context.Context, userRepo admin.SysUserRepo, tokenState *admin.UserTokenStateUseCase) error {
+	httpReq, ok := kratoshttp.RequestFromServerContext(ctx)
+	if ok {
+		// 检查 IP 是否在黑名单中
+		if clientIP := getClientIP(httpReq); clientIP != "" {
+			inBlacklist, err := userRepo.IsIpInBlacklist(ctx, clientIP)
+			if err != nil {
+				log.Errorf("Failed to check IP blacklist: %v", err)
+			} else if inBlacklist {
+				return i18n.WithID(errors.Forbidden("IP_BLACKLISTED", "您的IP已被封禁"), "auth.ipBlocked")
+			}
+		}
+
+		// 检查 JWT 是否在黑名单中
+		if authHeader := httpReq.Header.Get("Authorization"); strings.HasPrefix(authHeader, "Bearer ") {
+			inBlacklist, err := userRepo.IsJwtInBlacklist(ctx, strings.TrimPrefix(authHeader, "Bearer "))
+			if err != nil {
+				log.Errorf("Failed to check JWT blacklist: %v", err)
+			} else if inBlacklist {
+				return i18n.WithID(errors.Unauthorized("JWT_BLACKLISTED", "Token已被撤销"), "auth.tokenBlacklisted")
+			}
+		}
+	}
+	return checkUserTokenState(ctx, tokenState)
+}
+
+// Revalidate 返回重新校验当前请求的登录状态和权限的函数，供长连接在连接期间定期调用，
+// 校验内容与 Auth 相同：token 是否过期、是否被拉黑或撤销，以及 casbin 权限
+func Revalidate(repo admin.CasbinRuleRepo, userRepo admin.SysUserRepo, tokenState *admin.UserTokenStateUseCase) func(ctx context.Context) error {
+	return func(ctx context.Context) error {
+		claims, err := authz.FromContext(ctx)
+		if err != nil {
+			return i18n.WithID(jwt.ErrMissingJwtToken, jwtErrorIDs[jwt.ErrMissingJwtToken])
+		}
+		if claims.ExpiresAt != nil && !claims.ExpiresAt.After(time.Now()) {
+			return i18n.WithID(jwt.ErrTokenExpired, jwtErrorIDs[jwt.ErrTokenExpired])
+		}
+		if err = checkSession(ctx, userRepo, tokenState); err != nil {
+			return err
+		}
+		return authorize(ctx, repo)
+	}
+}
+
+// jwtErrorIDs 框架 jwt 中间件返回的错误对应的信息 ID
+var jwtErrorIDs = map[*errors.Error]string{
+	jwt.ErrMissingJwtToken:        "auth.tokenMissing",
+	jwt.ErrTokenInvalid:           "auth.tokenInvalid",
+	jwt.ErrTokenExpired:           "auth.tokenExpired",
+	jwt.ErrTokenParseFail:         "auth.tokenParseFailed",
+	jwt.ErrUnSupportSigningMethod: "auth.signingMethodInvalid",
+}
+
+// jwtErrorID 为 jwt 中间件返回的错误附加信息 ID，以便按语言翻译
+func jwtErrorID(m middleware.Middleware) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		next := m(handler)
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			reply, err := next(ctx, req)
+			if se, ok := err.(*errors.Error); ok {
+				if id, ok := jwtErrorIDs[se]; ok {
+					err = i18n.WithID(se, id)
+				}
+			}
+			return reply, err
+		}
+	}
+}
+
+// passwordChangeOperations 密码被重置后、修改密码前仍允许调用的接口
+var passwordChangeOperations = map[string]struct{}{
+	pb.OperationSysUserUpdatePassword: {},
+	pb.OperationSysUserLogout:         {},
+	pb.OperationSysUserAuth:           {},
+}
+
+// checkUserTokenState 拒绝撤销登录之前签发的 token 和已删除用户的 token；密码被管理员重置后，
+// 在修改密码之前只允许调用修改密码等接口，模拟登录不受影响。状态读自缓存，无法读取时拒绝请求
+func checkUserTokenState(ctx context.Context, tokenState *admin.UserTokenStateUseCase) error {
+	claims, err := authz.FromContext(ctx)
+	if err != nil {
+		return i18n.WithID(jwt.ErrMissingJwtToken, jwtErrorIDs[jwt.ErrMissingJwtToken])
+	}
+	user, err := tokenState.Load(ctx, claims.UserID)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// 用户已被删除
+		return i18n.WithID(errors.Unauthorized("ACCOUNT_NOT_FOUND", "账号不存在或已被删除，请重新登录"), "auth.accountNotFound")
+	}
+	if err != nil {
+		// 无法确认 token 是否已被撤销时拒绝请求
+		log.Errorf("Failed to check user token state: %v", err)
+		return i18n.WithID(errors.ServiceUnavailable("AUTH_STATE_UNAVAILABLE", "暂时无法校验登录状态，请稍后重试"), "auth.stateUnavailable")
+	}
+	if user.TokenRevokedAt > 0 && claims.IssuedBefore(user.TokenRevokedAt) {
+		return i18n.WithID(errors.Unauthorized("TOKEN_REVOKED", "登录已失效，请重新登录"), "auth.tokenRevoked")
+	}
+	if user.MustChangePassword && !claims.IsImpersonated() {
+		if tr, ok := transport.FromServerContext(ctx); ok {
+			if _, allowed := passwordChangeOperations[tr.Operation()]; !allowed {
+				return i18n.WithID(errors.Forbidden("PASSWORD_CHANGE_REQUIRED", "密码已被重置，请先修改密码"), "auth.passwordChangeRequired")
+			}
+		}
+	}
+	return nil
+}
+
+// defaultImpersonateBlockedOperations 未配置 impersonateBlockedOperations 时，
+// 模拟登录期间禁止的删除、导入、授权、审批和账号安全等操作
+var defaultImpersonateBlockedOperations = []string{
+	"/api.admin.v1.*/Delete*",
+	"/api.admin.v1.*/Clean*",
+	"/api.admin.v1.*/Purge*",
+	"/api.admin.v1.*/Import*",
+	pb.OperationLogsServiceArchiveLogs,
+	pb.OperationRecycleRestoreRecycle,
+	pb.OperationRolesUpdateRoles,
+	pb.OperationRolesDataScope,
+	pb.OperationRolesCreateTempGrant,
+	pb.OperationRolesRevokeTempGrant,
+	pb.OperationChangeRequestApproveChangeRequest,
+	pb.OperationChangeRequestRejectChangeRequest,
+	pb.OperationSysUserImpersonateSysUser,
+	pb.OperationSysUserResetSysUserPassword,
+	pb.OperationSysUserRevokeSysUserSessions,
+	pb.OperationSysUserUpdatePassword,
+}
+
+// Impersonation 将登录用户及模拟登录的真实操作人写入操作记录，
+// 并按配置禁止模拟登录期间的破坏性操作
+func Impersonation(s *conf.Auth) middleware.Middleware {
+	blocked := s.GetImpersonateBlockedOperations()
+	if len(blocked) == 0 {
+		blocked = defaultImpersonateBlockedOperations
+	}
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			claims, err := authz.FromContext(ctx)
+			if err != nil {
+				return handler(ctx, req)
+			}
+			if record := recordFromContext(ctx); record != nil {
+				record.UserID = claims.UserID
+				record.ImpersonatorID = claims.ImpersonatorID
+			}
+			if claims.IsImpersonated() && s.GetImpersonateBlockDestructive() {
+				if tr, ok := transport.FromServerContext(ctx); ok && matchOperation(blocked, tr.Operation()) {
+					return nil, i18n.WithID(errors.Forbidden("IMPERSONATE_FORBIDDEN", "模拟登录期间禁止该操作"), "impersonate.forbidden")
+				}
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// Authorize 使用与权限管理共用的 casbin 引擎校验当前用户的接口权限
+func Authorize(repo admin.CasbinRuleRepo) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if err := authorize(ctx, repo); err != nil {
+				return nil, err
+			}
+			return handler(ctx, req)
+		}
+	}
+}
+
+// authorize 按 casbin 校验当前操作的权限，角色没有权限时再校验用户的临时授权
+func authorize(ctx context.Context, repo admin.CasbinRuleRepo) error {
+	su := authz.NewSecurityUser()
+	if err := su.ParseFromContext(ctx); err != nil {
+		return i18n.WithID(errors.Forbidden("FORBIDDEN", "Security Info Parse Failed"), "auth.securityInfoInvalid")
+	}
+	allowed, err := repo.Enforce(su.GetSubject(), su.GetObject(), su.GetAction())
+	if err != nil {
+		return errors.Forbidden("FORBIDDEN", err.Error())
+	}
+	if !allowed {
+		claims, err := authz.FromContext(ctx)
+		if err != nil {
+			return i18n.WithID(errors.Forbidden("FORBIDDEN", "Security Info Parse Failed"), "auth.securityInfoInvalid")
+		}
+		allowed, err = repo.Enforce(authz.UserSubject(claims.UserID), su.GetObject(), su.GetAction())
+		if err != nil {
+			return errors.Forbidden("FORBIDDEN", err.Error())
+		}
+	}
+	if !allowed {
+		return i18n.WithID(errors.Forbidden("FORBIDDEN", "Unauthorized Access"), "auth.unauthorizedAccess")
+	}
+	return nil
+}
+
+// Approval 配置为需要审批的操作不直接执行，而是提交为待审批的变更请求
+func Approval(uc *admin.SysChangeRequestUseCase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			tr, ok := transport.FromServerContext(ctx)
+			if !ok {
+				return handler(ctx, req)
+			}
+			msg, ok := req.(proto.Message)
+			if !ok {
+				return handler(ctx, req)
+			}
+			cr, err := uc.Submit(ctx, tr.Operation(), msg)
+			if err != nil {
+				return nil, err
+			}
+			if cr == nil {
+				return handler(ctx, req)
+			}
+			return nil, i18n.WithID(errors.New(stdhttp.StatusAccepted, "APPROVAL_REQUIRED", "操作需要审批，已提交变更请求").
+				WithMetadata(map[string]string{"changeRequestId": strconv.FormatInt(cr.ID, 10)}), "approval.required")
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+)
+
+// ChangeHistory 收集业务修改的实体字段变更，请求成功后立即写入变更历史，
+// 不受操作日志的读写开关、排除、采样和异步队列丢弃的影响；
+// 当前请求记录操作日志时，操作记录写入后回填变更的 log_id
+func ChangeHistory(opRecordsCase *biz.SysLogsUseCase) middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			ctx, recorder := biz.WithChangeRecorder(ctx)
+			reply, err := handler(ctx, req)
+			if err != nil {
+				return reply, err
+			}
+			changes := recorder.Changes()
+			if len(changes) == 0 {
+				return reply, nil
+			}
+			var userID int64
+			if claims, e := authz.FromContext(ctx); e == nil {
+				userID = claims.UserID
+			}
+			var operation string
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				operation = tr.Operation()
+			}
+			for i := range changes {
+				changes[i].UserID = userID
+				changes[i].Operation = operation
+			}
+			if opRecordsCase.SaveChanges(ctx, changes) {
+				if record := recordFromContext(ctx); record != nil {
+					record.Changes = changes
+				}
+			}
+			return reply, nil
+		}
+	}
+}
@@ -0,0 +1,42 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/transport"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// Locale 按 Accept-Language 确定请求语言，并翻译返回的错误信息；
+// 登录用户的语言偏好由 UserLocale 在鉴权后覆盖
+func Locale() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			locale := i18n.DefaultLocale
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				locale = i18n.ParseAcceptLanguage(tr.RequestHeader().Get("Accept-Language"))
+			}
+			ctx = i18n.NewContext(ctx, locale)
+			reply, err := handler(ctx, req)
+			if err != nil {
+				err = i18n.LocalizeError(i18n.FromContext(ctx), err)
+			}
+			return reply, err
+		}
+	}
+}
+
+// UserLocale 用 token 中的用户语言偏好覆盖请求语言
+func UserLocale() middleware.Middleware {
+	return func(handler middleware.Handler) middleware.Handler {
+		return func(ctx context.Context, req interface{}) (interface{}, error) {
+			if claims, err := authz.FromContext(ctx); err == nil && claims.Locale != "" {
+				i18n.SetLocale(ctx, claims.Locale)
+			}
+			return handler(ctx, req)
+		}
+	}
+}
@@ -0,0 +1,137 @@
+package middleware
+
+import (
+	"math/rand/v2"
+	"path"
+	"sync/atomic"
+
+	"github.com/go-kratos/kratos/v2/config"
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/redact"
+)
+
+// logConfigKey 配置文件中操作日志配置的 key
+const logConfigKey = "log"
+
+// LogRoute 按操作的记录配置
+type LogRoute struct {
+	// Operation 操作名，glob 格式，如 /api.admin.v1.SysUser/*
+	Operation string
+	// DisableRequestBody 不记录请求体
+	DisableRequestBody bool
+	// DisableResponseBody 不记录响应体
+	DisableResponseBody bool
+	// SampleRate 采样率 (0,1]，为 0 时全部记录
+	SampleRate float64
+}
+
+// NewLogConfig 由配置文件生成日志配置，未配置时使用默认配置
+func NewLogConfig(c *conf.LogConfig) *LogConfig {
+	if c == nil {
+		return DefaultLogConfig()
+	}
+	config := &LogConfig{
+		EnableReadLog:  c.EnableReadLog,
+		EnableWriteLog: c.EnableWriteLog,
+		MaxBodyLength:  int(c.MaxBodyLength),
+		RedactFields:   c.RedactFields,
+		Include:        c.Include,
+		Exclude:        c.Exclude,
+	}
+	if len(config.RedactFields) == 0 {
+		config.RedactFields = redact.DefaultFields
+	}
+	for _, route := range c.Routes {
+		config.Routes = append(config.Routes, LogRoute{
+			Operation:           route.Operation,
+			DisableRequestBody:  route.DisableRequestBody,
+			DisableResponseBody: route.DisableResponseBody,
+			SampleRate:          route.SampleRate,
+		})
+	}
+	return config
+}
+
+// matchOperation 判断操作名是否匹配任一 glob
+func matchOperation(patterns []string, operation string) bool {
+	for _, pattern := range patterns {
+		if ok, _ := path.Match(pattern, operation); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// route 返回第一个匹配操作名的配置
+func (c *LogConfig) route(operation string) *LogRoute {
+	for i := range c.Routes {
+		if ok, _ := path.Match(c.Routes[i].Operation, operation); ok {
+			return &c.Routes[i]
+		}
+	}
+	return nil
+}
+
+// ruleFor 判断是否记录本次请求，记录时返回匹配的按操作配置（可能为 nil）。
+// exclude 优先，其次 include，最后按读写开关判断，被记录的请求再按采样率抽样
+func (c *LogConfig) ruleFor(operation, method string) (*LogRoute, bool) {
+	if matchOperation(c.Exclude, operation) {
+		return nil, false
+	}
+	if !matchOperation(c.Include, operation) && !shouldRecord(method, c) {
+		return nil, false
+	}
+	route := c.route(operation)
+	if route != nil && route.SampleRate > 0 && route.SampleRate < 1 && rand.Float64() >= route.SampleRate {
+		return nil, false
+	}
+	return route, true
+}
+
+// logSettings 生效中的日志配置及对应的脱敏器
+type logSettings struct {
+	config *LogConfig
+	redact *redact.Redactor
+}
+
+// LogConfigStore 保存当前生效的日志配置，配置源变化时原子替换，无需重启
+type LogConfigStore struct {
+	settings atomic.Pointer[logSettings]
+}
+
+func NewLogConfigStore(config *LogConfig) *LogConfigStore {
+	s := &LogConfigStore{}
+	s.Store(config)
+	return s
+}
+
+// Store 替换当前生效的日志配置
+func (s *LogConfigStore) Store(config *LogConfig) {
+	if config == nil {
+		config = DefaultLogConfig()
+	}
+	s.settings.Store(&logSettings{
+		config: config,
+		redact: redact.New(config.RedactFields),
+	})
+}
+
+func (s *LogConfigStore) load() *logSettings {
+	return s.settings.Load()
+}
+
+// Watch 监听配置源中的操作日志配置，变化后立即生效
+func (s *LogConfigStore) Watch(c config.Config, logger log.Logger) error {
+	helper := log.NewHelper(log.With(logger, "module", "middleware/logConfig"))
+	return c.Watch(logConfigKey, func(_ string, value config.Value) {
+		var lc conf.LogConfig
+		if err := value.Scan(&lc); err != nil {
+			helper.Errorf("解析操作日志配置失败: %v", err)
+			return
+		}
+		s.Store(NewLogConfig(&lc))
+		helper.Info("操作日志配置已更新")
+	})
+}
@@ -5,14 +5,20 @@ import (
 	"context"
 	"encoding/json"
 	"io"
+	stdhttp "net/http"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/middleware"
+	"github.com/go-kratos/kratos/v2/middleware/tracing"
+	"github.com/go-kratos/kratos/v2/transport"
 	"github.com/go-kratos/kratos/v2/transport/http"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/common"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/redact"
 )
 
 // LogConfig 日志记录配置
@@ -23,6 +29,14 @@ type LogConfig struct {
 	EnableWriteLog bool
 	// MaxBodyLength 请求/响应体最大长度
 	MaxBodyLength int
+	// RedactFields 需要脱敏的字段名或 JSON 路径，proto 中标记为 sensitive 的字段总是脱敏
+	RedactFields []string
+	// Include 总是记录的操作，glob 格式，优先于读写开关
+	Include []string
+	// Exclude 不记录的操作，glob 格式，优先于 Include
+	Exclude []string
+	// Routes 按操作的记录配置，使用第一个匹配的配置
+	Routes []LogRoute
 }
 
 // DefaultLogConfig 返回默认日志配置
@@ -32,6 +46,7 @@ func DefaultLogConfig() *LogConfig {
 		EnableReadLog:  false,
 		EnableWriteLog: true,
 		MaxBodyLength:  4096,
+		RedactFields:   redact.DefaultFields,
 	}
 }
 
@@ -69,6 +84,14 @@ func shouldRecord(method string, config *LogConfig) bool {
 	return true
 }
 
+type operationRecordKey struct{}
+
+// recordFromContext 获取当前请求的操作记录，不记录日志的请求返回nil
+func recordFromContext(ctx context.Context) *model.SysLogs {
+	record, _ := ctx.Value(operationRecordKey{}).(*model.SysLogs)
+	return record
+}
+
 // OperationRecord returns a middleware for recording API operations.
 // 默认只记录写操作(POST/PUT/DELETE/PATCH)，读操作(GET/HEAD/OPTIONS)不记录
 func OperationRecord(opRecordsCase *biz.SysLogsUseCase) middleware.Middleware {
@@ -78,12 +101,17 @@ func OperationRecord(opRecordsCase *biz.SysLogsUseCase) middleware.Middleware {
 // OperationRecordWithConfig returns a middleware for recording API operations with custom config.
 // 支持自定义配置，可控制是否记录读操作和写操作
 func OperationRecordWithConfig(opRecordsCase *biz.SysLogsUseCase, config *LogConfig) middleware.Middleware {
-	if config == nil {
-		config = DefaultLogConfig()
-	}
+	return OperationRecordWithStore(opRecordsCase, NewLogConfigStore(config))
+}
 
+// OperationRecordWithStore returns a middleware for recording API operations,
+// 每个请求读取 store 中当前生效的配置，配置变化无需重启
+func OperationRecordWithStore(opRecordsCase *biz.SysLogsUseCase, store *LogConfigStore) middleware.Middleware {
 	return func(handler middleware.Handler) middleware.Handler {
 		return func(ctx context.Context, req interface{}) (reply interface{}, err error) {
+			settings := store.load()
+			config := settings.config
+
 			// Get HTTP request from context using Kratos API
 			var httpReq *http.Request
 			if kratosReq, ok := http.RequestFromServerContext(ctx); ok {
@@ -91,16 +119,24 @@ func OperationRecordWithConfig(opRecordsCase *biz.SysLogsUseCase, config *LogCon
 			}
 
 			method := getMethod(httpReq)
+			var operation string
+			if tr, ok := transport.FromServerContext(ctx); ok {
+				operation = tr.Operation()
+			}
 
 			// 根据配置判断是否记录此请求
-			if !shouldRecord(method, config) {
+			route, ok := config.ruleFor(operation, method)
+			if !ok {
 				// 不记录日志，直接执行handler
 				return handler(ctx, req)
 			}
+			if route == nil {
+				route = &LogRoute{}
+			}
 
 			// Capture request body
 			var reqBody []byte
-			if httpReq != nil && httpReq.Method != "GET" {
+			if httpReq != nil && httpReq.Method != "GET" && !route.DisableRequestBody {
 				bodyBytes, err := io.ReadAll(httpReq.Body)
 				if err == nil {
 					reqBody = bodyBytes
@@ -122,74 +158,80 @@ func OperationRecordWithConfig(opRecordsCase *biz.SysLogsUseCase, config *LogCon
 
 			// Create operation record with initial values
 			record := &model.SysLogs{
-				IP:     getClientIP(httpReq),
-				Method: method,
-				Path:   getPath(httpReq),
-				Agent:  getUserAgent(httpReq),
-				Body:   truncateBody(string(reqBody), config.MaxBodyLength),
-				UserID: userID,
+				IP:        getClientIP(httpReq),
+				Method:    method,
+				Path:      getPath(httpReq),
+				Agent:     getUserAgent(httpReq),
+				Body:      truncateBody(string(settings.redact.RedactRequest(getContentType(httpReq), reqBody, req)), config.MaxBodyLength),
+				UserID:    userID,
+				TraceID:   getTraceID(ctx, httpReq),
+				Operation: operation,
 			}
 
 			// Call the handler
-			reply, err = handler(ctx, req)
+			// 记录放入 context，由鉴权中间件补充用户及模拟登录信息，
+			// 由变更历史中间件关联已写入的字段变更
+			reply, err = handler(context.WithValue(ctx, operationRecordKey{}, record), req)
 
 			// Calculate latency after handler completes
 			latency := time.Since(startTime)
 			record.Latency = int64(latency)
 
-			// Get error message if any
+			// Record final HTTP status and structured kratos error
+			record.Status = stdhttp.StatusOK
 			if err != nil {
-				record.ErrorMessage = err.Error()
+				se := errors.FromError(err)
+				record.Status = int64(se.Code)
+				if se.Code < 100 || se.Code > 599 {
+					record.Status = stdhttp.StatusInternalServerError
+				}
+				record.ErrorCode = se.Code
+				record.Reason = se.Reason
+				record.ErrorMessage = se.Message
 			}
 
 			// Capture response body
-			if reply != nil {
+			if reply != nil && !route.DisableResponseBody {
 				respBytes, jsonErr := json.Marshal(reply)
 				if jsonErr == nil {
-					record.Resp = truncateBody(string(respBytes), config.MaxBodyLength)
+					record.Resp = truncateBody(string(settings.redact.Redact(respBytes, reply)), config.MaxBodyLength)
 				}
 			}
 
-			// Save operation record asynchronously to avoid blocking
-			// Use context.Background() instead of request ctx because:
-			// 1. Request context is canceled when HTTP response is sent
-			// 2. Database transaction may already be committed/rolled back
-			// 3. Async operation needs independent context that won't be canceled
-			go func() {
-				bgCtx := context.Background()
-				saveErr := opRecordsCase.CreateOperationRecord(bgCtx, record)
-				if saveErr != nil {
-					// In production, use a proper logger here
-				}
-			}()
+			// Save operation record asynchronously to avoid blocking,
+			// records are queued and inserted in batches by SysLogsWriter
+			opRecordsCase.RecordAsync(record)
 
 			return reply, err
 		}
 	}
 }
 
-// getClientIP extracts the client IP from the HTTP request
-func getClientIP(req *http.Request) string {
+// getTraceID returns the tracing trace id, falling back to request id headers
+func getTraceID(ctx context.Context, req *http.Request) string {
+	if traceID, ok := tracing.TraceID()(ctx).(string); ok && traceID != "" {
+		return traceID
+	}
 	if req == nil {
 		return ""
 	}
-	forwarded := req.Header.Get("X-Forwarded-For")
-	if forwarded != "" {
-		if idx := bytes.IndexByte([]byte(forwarded), ','); idx != -1 {
-			return forwarded[:idx]
-		}
-		return forwarded
-	}
-	if realIP := req.Header.Get("X-Real-IP"); realIP != "" {
-		return realIP
+	if traceID := req.Header.Get("X-Trace-Id"); traceID != "" {
+		return traceID
 	}
-	if req.RemoteAddr != "" {
-		if idx := bytes.IndexByte([]byte(req.RemoteAddr), ':'); idx != -1 {
-			return req.RemoteAddr[:idx]
-		}
-		return req.RemoteAddr
+	return req.Header.Get("X-Request-Id")
+}
+
+// getContentType returns the request content type
+func getContentType(req *http.Request) string {
+	if req == nil {
+		return ""
 	}
-	return ""
+	return req.Header.Get("Content-Type")
+}
+
+// getClientIP extracts the client IP from the HTTP request
+func getClientIP(req *http.Request) string {
+	return common.ClientIP(req)
 }
 
 func getMethod(req *http.Request) string {
@@ -0,0 +1,209 @@
+package redact
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"mime"
+	"net/url"
+	"strings"
+	"sync"
+
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+)
+
+// Value 脱敏后的字段值
+const Value = "******"
+
+// DefaultFields 默认脱敏的字段名
+var DefaultFields = []string{
+	"password", "oldPassword", "newPassword", "salt",
+	"secret", "qrcode", "token", "accessToken", "refreshToken",
+}
+
+// sensitivePaths 缓存 proto 消息中标记为 sensitive 的字段路径
+var sensitivePaths sync.Map // protoreflect.FullName -> map[string]struct{}
+
+// Redactor 按字段名或 JSON 路径对请求/响应体脱敏，
+// 配置中含 "." 的按路径匹配（如 user.password），否则按字段名匹配
+type Redactor struct {
+	fields map[string]struct{}
+	paths  map[string]struct{}
+}
+
+func New(fields []string) *Redactor {
+	r := &Redactor{
+		fields: make(map[string]struct{}),
+		paths:  make(map[string]struct{}),
+	}
+	for _, field := range fields {
+		if strings.Contains(field, ".") {
+			r.paths[normalizeRedactKey(field)] = struct{}{}
+		} else if field != "" {
+			r.fields[normalizeRedactKey(field)] = struct{}{}
+		}
+	}
+	return r
+}
+
+// normalizeRedactKey 忽略大小写和下划线，使 new_password 与 newPassword 一致
+func normalizeRedactKey(key string) string {
+	return strings.ToLower(strings.ReplaceAll(key, "_", ""))
+}
+
+// Redact 对 JSON 内容脱敏，msg 为对应的 proto 消息时同时脱敏其 sensitive 字段，
+// 无法解析的内容不保留，只记录其长度
+func (r *Redactor) Redact(body []byte, msg interface{}) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return omitted("invalid json", body)
+	}
+	if !r.redactValue(value, "", sensitivePathsOf(msg)) {
+		return body
+	}
+	redacted, err := json.Marshal(value)
+	if err != nil {
+		return omitted("invalid json", body)
+	}
+	return redacted
+}
+
+// RedactRequest 按请求的 Content-Type 脱敏，JSON 和表单按字段脱敏，
+// 其他类型（文件上传、protobuf 等）无法逐字段脱敏，不保留内容
+func (r *Redactor) RedactRequest(contentType string, body []byte, msg interface{}) []byte {
+	if len(body) == 0 {
+		return body
+	}
+	mediaType, _, _ := mime.ParseMediaType(contentType)
+	switch {
+	case mediaType == "" || mediaType == "application/json" || strings.HasSuffix(mediaType, "+json"):
+		return r.Redact(body, msg)
+	case mediaType == "application/x-www-form-urlencoded":
+		return r.redactForm(body, msg)
+	default:
+		return omitted(mediaType, body)
+	}
+}
+
+// redactForm 按键名对表单脱敏，键名中的 "." 视为 JSON 路径
+func (r *Redactor) redactForm(body []byte, msg interface{}) []byte {
+	values, err := url.ParseQuery(string(body))
+	if err != nil {
+		return omitted("invalid form", body)
+	}
+	paths := sensitivePathsOf(msg)
+	changed := false
+	for key := range values {
+		path := normalizeRedactKey(strings.TrimSuffix(key, "[]"))
+		name := path
+		if i := strings.LastIndex(path, "."); i >= 0 {
+			name = path[i+1:]
+		}
+		_, byField := r.fields[name]
+		_, byPath := r.paths[path]
+		_, bySensitive := paths[path]
+		if byField || byPath || bySensitive {
+			values[key] = []string{Value}
+			changed = true
+		}
+	}
+	if !changed {
+		return body
+	}
+	return []byte(values.Encode())
+}
+
+// omitted 不保留的内容只记录类型和长度
+func omitted(kind string, body []byte) []byte {
+	return []byte(fmt.Sprintf("[%s body omitted, %d bytes]", kind, len(body)))
+}
+
+func sensitivePathsOf(msg interface{}) map[string]struct{} {
+	if m, ok := msg.(proto.Message); ok {
+		return messageSensitivePaths(m.ProtoReflect().Descriptor())
+	}
+	return nil
+}
+
+// redactValue 递归脱敏，返回是否有字段被脱敏
+func (r *Redactor) redactValue(value interface{}, prefix string, paths map[string]struct{}) bool {
+	changed := false
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			normalized := normalizeRedactKey(key)
+			path := normalized
+			if prefix != "" {
+				path = prefix + "." + normalized
+			}
+			_, byField := r.fields[normalized]
+			_, byPath := r.paths[path]
+			_, bySensitive := paths[path]
+			if byField || byPath || bySensitive {
+				v[key] = Value
+				changed = true
+				continue
+			}
+			if r.redactValue(item, path, paths) {
+				changed = true
+			}
+		}
+	case []interface{}:
+		for _, item := range v {
+			if r.redactValue(item, prefix, paths) {
+				changed = true
+			}
+		}
+	}
+	return changed
+}
+
+// messageSensitivePaths 返回消息中标记为 sensitive 的字段路径，包含嵌套消息
+func messageSensitivePaths(desc protoreflect.MessageDescriptor) map[string]struct{} {
+	if cached, ok := sensitivePaths.Load(desc.FullName()); ok {
+		return cached.(map[string]struct{})
+	}
+	paths := make(map[string]struct{})
+	collectSensitivePaths(desc, "", paths, map[protoreflect.FullName]bool{})
+	sensitivePaths.Store(desc.FullName(), paths)
+	return paths
+}
+
+func collectSensitivePaths(desc protoreflect.MessageDescriptor, prefix string, paths map[string]struct{}, visiting map[protoreflect.FullName]bool) {
+	if visiting[desc.FullName()] {
+		return
+	}
+	visiting[desc.FullName()] = true
+	defer delete(visiting, desc.FullName())
+
+	fields := desc.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		field := fields.Get(i)
+		names := []string{normalizeRedactKey(field.JSONName())}
+		if name := normalizeRedactKey(string(field.Name())); name != names[0] {
+			names = append(names, name)
+		}
+		sensitive := proto.GetExtension(field.Options(), pb.E_Sensitive).(bool)
+		for _, name := range names {
+			path := name
+			if prefix != "" {
+				path = prefix + "." + name
+			}
+			if sensitive {
+				paths[path] = struct{}{}
+				continue
+			}
+			if field.Message() != nil && !field.IsMap() {
+				collectSensitivePaths(field.Message(), path, paths, visiting)
+			}
+		}
+	}
+}
@@ -0,0 +1,152 @@
+package redact
+
+import (
+	"testing"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+)
+
+func Test_Redactor_RedactRequest(t *testing.T) {
+	tests := []struct {
+		name        string
+		fields      []string
+		contentType string
+		body        string
+		msg         interface{}
+		want        string
+	}{
+		{
+			name:        "json 默认字段",
+			fields:      DefaultFields,
+			contentType: "application/json",
+			body:        `{"username":"admin","password":"123456"}`,
+			want:        `{"password":"******","username":"admin"}`,
+		},
+		{
+			name:        "json 没有敏感字段时原样保留",
+			fields:      DefaultFields,
+			contentType: "application/json; charset=utf-8",
+			body:        `{"b":1,"a":2}`,
+			want:        `{"b":1,"a":2}`,
+		},
+		{
+			name:        "json 字段名忽略大小写和下划线",
+			fields:      DefaultFields,
+			contentType: "application/json",
+			body:        `{"new_password":"a","OldPassword":"b"}`,
+			want:        `{"OldPassword":"******","new_password":"******"}`,
+		},
+		{
+			name:        "未指定 Content-Type 按 json 处理",
+			fields:      DefaultFields,
+			body:        `{"token":"abc"}`,
+			want:        `{"token":"******"}`,
+		},
+		{
+			name:        "+json 类型",
+			fields:      DefaultFields,
+			contentType: "application/vnd.api+json",
+			body:        `{"secret":"abc"}`,
+			want:        `{"secret":"******"}`,
+		},
+		{
+			name:        "嵌套路径只脱敏对应位置",
+			fields:      []string{"user.phone"},
+			contentType: "application/json",
+			body:        `{"phone":"1","user":{"name":"n","phone":"2"}}`,
+			want:        `{"phone":"1","user":{"name":"n","phone":"******"}}`,
+		},
+		{
+			name:        "数组中的对象，数字保持原样",
+			fields:      DefaultFields,
+			contentType: "application/json",
+			body:        `{"list":[{"token":"t"},{"id":12345678901234567}]}`,
+			want:        `{"list":[{"token":"******"},{"id":12345678901234567}]}`,
+		},
+		{
+			name:        "proto sensitive 字段",
+			contentType: "application/json",
+			body:        `{"format":"csv","content":"zhangsan,123456"}`,
+			msg:         &pb.ImportSysUsersRequest{},
+			want:        `{"content":"******","format":"csv"}`,
+		},
+		{
+			name:        "嵌套消息的 proto sensitive 字段",
+			contentType: "application/json",
+			body:        `{"user":{"phone":"1","salt":"s","password":"p"},"role":{"roleId":1}}`,
+			msg:         &pb.AuthReply{},
+			want:        `{"role":{"roleId":1},"user":{"password":"******","phone":"1","salt":"******"}}`,
+		},
+		{
+			name:        "无法解析的 json 不保留内容",
+			fields:      DefaultFields,
+			contentType: "application/json",
+			body:        `{"password":`,
+			want:        `[invalid json body omitted, 12 bytes]`,
+		},
+		{
+			name:        "表单",
+			fields:      DefaultFields,
+			contentType: "application/x-www-form-urlencoded; charset=utf-8",
+			body:        `username=admin&password=123456`,
+			want:        `password=%2A%2A%2A%2A%2A%2A&username=admin`,
+		},
+		{
+			name:        "表单数组和路径键名",
+			fields:      append([]string{"user.phone"}, DefaultFields...),
+			contentType: "application/x-www-form-urlencoded",
+			body:        `token[]=a&token[]=b&user.phone=1&phone=2`,
+			want:        `phone=2&token%5B%5D=%2A%2A%2A%2A%2A%2A&user.phone=%2A%2A%2A%2A%2A%2A`,
+		},
+		{
+			name:        "表单的 proto sensitive 字段",
+			contentType: "application/x-www-form-urlencoded",
+			body:        `format=csv&content=zhangsan`,
+			msg:         &pb.ImportSysUsersRequest{},
+			want:        `content=%2A%2A%2A%2A%2A%2A&format=csv`,
+		},
+		{
+			name:        "表单没有敏感字段时原样保留",
+			fields:      DefaultFields,
+			contentType: "application/x-www-form-urlencoded",
+			body:        `b=1&a=2`,
+			want:        `b=1&a=2`,
+		},
+		{
+			name:        "文件上传不保留内容",
+			fields:      DefaultFields,
+			contentType: "multipart/form-data; boundary=x",
+			body:        "--x\r\nContent-Disposition: form-data; name=\"password\"\r\n\r\n123456\r\n--x--\r\n",
+			want:        `[multipart/form-data body omitted, 71 bytes]`,
+		},
+		{
+			name:        "其他类型不保留内容",
+			fields:      DefaultFields,
+			contentType: "application/x-protobuf",
+			body:        "\x0a\x06123456",
+			want:        `[application/x-protobuf body omitted, 8 bytes]`,
+		},
+		{
+			name:        "文本类型不保留内容",
+			fields:      DefaultFields,
+			contentType: "text/plain",
+			body:        "password=123456",
+			want:        `[text/plain body omitted, 15 bytes]`,
+		},
+		{
+			name:        "空内容",
+			fields:      DefaultFields,
+			contentType: "application/octet-stream",
+			body:        "",
+			want:        "",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := New(tt.fields).RedactRequest(tt.contentType, []byte(tt.body), tt.msg)
+			if string(got) != tt.want {
+				t.Errorf("got %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
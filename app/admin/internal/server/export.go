@@ -0,0 +1,164 @@
+package server
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/url"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/transport/http"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/export"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+	adminV1 "github.com/swordkee/kratos-vue-admin/app/admin/internal/service/admin"
+)
+
+// registerExportRoutes 注册列表导出和导出文件下载的路由
+func registerExportRoutes(r *http.Router, exportCase *biz.SysExportUseCase, exportService *adminV1.ExportService) {
+	r.GET("/system/logs/list/export", exportHandler(exportCase, "/api.admin.v1.LogsService/ExportLogs", exportService.LogsSource))
+	r.GET("/system/user/list/export", exportHandler(exportCase, "/api.admin.v1.SysUser/ExportSysUser", exportService.UserSource))
+	r.GET("/system/role/list/export", exportHandler(exportCase, "/api.admin.v1.Roles/ExportRoleList", exportService.RoleSource))
+	r.GET("/system/loginLog/list/export", exportHandler(exportCase, "/api.admin.v1.LoginLog/ExportLoginLogs", exportService.LoginLogSource))
+	r.GET("/system/export/{id}/download", func(ctx http.Context) error {
+		http.SetOperation(ctx, "/api.admin.v1.Export/DownloadExport")
+		id, err := strconv.ParseInt(ctx.Vars().Get("id"), 10, 64)
+		if err != nil {
+			return i18n.WithID(errors.BadRequest("EXPORT_TASK_NOT_FOUND", "导出任务不存在"), "export.taskNotFound")
+		}
+		h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+			task, f, err := exportCase.Open(c, id)
+			if err != nil {
+				return nil, err
+			}
+			defer f.Close()
+			setAttachment(ctx, task.FileName, task.Format)
+			_, err = io.Copy(ctx.Response(), f)
+			return nil, err
+		})
+		_, err = h(ctx, nil)
+		return err
+	})
+}
+
+// registerUserImportTemplateRoute 注册用户导入模板的下载路由，format 参数指定 csv 或 xlsx
+func registerUserImportTemplateRoute(r *http.Router) {
+	r.GET("/system/user/import/template", func(ctx http.Context) error {
+		http.SetOperation(ctx, "/api.admin.v1.SysUser/ImportSysUsersTemplate")
+		format, err := export.NormalizeFormat(ctx.Query().Get("format"))
+		if err != nil {
+			return i18n.WithID(errors.BadRequest("EXPORT_FORMAT_INVALID", err.Error()).WithCause(err), "export.formatInvalid")
+		}
+		h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+			setAttachment(ctx, "用户导入模板."+format, format)
+			w, err := export.NewWriter(format, ctx.Response())
+			if err != nil {
+				return nil, err
+			}
+			for _, row := range admin.UserImportTemplate() {
+				if err = w.Write(row); err != nil {
+					return nil, err
+				}
+			}
+			return nil, w.Close()
+		})
+		_, err = h(ctx, nil)
+		return err
+	})
+}
+
+// registerUserImportPasswordsRoute 注册导入用户时随机生成的初始密码的下载路由，
+// format 参数指定 csv 或 xlsx，只有导入人可以下载且只能下载一次
+func registerUserImportPasswordsRoute(r *http.Router, sysUserService *adminV1.SysUserService) {
+	r.GET("/system/user/import/passwords/{token}", func(ctx http.Context) error {
+		http.SetOperation(ctx, "/api.admin.v1.SysUser/ImportSysUsersPasswords")
+		format, err := export.NormalizeFormat(ctx.Query().Get("format"))
+		if err != nil {
+			return i18n.WithID(errors.BadRequest("EXPORT_FORMAT_INVALID", err.Error()).WithCause(err), "export.formatInvalid")
+		}
+		token := ctx.Vars().Get("token")
+		h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+			table, err := sysUserService.ImportPasswords(c, token)
+			if err != nil {
+				return nil, err
+			}
+			setAttachment(ctx, "用户初始密码."+format, format)
+			ctx.Response().Header().Set("Cache-Control", "no-store")
+			w, err := export.NewWriter(format, ctx.Response())
+			if err != nil {
+				return nil, err
+			}
+			for _, row := range table {
+				if err = w.Write(row); err != nil {
+					return nil, err
+				}
+			}
+			return nil, w.Close()
+		})
+		_, err = h(ctx, nil)
+		return err
+	})
+}
+
+// exportHandler 与列表接口使用相同的查询参数，额外的 format 参数指定 csv 或 xlsx，
+// 行数较少时生成完整文件后再返回，否则创建后台任务并返回任务信息
+func exportHandler[Req any, PReq interface{ *Req }](exportCase *biz.SysExportUseCase, operation string, source func(context.Context, PReq) (*admin.ExportSource, error)) http.HandlerFunc {
+	return func(ctx http.Context) error {
+		http.SetOperation(ctx, operation)
+		format, err := export.NormalizeFormat(ctx.Query().Get("format"))
+		if err != nil {
+			return i18n.WithID(errors.BadRequest("EXPORT_FORMAT_INVALID", err.Error()).WithCause(err), "export.formatInvalid")
+		}
+		req := PReq(new(Req))
+		if err = ctx.BindQuery(req); err != nil {
+			return err
+		}
+		h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+			src, err := source(c, req)
+			if err != nil {
+				return nil, err
+			}
+			large, err := exportCase.NeedTask(c, src)
+			if err != nil {
+				return nil, err
+			}
+			if large {
+				task, err := exportCase.Submit(c, src, format)
+				if err != nil {
+					return nil, err
+				}
+				reply := &pb.ExportReply{TaskId: task.ID, Status: task.Status}
+				if task.Status == admin.ExportTaskStatusFinished {
+					reply.DownloadUrl = adminV1.DownloadURL(task.ID)
+				}
+				return reply, nil
+			}
+			// 先写入内存，导出失败时还能返回错误，不会返回状态为 200 的不完整文件
+			var buf bytes.Buffer
+			if _, err = exportCase.Write(c, src, format, &buf); err != nil {
+				return nil, err
+			}
+			setAttachment(ctx, admin.ExportFileName(src.Name, format), format)
+			_, err = ctx.Response().Write(buf.Bytes())
+			return nil, err
+		})
+		reply, err := h(ctx, req)
+		if err != nil {
+			return err
+		}
+		if reply != nil {
+			return ctx.Result(200, reply)
+		}
+		return nil
+	}
+}
+
+func setAttachment(ctx http.Context, fileName, format string) {
+	header := ctx.Response().Header()
+	header.Set("Content-Type", export.ContentType(format))
+	header.Set("Content-Disposition", "attachment; filename*=UTF-8''"+url.PathEscape(fileName))
+}
@@ -14,6 +14,7 @@ import (
 	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 
+	"github.com/go-kratos/kratos/v2/config"
 	"github.com/go-kratos/kratos/v2/log"
 	"github.com/go-kratos/kratos/v2/middleware/logging"
 	"github.com/go-kratos/kratos/v2/middleware/recovery"
@@ -25,6 +26,7 @@ import (
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/common"
 	adminV1 "github.com/swordkee/kratos-vue-admin/app/admin/internal/service/admin"
 )
 
@@ -173,8 +175,11 @@ func EncoderResponse() http.EncodeResponseFunc {
 func NewHTTPServer(
 	c *conf.Server,
 	s *conf.Auth,
+	lc *conf.LogConfig,
+	cfg config.Config,
 	casbinRepo admin.CasbinRuleRepo,
 	userRepo admin.SysUserRepo,
+	tokenState *admin.UserTokenStateUseCase,
 	logger log.Logger,
 	sysUserService *adminV1.SysUserService,
 	apiService *adminV1.ApiService,
@@ -186,23 +191,40 @@ func NewHTTPServer(
 	dictTypeService *adminV1.DictTypeService,
 	dictDataService *adminV1.DictDataService,
 	roleService *adminV1.RolesService,
+	changeRequestCase *biz.SysChangeRequestUseCase,
+	changeRequestService *adminV1.ChangeRequestService,
+	exportCase *biz.SysExportUseCase,
+	exportService *adminV1.ExportService,
+	loginLogService *adminV1.LoginLogService,
+	translationService *adminV1.TranslationService,
+	recycleService *adminV1.RecycleService,
 ) *http.Server {
-	// 构建日志中间件配置
-	logMiddlewareConfig := middleware.DefaultLogConfig()
+	if err := common.SetTrustedProxies(c.GetHttp().GetTrustedProxies()); err != nil {
+		log.NewHelper(logger).Errorf("可信代理配置无效，不采用转发头中的客户端 IP: %v", err)
+	}
+
+	// 构建日志中间件配置，配置文件变化时自动更新
+	logConfigStore := middleware.NewLogConfigStore(middleware.NewLogConfig(lc))
+	if err := logConfigStore.Watch(cfg, logger); err != nil {
+		log.NewHelper(logger).Warnf("监听操作日志配置失败: %v", err)
+	}
 
 	var opts = []http.ServerOption{
 		http.Middleware(
 			recovery.Recovery(),
+			middleware.Locale(),
 			logging.Server(logger),
-			middleware.OperationRecordWithConfig(opRecordsCase, logMiddlewareConfig),
-			middleware.Auth(s, casbinRepo, userRepo),
+			middleware.OperationRecordWithStore(opRecordsCase, logConfigStore),
+			middleware.Auth(s, casbinRepo, userRepo, tokenState),
+			middleware.ChangeHistory(opRecordsCase),
+			middleware.Approval(changeRequestCase),
 		),
 		http.Filter(handlers.CORS(
 			handlers.AllowedHeaders([]string{"Accept", "Accept-Language", "Content-Language", "Origin", "Content-Type", "Content-Length", "Accept-Encoding", "Authorization"}),
 			handlers.AllowedOrigins([]string{"*"}),
 			handlers.AllowedMethods([]string{"GET", "POST", "PUT", "DELETE", "OPTIONS", "HEAD"}),
 			handlers.AllowCredentials(),
-		)),
+		), logsStreamFilter),
 		http.ResponseEncoder(EncoderResponse()),
 		http.RequestDecoder(CustomRequestDecoder),
 	}
@@ -226,6 +248,12 @@ func NewHTTPServer(
 	v1.RegisterDictTypeHTTPServer(srv, dictTypeService)
 	v1.RegisterDictDataHTTPServer(srv, dictDataService)
 	v1.RegisterRolesHTTPServer(srv, roleService)
+	v1.RegisterChangeRequestHTTPServer(srv, changeRequestService)
+	v1.RegisterExportHTTPServer(srv, exportService)
+	v1.RegisterLoginLogHTTPServer(srv, loginLogService)
+	v1.RegisterTranslationHTTPServer(srv, translationService)
+	v1.RegisterRecycleHTTPServer(srv, recycleService)
+	apiService.SetHTTPServer(srv)
 
 	// 上传文件的路由
 	r := srv.Route("/")
@@ -251,6 +279,11 @@ func NewHTTPServer(
 		return ctx.Result(200, url)
 	})
 
+	registerExportRoutes(r, exportCase, exportService)
+	registerUserImportTemplateRoute(r)
+	registerUserImportPasswordsRoute(r, sysUserService)
+	registerLogsStreamRoute(r, opRecordsService, middleware.Revalidate(casbinRepo, userRepo, tokenState))
+
 	srv.Handle("/debug/pprof/", pprof.NewHandler())
 
 	return srv
@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
+)
+
+// jobInterval 定时任务执行间隔
+const jobInterval = time.Minute
+
+// JobServer 进程内定时任务，随应用启动和停止
+type JobServer struct {
+	tempGrant     *biz.SysTempGrantUseCase
+	changeRequest *biz.SysChangeRequestUseCase
+	export        *biz.SysExportUseCase
+	recycle       *biz.SysRecycleUseCase
+	log           *log.Helper
+	stop          chan struct{}
+}
+
+// NewJobServer new a job server.
+func NewJobServer(tempGrant *biz.SysTempGrantUseCase, changeRequest *biz.SysChangeRequestUseCase, export *biz.SysExportUseCase, recycle *biz.SysRecycleUseCase, logger log.Logger) *JobServer {
+	return &JobServer{
+		tempGrant:     tempGrant,
+		changeRequest: changeRequest,
+		export:        export,
+		recycle:       recycle,
+		log:           log.NewHelper(log.With(logger, "module", "server/job")),
+		stop:          make(chan struct{}),
+	}
+}
+
+func (s *JobServer) Start(ctx context.Context) error {
+	ticker := time.NewTicker(jobInterval)
+	defer ticker.Stop()
+	// 本节点重启前未完成的导出任务不会再继续执行
+	if err := s.export.FailInterrupted(ctx); err != nil {
+		s.log.Errorf("处理中断的导出任务失败: %v", err)
+	}
+	s.run(ctx)
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-s.stop:
+			return nil
+		case <-ticker.C:
+			s.run(ctx)
+		}
+	}
+}
+
+func (s *JobServer) Stop(context.Context) error {
+	close(s.stop)
+	return nil
+}
+
+func (s *JobServer) run(ctx context.Context) {
+	// 临时授权到期生效或撤销
+	if err := s.tempGrant.Reconcile(ctx); err != nil {
+		s.log.Errorf("处理临时授权失败: %v", err)
+	}
+	// 待审批的变更请求过期
+	if err := s.changeRequest.ExpirePending(ctx); err != nil {
+		s.log.Errorf("处理过期变更请求失败: %v", err)
+	}
+	// 标记超时未完成的导出任务，清理过期的导出文件
+	if err := s.export.FailStale(ctx); err != nil {
+		s.log.Errorf("处理超时导出任务失败: %v", err)
+	}
+	if err := s.export.CleanExpired(ctx); err != nil {
+		s.log.Errorf("清理过期导出文件失败: %v", err)
+	}
+	// 永久删除回收站中超过保留天数的记录
+	if err := s.recycle.PurgeExpired(ctx); err != nil {
+		s.log.Errorf("清理回收站失败: %v", err)
+	}
+}
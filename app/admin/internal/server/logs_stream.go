@@ -0,0 +1,108 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	stdhttp "net/http"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	adminV1 "github.com/swordkee/kratos-vue-admin/app/admin/internal/service/admin"
+)
+
+// logsStreamPath 操作记录实时推送（SSE）的路由
+const logsStreamPath = "/system/logs/stream"
+
+type rawRequestContextKey struct{}
+
+// logsStreamFilter 推送是长连接，不能受 http 超时的限制；kratos 会给请求 context 加上超时，
+// 这里在此之前保存原始的请求 context，推送时只以它判断客户端是否已断开
+func logsStreamFilter(next stdhttp.Handler) stdhttp.Handler {
+	return stdhttp.HandlerFunc(func(w stdhttp.ResponseWriter, req *stdhttp.Request) {
+		if req.URL.Path == logsStreamPath {
+			req = req.WithContext(context.WithValue(req.Context(), rawRequestContextKey{}, req.Context()))
+		}
+		next.ServeHTTP(w, req)
+	})
+}
+
+// registerLogsStreamRoute 注册操作记录实时推送路由，经过鉴权和 casbin 中间件；
+// 推送在 token 过期时结束，连接期间按心跳用 revalidate 重新校验登录状态和权限
+func registerLogsStreamRoute(r *http.Router, opRecordsService *adminV1.SysLogsService, revalidate func(context.Context) error) {
+	r.GET(logsStreamPath, func(ctx http.Context) error {
+		http.SetOperation(ctx, "/api.admin.v1.LogsService/StreamLogs")
+		var req pb.StreamLogsRequest
+		if err := ctx.BindQuery(&req); err != nil {
+			return err
+		}
+		h := ctx.Middleware(func(c context.Context, _ interface{}) (interface{}, error) {
+			sender := &sseSender{w: ctx.Response(), rc: stdhttp.NewResponseController(ctx.Response())}
+			streamCtx, cancel := context.WithCancel(context.WithoutCancel(c))
+			defer cancel()
+			if claims, err := authz.FromContext(c); err == nil && claims.ExpiresAt != nil {
+				var cancelDeadline context.CancelFunc
+				streamCtx, cancelDeadline = context.WithDeadline(streamCtx, claims.ExpiresAt.Time)
+				defer cancelDeadline()
+			}
+			if raw, ok := ctx.Request().Context().Value(rawRequestContextKey{}).(context.Context); ok {
+				defer context.AfterFunc(raw, cancel)()
+			} else {
+				defer context.AfterFunc(c, cancel)()
+			}
+			err := opRecordsService.StreamLogs(streamCtx, &req, sender, revalidate)
+			if sender.opened {
+				// 响应头已写出，推送中断多为客户端断开，不再返回错误
+				return nil, nil
+			}
+			return nil, err
+		})
+		_, err := h(ctx, &req)
+		return err
+	})
+}
+
+// sseSender 按 Server-Sent Events 格式写出操作记录
+type sseSender struct {
+	w      stdhttp.ResponseWriter
+	rc     *stdhttp.ResponseController
+	opened bool
+}
+
+func (s *sseSender) Open() error {
+	// 长连接不设写超时
+	_ = s.rc.SetWriteDeadline(time.Time{})
+	h := s.w.Header()
+	h.Set("Content-Type", "text/event-stream")
+	h.Set("Cache-Control", "no-cache")
+	h.Set("Connection", "keep-alive")
+	// 关闭 nginx 等反向代理的缓冲
+	h.Set("X-Accel-Buffering", "no")
+	s.w.WriteHeader(stdhttp.StatusOK)
+	s.opened = true
+	if _, err := fmt.Fprint(s.w, "retry: 3000\n\n"); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+func (s *sseSender) Send(record *pb.SysLogsDetail) error {
+	data, err := protojson.MarshalOptions{EmitUnpopulated: true}.Marshal(record)
+	if err != nil {
+		return err
+	}
+	if _, err = fmt.Fprintf(s.w, "id: %d\nevent: log\ndata: %s\n\n", record.Id, data); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
+
+func (s *sseSender) Heartbeat(dropped int64) error {
+	if _, err := fmt.Fprintf(s.w, "event: heartbeat\ndata: {\"dropped\":%d}\n\n", dropped); err != nil {
+		return err
+	}
+	return s.rc.Flush()
+}
@@ -10,12 +10,14 @@ import (
 	"github.com/swordkee/kratos-vue-admin/pkg/util"
 
 	"github.com/go-kratos/kratos/v2/log"
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
 )
 
 type ApiService struct {
 	pb.UnimplementedApiServer
 	apiUseCase    *biz.SysApiUseCase
 	casbinUseCase *admin.CasbinRuleUseCase
+	httpSrv       *kratoshttp.Server
 	log           *log.Helper
 }
 
@@ -27,6 +29,11 @@ func NewApiService(ac *biz.SysApiUseCase, logger log.Logger, casbinUseCase *admi
 	}
 }
 
+// SetHTTPServer 设置 HTTP 服务，同步 api 时只同步该服务上注册的路由
+func (a *ApiService) SetHTTPServer(srv *kratoshttp.Server) {
+	a.httpSrv = srv
+}
+
 func (a *ApiService) FindApi(ctx context.Context, req *pb.FindApiRequest) (*pb.FindApiReply, error) {
 	api, err := a.apiUseCase.FindApiByID(ctx, req.Id)
 	if err != nil {
@@ -115,3 +122,60 @@ func (a *ApiService) QueryPolicyPathByRoleKey(ctx context.Context, req *pb.Query
 		Apis: apis,
 	}, nil
 }
+
+// SyncApi 根据已注册的路由同步api，并标记已失效的api
+func (a *ApiService) SyncApi(ctx context.Context, req *pb.SyncApiRequest) (*pb.SyncApiReply, error) {
+	routes, err := collectApiRoutes(a.httpSrv)
+	if err != nil {
+		return nil, err
+	}
+	result, err := a.apiUseCase.SyncApis(ctx, routes, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.SyncApiReply{
+		Created: make([]*pb.ApiBase, len(result.Created)),
+		Updated: make([]*pb.ApiBase, len(result.Updated)),
+		Stale:   make([]*pb.StaleApi, len(result.Stale)),
+	}
+	for i, d := range result.Created {
+		reply.Created[i] = &pb.ApiBase{Path: d.Path, Method: d.Method}
+	}
+	for i, d := range result.Updated {
+		reply.Updated[i] = &pb.ApiBase{Path: d.Path, Method: d.Method}
+	}
+	for i, d := range result.Stale {
+		reply.Stale[i] = &pb.StaleApi{
+			Api:      ConvertApiDataFromApiList([]*model.SysApis{d.Api})[0],
+			RoleKeys: d.RoleKeys,
+		}
+	}
+	return reply, nil
+}
+
+// CheckPermission 权限校验，返回是否放行及命中的策略
+func (a *ApiService) CheckPermission(ctx context.Context, req *pb.CheckPermissionRequest) (*pb.CheckPermissionReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	explain, err := a.casbinUseCase.ExplainPermission(ctx, req.RoleKey, req.UserId, req.Path, req.Method)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.CheckPermissionReply{
+		Allowed:  explain.Allowed,
+		RoleKey:  explain.RoleKey,
+		Policies: ConvertApiBaseFromList(explain.Policies),
+		Roles:    explain.Roles,
+	}
+	if len(explain.Matched) >= 3 {
+		reply.Matched = &pb.ApiBase{
+			Path:   explain.Matched[1],
+			Method: explain.Matched[2],
+		}
+	}
+	return reply, nil
+}
@@ -0,0 +1,97 @@
+package admin
+
+import (
+	"fmt"
+	"net/http"
+	"path"
+	"strings"
+
+	kratoshttp "github.com/go-kratos/kratos/v2/transport/http"
+	"google.golang.org/genproto/googleapis/api/annotations"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+)
+
+// collectApiRoutes 根据 proto 中的 google.api.http 注解生成接口列表，
+// srv 不为空时只保留 HTTP 服务上实际注册的路由
+func collectApiRoutes(srv *kratoshttp.Server) ([]*admin.ApiRoute, error) {
+	var registered map[string]struct{}
+	if srv != nil {
+		registered = make(map[string]struct{})
+		err := srv.WalkRoute(func(r kratoshttp.RouteInfo) error {
+			registered[r.Method+" "+r.Path] = struct{}{}
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var routes []*admin.ApiRoute
+	protoregistry.GlobalFiles.RangeFilesByPackage(pb.File_api_proto.Package(), func(fd protoreflect.FileDescriptor) bool {
+		services := fd.Services()
+		for i := 0; i < services.Len(); i++ {
+			sd := services.Get(i)
+			methods := sd.Methods()
+			for j := 0; j < methods.Len(); j++ {
+				md := methods.Get(j)
+				rule, ok := proto.GetExtension(md.Options(), annotations.E_Http).(*annotations.HttpRule)
+				if !ok || rule == nil {
+					continue
+				}
+				method, urlPath := httpRulePattern(rule)
+				if method == "" {
+					continue
+				}
+				if registered != nil {
+					if _, ok := registered[method+" "+urlPath]; !ok {
+						continue
+					}
+				}
+				routes = append(routes, &admin.ApiRoute{
+					Path:        fmt.Sprintf("/%s/%s", sd.FullName(), md.Name()),
+					Method:      method,
+					Group:       apiGroupFromPath(urlPath),
+					Description: string(md.Name()),
+				})
+			}
+		}
+		return true
+	})
+	return routes, nil
+}
+
+// httpRulePattern 返回注解中的 HTTP 方法和路径
+func httpRulePattern(rule *annotations.HttpRule) (method, urlPath string) {
+	switch p := rule.GetPattern().(type) {
+	case *annotations.HttpRule_Get:
+		method, urlPath = http.MethodGet, p.Get
+	case *annotations.HttpRule_Post:
+		method, urlPath = http.MethodPost, p.Post
+	case *annotations.HttpRule_Put:
+		method, urlPath = http.MethodPut, p.Put
+	case *annotations.HttpRule_Delete:
+		method, urlPath = http.MethodDelete, p.Delete
+	case *annotations.HttpRule_Patch:
+		method, urlPath = http.MethodPatch, p.Patch
+	case *annotations.HttpRule_Custom:
+		method, urlPath = strings.ToUpper(p.Custom.GetKind()), p.Custom.GetPath()
+	default:
+		return "", ""
+	}
+	// 与 kratos 注册路由时的处理保持一致
+	return method, path.Join("/", urlPath)
+}
+
+// apiGroupFromPath 以路径的模块名作为分组，如 /system/user/list => user
+func apiGroupFromPath(urlPath string) string {
+	segments := strings.Split(strings.Trim(urlPath, "/"), "/")
+	if len(segments) > 1 && segments[0] == "system" {
+		return segments[1]
+	}
+	return segments[0]
+}
@@ -0,0 +1,126 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/pkg/util"
+)
+
+type ChangeRequestService struct {
+	pb.UnimplementedChangeRequestServer
+	uc  *biz.SysChangeRequestUseCase
+	log *log.Helper
+}
+
+func NewChangeRequestService(uc *biz.SysChangeRequestUseCase, roleCase *biz.SysRoleUseCase, userService *SysUserService, roleService *RolesService, logsService *SysLogsService, logger log.Logger) *ChangeRequestService {
+	s := &ChangeRequestService{
+		uc:  uc,
+		log: log.NewHelper(log.With(logger, "module", "service/changeRequest")),
+	}
+
+	// 仅创建超级管理员需要审批
+	uc.Register(pb.OperationSysUserCreateSysUser, changeHandler(userService.CreateSysUser, func(ctx context.Context, req *pb.CreateSysUserRequest) (bool, error) {
+		ids := append(util.Split2Int64Slice(req.RoleIds), req.RoleId)
+		return roleCase.HasSuperAdmin(ctx, ids)
+	}))
+	uc.Register(pb.OperationRolesUpdateRoles, changeHandler(roleService.UpdateRoles, nil))
+	uc.Register(pb.OperationLogsServiceCleanLogs, changeHandler(logsService.CleanLogs, nil))
+	return s
+}
+
+// changeHandler 将服务方法包装为审批通过后执行的操作
+func changeHandler[Req proto.Message, Reply any](call func(context.Context, Req) (Reply, error), match func(context.Context, Req) (bool, error)) *admin.ChangeHandler {
+	handler := &admin.ChangeHandler{
+		NewRequest: func() proto.Message {
+			var req Req
+			return req.ProtoReflect().New().Interface()
+		},
+		Execute: func(ctx context.Context, req proto.Message) error {
+			_, err := call(ctx, req.(Req))
+			return err
+		},
+	}
+	if match != nil {
+		handler.Match = func(ctx context.Context, req proto.Message) (bool, error) {
+			return match(ctx, req.(Req))
+		}
+	}
+	return handler
+}
+
+func (s *ChangeRequestService) ListChangeRequests(ctx context.Context, req *pb.ListChangeRequestsRequest) (*pb.ListChangeRequestsReply, error) {
+	list, total, err := s.uc.ListPage(ctx, req.Operation, req.Status, req.PageNum, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*pb.ChangeRequestData, len(list))
+	for i, d := range list {
+		data[i] = s.convertChangeRequest(d)
+	}
+	return &pb.ListChangeRequestsReply{
+		Total:    total,
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+		Data:     data,
+	}, nil
+}
+
+func (s *ChangeRequestService) GetChangeRequest(ctx context.Context, req *pb.GetChangeRequestRequest) (*pb.GetChangeRequestReply, error) {
+	cr, err := s.uc.FindByID(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetChangeRequestReply{Data: s.convertChangeRequest(cr)}, nil
+}
+
+func (s *ChangeRequestService) ApproveChangeRequest(ctx context.Context, req *pb.ApproveChangeRequestRequest) (*pb.ApproveChangeRequestReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	cr, err := s.uc.Approve(ctx, req.Id, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ApproveChangeRequestReply{Data: s.convertChangeRequest(cr)}, nil
+}
+
+func (s *ChangeRequestService) RejectChangeRequest(ctx context.Context, req *pb.RejectChangeRequestRequest) (*pb.RejectChangeRequestReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	cr, err := s.uc.Reject(ctx, req.Id, req.Comment)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RejectChangeRequestReply{Data: s.convertChangeRequest(cr)}, nil
+}
+
+// convertChangeRequest 请求参数解密并脱敏后返回
+func (s *ChangeRequestService) convertChangeRequest(cr *model.SysChangeRequests) *pb.ChangeRequestData {
+	data := &pb.ChangeRequestData{
+		Id:            cr.ID,
+		Operation:     cr.Operation,
+		Payload:       s.uc.DisplayPayload(cr),
+		Status:        cr.Status,
+		CreateUserId:  cr.CreateUserID,
+		CreateBy:      cr.CreateBy,
+		ReviewUserId:  cr.ReviewUserID,
+		ReviewBy:      cr.ReviewBy,
+		ReviewComment: cr.ReviewComment,
+		ErrorMessage:  cr.ErrorMessage,
+		ExpireTime:    util.NewTimestamp(cr.ExpireAt),
+		CreateTime:    util.NewTimestamp(cr.CreatedAt),
+		UpdateTime:    util.NewTimestamp(cr.UpdatedAt),
+	}
+	if !cr.ReviewedAt.IsZero() {
+		data.ReviewTime = util.NewTimestamp(cr.ReviewedAt)
+	}
+	return data
+}
@@ -82,7 +82,7 @@ func (s *DeptService) CreateDept(ctx context.Context, req *pb.CreateDeptRequest)
 }
 func (s *DeptService) UpdateDept(ctx context.Context, req *pb.UpdateDeptRequest) (*pb.UpdateDeptReply, error) {
 	fmt.Print("status:", req.Status)
-	_, err := s.deptUseCase.UpdateDept(ctx, &model.SysDepts{
+	_, moved, err := s.deptUseCase.UpdateDept(ctx, &model.SysDepts{
 		ID:       req.DeptId,
 		ParentID: req.ParentId,
 		DeptName: req.DeptName,
@@ -92,10 +92,42 @@ func (s *DeptService) UpdateDept(ctx context.Context, req *pb.UpdateDeptRequest)
 		Email:    req.Email,
 		Status:   int32(req.Status),
 	})
-	return &pb.UpdateDeptReply{}, err
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.UpdateDeptReply{}
+	if moved != nil {
+		reply.DeptIds = moved.DeptIDs
+		reply.RoleChanges = roleDeptChanges(moved.RoleChanges)
+	}
+	return reply, nil
+}
+func (s *DeptService) MoveDept(ctx context.Context, req *pb.MoveDeptRequest) (*pb.MoveDeptReply, error) {
+	result, err := s.deptUseCase.MoveDept(ctx, req.DeptId, req.ParentId)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.MoveDeptReply{
+		DeptIds:     result.DeptIDs,
+		RoleIds:     result.RoleIDs,
+		RoleChanges: roleDeptChanges(result.RoleChanges),
+	}, nil
+}
+
+// roleDeptChanges 转换移动部门后角色数据权限的变化
+func roleDeptChanges(changes []*biz.RoleDeptChange) []*pb.RoleDeptChange {
+	result := make([]*pb.RoleDeptChange, 0, len(changes))
+	for _, c := range changes {
+		result = append(result, &pb.RoleDeptChange{
+			RoleId:         c.RoleID,
+			GrantedDeptIds: c.Granted,
+			RevokedDeptIds: c.Revoked,
+		})
+	}
+	return result
 }
 func (s *DeptService) DeleteDept(ctx context.Context, req *pb.DeleteDeptRequest) (*pb.DeleteDeptReply, error) {
-	err := s.deptUseCase.DeleteDept(ctx, req.Id)
+	err := s.deptUseCase.DeleteDept(ctx, req.Id, biz.DeleteOptions{Cascade: req.Cascade, ReassignTo: req.ReassignDeptId})
 	return &pb.DeleteDeptReply{}, err
 }
 
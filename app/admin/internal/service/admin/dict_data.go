@@ -2,16 +2,24 @@ package admin
 
 import (
 	"context"
+	"fmt"
 	"strconv"
+	"strings"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"github.com/go-kratos/kratos/v2/transport"
 
 	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
 	"github.com/swordkee/kratos-vue-admin/pkg/util"
 )
 
+// batchDictTypesLimit 批量查询一次最多的字典类型数量
+const batchDictTypesLimit = 50
+
 type DictDataService struct {
 	pb.UnimplementedDictDataServer
 	pc  *biz.SysDictDatumUseCase
@@ -32,22 +40,7 @@ func (s *DictDataService) ListDictData(ctx context.Context, req *pb.ListDictData
 	}
 	data := make([]*pb.DictDataContent, len(postList))
 	for i, d := range postList {
-		data[i] = &pb.DictDataContent{
-			DictCode:   uint32(d.DictCode),
-			DictType:   d.DictType,
-			DictSort:   uint32(d.DictSort),
-			DictLabel:  d.DictLabel,
-			DictValue:  d.DictValue,
-			Status:     d.Status,
-			CssClass:   d.CSSClass,
-			ListClass:  d.ListClass,
-			IsDefault:  d.IsDefault,
-			Remark:     d.Remark,
-			CreateBy:   d.CreateBy,
-			UpdateBy:   d.UpdateBy,
-			CreateTime: util.NewTimestamp(d.CreateTime),
-			UpdateTime: util.NewTimestamp(d.UpdateTime),
-		}
+		data[i] = convertDictData(d)
 	}
 
 	return &pb.ListDictDataReply{
@@ -57,6 +50,26 @@ func (s *DictDataService) ListDictData(ctx context.Context, req *pb.ListDictData
 		List:     data,
 	}, nil
 }
+
+func convertDictData(d *model.SysDictData) *pb.DictDataContent {
+	return &pb.DictDataContent{
+		DictCode:   uint32(d.DictCode),
+		DictType:   d.DictType,
+		DictSort:   uint32(d.DictSort),
+		DictLabel:  d.DictLabel,
+		DictValue:  d.DictValue,
+		Status:     d.Status,
+		CssClass:   d.CSSClass,
+		ListClass:  d.ListClass,
+		IsDefault:  d.IsDefault,
+		Remark:     d.Remark,
+		CreateBy:   d.CreateBy,
+		UpdateBy:   d.UpdateBy,
+		CreateTime: util.NewTimestamp(d.CreateTime),
+		UpdateTime: util.NewTimestamp(d.UpdateTime),
+	}
+}
+
 func (s *DictDataService) CreateDictData(ctx context.Context, req *pb.CreateDictDataRequest) (*pb.CreateDictDataReply, error) {
 	if err := req.Validate(); err != nil {
 		return nil, err
@@ -91,3 +104,52 @@ func (s *DictDataService) DeleteDictData(ctx context.Context, req *pb.DeleteDict
 	err := s.pc.DeleteDictData(ctx, ids)
 	return &pb.DeleteDictDataReply{}, err
 }
+
+// BatchDictData 批量获取字典数据，etag 与当前数据一致时不返回数据
+func (s *DictDataService) BatchDictData(ctx context.Context, req *pb.BatchDictDataRequest) (*pb.BatchDictDataReply, error) {
+	dictTypes := make([]string, 0)
+	seen := make(map[string]bool)
+	for _, t := range strings.Split(req.DictTypes, ",") {
+		t = strings.TrimSpace(t)
+		if t == "" || seen[t] {
+			continue
+		}
+		seen[t] = true
+		dictTypes = append(dictTypes, t)
+	}
+	if len(dictTypes) == 0 {
+		return nil, i18n.WithID(errors.BadRequest("DICT_TYPES_EMPTY", "dictTypes 不能为空"), "dict.typesEmpty")
+	}
+	if len(dictTypes) > batchDictTypesLimit {
+		return nil, i18n.WithID(errors.BadRequest("DICT_TYPES_TOO_MANY", fmt.Sprintf("一次最多查询 %d 个字典类型", batchDictTypesLimit)), "dict.typesTooMany", strconv.Itoa(batchDictTypesLimit))
+	}
+
+	lists, etag, err := s.pc.FindDictDataByTypes(ctx, dictTypes)
+	if err != nil {
+		return nil, err
+	}
+	clientETag := req.Etag
+	if tr, ok := transport.FromServerContext(ctx); ok {
+		tr.ReplyHeader().Set("ETag", etag)
+		tr.ReplyHeader().Set("Cache-Control", "no-cache")
+		if clientETag == "" {
+			clientETag = tr.RequestHeader().Get("If-None-Match")
+		}
+	}
+	if clientETag == etag {
+		return &pb.BatchDictDataReply{Etag: etag, NotModified: true}, nil
+	}
+
+	reply := &pb.BatchDictDataReply{
+		Etag: etag,
+		List: make([]*pb.DictTypeDataList, len(dictTypes)),
+	}
+	for i, t := range dictTypes {
+		data := make([]*pb.DictDataContent, len(lists[i]))
+		for j, d := range lists[i] {
+			data[j] = convertDictData(d)
+		}
+		reply.List[i] = &pb.DictTypeDataList{DictType: t, List: data}
+	}
+	return reply, nil
+}
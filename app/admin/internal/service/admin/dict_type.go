@@ -1,14 +1,23 @@
 package admin
 
 import (
+	"bytes"
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"strconv"
+	"strings"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"gopkg.in/yaml.v3"
 
 	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/export"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
 	"github.com/swordkee/kratos-vue-admin/pkg/util"
 )
 
@@ -86,3 +95,113 @@ func (s *DictTypeService) DeleteDictType(ctx context.Context, req *pb.DeleteDict
 	err := s.pc.DeleteDictType(ctx, ids)
 	return &pb.DeleteDictTypeReply{}, err
 }
+
+// ExportDicts 导出字典类型及字典数据
+func (s *DictTypeService) ExportDicts(ctx context.Context, req *pb.ExportDictsRequest) (*pb.ExportDictsReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var dictTypes []string
+	for _, t := range strings.Split(req.DictTypes, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			dictTypes = append(dictTypes, t)
+		}
+	}
+
+	file, err := s.pc.ExportDicts(ctx, dictTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	format := dictTransferFormat(req.Format)
+	var content []byte
+	switch format {
+	case "json":
+		content, err = json.MarshalIndent(file, "", "  ")
+	case export.FormatXLSX:
+		content, err = writeTable(file.Table())
+	default:
+		content, err = yaml.Marshal(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ExportDictsReply{Format: format, Content: string(content)}
+	if format == export.FormatXLSX {
+		reply.Content = base64.StdEncoding.EncodeToString(content)
+	}
+	return reply, nil
+}
+
+// ImportDicts 导入字典
+func (s *DictTypeService) ImportDicts(ctx context.Context, req *pb.ImportDictsRequest) (*pb.ImportDictsReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	file := &admin.DictExportFile{}
+	var err error
+	switch dictTransferFormat(req.Format) {
+	case "json":
+		err = json.Unmarshal([]byte(req.Content), file)
+	case export.FormatXLSX:
+		var data []byte
+		var rows [][]string
+		if data, err = base64.StdEncoding.DecodeString(req.Content); err == nil {
+			if rows, err = export.ReadXLSX(data); err == nil {
+				file, err = admin.ParseDictTable(rows)
+			}
+		}
+	default:
+		err = yaml.Unmarshal([]byte(req.Content), file)
+	}
+	if err != nil {
+		if errors.FromError(err).Code != errors.UnknownCode {
+			return nil, err
+		}
+		return nil, i18n.WithID(errors.BadRequest("DICT_IMPORT_INVALID", "导入内容格式错误: "+err.Error()).WithCause(err), "import.contentInvalid")
+	}
+
+	results, err := s.pc.ImportDicts(ctx, file, req.Mode, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ImportDictsReply{Dicts: make([]*pb.DictImportResult, len(results))}
+	for i, r := range results {
+		reply.Dicts[i] = &pb.DictImportResult{
+			DictType:      r.DictType,
+			Action:        r.Action,
+			ChangedFields: r.ChangedFields,
+			CreatedValues: r.CreatedValues,
+			UpdatedValues: r.UpdatedValues,
+			SkippedValues: r.SkippedValues,
+		}
+	}
+	return reply, nil
+}
+
+// dictTransferFormat 导入导出格式，默认 yaml
+func dictTransferFormat(format string) string {
+	if format == "json" || format == export.FormatXLSX {
+		return format
+	}
+	return "yaml"
+}
+
+// writeTable 将表格写成 xlsx 文件内容
+func writeTable(rows [][]string) ([]byte, error) {
+	var buf bytes.Buffer
+	w, err := export.NewWriter(export.FormatXLSX, &buf)
+	if err != nil {
+		return nil, err
+	}
+	for _, row := range rows {
+		if err = w.Write(row); err != nil {
+			return nil, err
+		}
+	}
+	if err = w.Close(); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
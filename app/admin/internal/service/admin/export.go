@@ -0,0 +1,262 @@
+package admin
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/log"
+	"google.golang.org/protobuf/proto"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+	"github.com/swordkee/kratos-vue-admin/pkg/util"
+)
+
+type ExportService struct {
+	pb.UnimplementedExportServer
+	uc       *biz.SysExportUseCase
+	logsCase *biz.SysLogsUseCase
+	userCase *admin.SysUserUseCase
+	roleCase *admin.SysRoleUseCase
+	deptCase *admin.SysDeptUseCase
+	loginLog *biz.SysLoginLogUseCase
+	log      *log.Helper
+}
+
+func NewExportService(uc *biz.SysExportUseCase, logsCase *biz.SysLogsUseCase, userCase *admin.SysUserUseCase, roleCase *admin.SysRoleUseCase, deptCase *admin.SysDeptUseCase, loginLog *biz.SysLoginLogUseCase, logger log.Logger) *ExportService {
+	return &ExportService{
+		uc:       uc,
+		logsCase: logsCase,
+		userCase: userCase,
+		roleCase: roleCase,
+		deptCase: deptCase,
+		loginLog: loginLog,
+		log:      log.NewHelper(log.With(logger, "module", "service/export")),
+	}
+}
+
+// DownloadURL 导出任务的下载地址
+func DownloadURL(id int64) string {
+	return "/system/export/" + strconv.FormatInt(id, 10) + "/download"
+}
+
+// exportColumns 按请求语言翻译列名，dictTypes 为列序号对应的字典类型
+func exportColumns(ctx context.Context, dictTypes map[int]string, ids ...string) []admin.ExportColumn {
+	locale := i18n.FromContext(ctx)
+	columns := make([]admin.ExportColumn, len(ids))
+	for i, id := range ids {
+		columns[i] = admin.ExportColumn{Title: i18n.Label(locale, id), DictType: dictTypes[i]}
+	}
+	return columns
+}
+
+func (s *ExportService) ListExportTasks(ctx context.Context, req *pb.ListExportTasksRequest) (*pb.ListExportTasksReply, error) {
+	list, total, err := s.uc.ListTasks(ctx, req.PageNum, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*pb.ExportTask, len(list))
+	for i, task := range list {
+		data[i] = convertExportTask(task)
+	}
+	return &pb.ListExportTasksReply{
+		Total:    total,
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+		Data:     data,
+	}, nil
+}
+
+func (s *ExportService) GetExportTask(ctx context.Context, req *pb.GetExportTaskRequest) (*pb.GetExportTaskReply, error) {
+	task, err := s.uc.FindTask(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.GetExportTaskReply{Data: convertExportTask(task)}, nil
+}
+
+func convertExportTask(task *model.SysExportTasks) *pb.ExportTask {
+	data := &pb.ExportTask{
+		Id:           task.ID,
+		Name:         task.Name,
+		Format:       task.Format,
+		Status:       task.Status,
+		Rows:         task.Rows,
+		FileName:     task.FileName,
+		ErrorMessage: task.ErrorMessage,
+		CreateBy:     task.CreateBy,
+		ExpireTime:   util.NewTimestamp(task.ExpireAt),
+		CreateTime:   util.NewTimestamp(task.CreatedAt),
+		UpdateTime:   util.NewTimestamp(task.UpdatedAt),
+	}
+	if task.Status == admin.ExportTaskStatusFinished {
+		data.DownloadUrl = DownloadURL(task.ID)
+	}
+	return data
+}
+
+// LogsSource 按操作日志列表的过滤条件导出，只导出请求时已有的记录，按 id 游标分页
+func (s *ExportService) LogsSource(ctx context.Context, req *pb.ListLogsRequest) (*admin.ExportSource, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	cond, err := logsCondition(req)
+	if err != nil {
+		return nil, err
+	}
+	if cond.MaxID, err = s.logsCase.MaxID(ctx); err != nil {
+		return nil, err
+	}
+	return &admin.ExportSource{
+		Name: i18n.Label(i18n.FromContext(ctx), "export.logs"),
+		Columns: exportColumns(ctx, nil,
+			"export.column.id", "export.logs.createdAt", "export.column.username", "export.column.nickname", "export.column.ip",
+			"export.logs.method", "export.logs.path", "export.logs.operation", "export.logs.status", "export.logs.errorCode",
+			"export.logs.reason", "export.logs.errorMessage", "export.logs.latency", "export.logs.traceId",
+		),
+		Fetch: func(ctx context.Context, page, size int32) ([][]string, int64, error) {
+			list, total, err := s.logsCase.ListPage(ctx, cond, page, size)
+			if err != nil {
+				return nil, 0, err
+			}
+			return logsExportRows(list), total, nil
+		},
+		Scan: func(ctx context.Context, after int64, size int32) ([][]string, int64, error) {
+			list, err := s.logsCase.ListAfter(ctx, cond, after, int(size))
+			if err != nil {
+				return nil, after, err
+			}
+			if len(list) > 0 {
+				after = list[len(list)-1].ID
+			}
+			return logsExportRows(list), after, nil
+		},
+	}, nil
+}
+
+func logsExportRows(list []*admin.SysLogsWithUser) [][]string {
+	rows := make([][]string, len(list))
+	for i, d := range list {
+		rows[i] = []string{
+			strconv.FormatInt(d.ID, 10), d.CreatedAt.Format("2006-01-02 15:04:05"), d.Username, d.NickName, d.IP,
+			d.Method, d.Path, d.Operation, strconv.FormatInt(d.Status, 10), strconv.Itoa(int(d.ErrorCode)),
+			d.Reason, d.ErrorMessage, strconv.FormatInt(d.Latency, 10), d.TraceID,
+		}
+	}
+	return rows
+}
+
+// UserSource 按用户列表的过滤条件导出，不导出密钥等敏感字段
+func (s *ExportService) UserSource(ctx context.Context, req *pb.ListSysUserRequest) (*admin.ExportSource, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	return &admin.ExportSource{
+		Name: i18n.Label(i18n.FromContext(ctx), "export.users"),
+		Columns: exportColumns(ctx, map[int]string{5: "sys_user_sex", 8: "sys_normal_disable"},
+			"export.users.id", "export.column.username", "export.column.nickname", "export.users.phone", "export.users.email",
+			"export.users.sex", "export.users.dept", "export.users.role",
+			"export.column.status", "export.column.remark", "export.column.createBy", "export.column.createdAt",
+		),
+		Fetch: func(ctx context.Context, page, size int32) ([][]string, int64, error) {
+			pageReq := proto.Clone(req).(*pb.ListSysUserRequest)
+			pageReq.PageNum, pageReq.PageSize = page, size
+			users, total, err := s.userCase.ListPage(ctx, pageReq)
+			if err != nil {
+				return nil, 0, err
+			}
+			deptCache := util.NewCache(func(id int64) (*model.SysDepts, error) {
+				d, err := s.deptCase.FindDept(ctx, id)
+				if d == nil {
+					d = &model.SysDepts{}
+				}
+				return d, err
+			})
+			roleCache := util.NewCache(func(id int64) (*model.SysRoles, error) {
+				d, err := s.roleCase.FindRole(ctx, id)
+				if d == nil {
+					d = &model.SysRoles{}
+				}
+				return d, err
+			})
+			rows := make([][]string, len(users))
+			for i, user := range users {
+				role, _ := roleCache.Get(user.RoleID)
+				dept, _ := deptCache.Get(user.DeptID)
+				rows[i] = []string{
+					strconv.FormatInt(user.ID, 10), user.Username, user.NickName, user.Phone, user.Email,
+					strconv.Itoa(int(user.Sex)), dept.DeptName, role.RoleName,
+					strconv.Itoa(int(user.Status)), user.Remark, user.CreateBy, user.CreatedAt.Format("2006-01-02 15:04:05"),
+				}
+			}
+			return rows, int64(total), nil
+		},
+	}, nil
+}
+
+// RoleSource 按角色列表的过滤条件导出
+func (s *ExportService) RoleSource(ctx context.Context, req *pb.ListRolesRequest) (*admin.ExportSource, error) {
+	return &admin.ExportSource{
+		Name: i18n.Label(i18n.FromContext(ctx), "export.roles"),
+		Columns: exportColumns(ctx, map[int]string{5: "sys_normal_disable"},
+			"export.roles.id", "export.roles.name", "export.roles.key", "export.roles.sort", "export.roles.dataScope",
+			"export.column.status", "export.column.remark", "export.column.createBy", "export.column.createdAt",
+		),
+		Fetch: func(ctx context.Context, page, size int32) ([][]string, int64, error) {
+			roles, total, err := s.roleCase.ListPage(ctx, req.RoleName, req.RoleKey, req.Status, page, size)
+			if err != nil {
+				return nil, 0, err
+			}
+			rows := make([][]string, len(roles))
+			for i, d := range roles {
+				rows[i] = []string{
+					strconv.FormatInt(d.ID, 10), d.RoleName, d.RoleKey, strconv.Itoa(int(d.RoleSort)), strconv.Itoa(int(d.DataScope)),
+					strconv.Itoa(int(d.Status)), d.Remark, d.CreateBy, d.CreatedAt.Format("2006-01-02 15:04:05"),
+				}
+			}
+			return rows, int64(total), nil
+		},
+	}, nil
+}
+
+// LoginLogSource 按登录日志列表的过滤条件导出
+func (s *ExportService) LoginLogSource(ctx context.Context, req *pb.ListLoginLogsRequest) (*admin.ExportSource, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	cond, err := loginLogCondition(req)
+	if err != nil {
+		return nil, err
+	}
+	locale := i18n.FromContext(ctx)
+	success, failed := i18n.Label(locale, "export.loginLogs.success"), i18n.Label(locale, "export.loginLogs.failed")
+	return &admin.ExportSource{
+		Name: i18n.Label(locale, "export.loginLogs"),
+		Columns: exportColumns(ctx, nil,
+			"export.column.id", "export.loginLogs.createdAt", "export.column.username", "export.loginLogs.result",
+			"export.loginLogs.reason", "export.loginLogs.message", "export.column.ip", "export.loginLogs.browser",
+			"export.loginLogs.os", "export.loginLogs.mfa", "export.loginLogs.agent",
+		),
+		Fetch: func(ctx context.Context, page, size int32) ([][]string, int64, error) {
+			list, total, err := s.loginLog.ListPage(ctx, cond, page, size)
+			if err != nil {
+				return nil, 0, err
+			}
+			rows := make([][]string, len(list))
+			for i, d := range list {
+				status := success
+				if d.Status == admin.LoginStatusFailed {
+					status = failed
+				}
+				rows[i] = []string{
+					strconv.FormatInt(d.ID, 10), d.CreatedAt.Format("2006-01-02 15:04:05"), d.Username, status, d.Reason, d.Message,
+					d.IP, d.Browser, d.Os, d.MfaMethod, d.Agent,
+				}
+			}
+			return rows, total, nil
+		},
+	}, nil
+}
@@ -0,0 +1,117 @@
+package admin
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+type LoginLogService struct {
+	pb.UnimplementedLoginLogServer
+	uc  *biz.SysLoginLogUseCase
+	log *log.Helper
+}
+
+func NewLoginLogService(uc *biz.SysLoginLogUseCase, logger log.Logger) *LoginLogService {
+	return &LoginLogService{
+		uc:  uc,
+		log: log.NewHelper(log.With(logger, "module", "service/loginLog")),
+	}
+}
+
+func (s *LoginLogService) ListLoginLogs(ctx context.Context, req *pb.ListLoginLogsRequest) (*pb.ListLoginLogsReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	cond, err := loginLogCondition(req)
+	if err != nil {
+		return nil, err
+	}
+	list, total, err := s.uc.ListPage(ctx, cond, req.PageNum, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*pb.LoginLogData, len(list))
+	for i, d := range list {
+		data[i] = convertLoginLog(d)
+	}
+	return &pb.ListLoginLogsReply{
+		Total:    int32(total),
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+		Data:     data,
+	}, nil
+}
+
+func (s *LoginLogService) CleanLoginLogs(ctx context.Context, req *pb.CleanLoginLogsRequest) (*pb.CleanLoginLogsReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	startTime, err := parseLoginLogTime(req.StartTime)
+	if err != nil {
+		return nil, err
+	}
+	endTime, err := parseLoginLogTime(req.EndTime)
+	if err != nil {
+		return nil, err
+	}
+	count, err := s.uc.Clean(ctx, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CleanLoginLogsReply{Count: count}, nil
+}
+
+// loginLogCondition 由列表请求构造查询条件，列表和导出共用
+func loginLogCondition(req *pb.ListLoginLogsRequest) (*admin.SysLoginLogCondition, error) {
+	cond := &admin.SysLoginLogCondition{
+		Username: req.Username,
+		IP:       req.Ip,
+		Status:   req.Status,
+	}
+	var err error
+	if req.StartTime != "" {
+		if cond.StartTime, err = parseLoginLogTime(req.StartTime); err != nil {
+			return nil, err
+		}
+	}
+	if req.EndTime != "" {
+		if cond.EndTime, err = parseLoginLogTime(req.EndTime); err != nil {
+			return nil, err
+		}
+	}
+	return cond, nil
+}
+
+func parseLoginLogTime(value string) (time.Time, error) {
+	t, err := time.ParseInLocation(time.DateTime, value, time.Local)
+	if err != nil {
+		return t, i18n.WithID(errors.BadRequest("LOGIN_LOG_TIME_INVALID", "时间格式应为 2006-01-02 15:04:05"), "time.formatInvalid")
+	}
+	return t, nil
+}
+
+func convertLoginLog(d *model.SysLoginLogs) *pb.LoginLogData {
+	return &pb.LoginLogData{
+		Id:        d.ID,
+		UserId:    d.UserID,
+		Username:  d.Username,
+		Status:    d.Status,
+		Reason:    d.Reason,
+		Message:   d.Message,
+		Ip:        d.IP,
+		Agent:     d.Agent,
+		Browser:   d.Browser,
+		Os:        d.Os,
+		MfaMethod: d.MfaMethod,
+		CreatedAt: d.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
@@ -108,7 +108,7 @@ func (s *MenusService) UpdateMenus(ctx context.Context, req *pb.UpdateMenusReque
 	}, nil
 }
 func (s *MenusService) DeleteMenus(ctx context.Context, req *pb.DeleteMenusRequest) (*pb.DeleteMenusReply, error) {
-	if err := s.menuUseCase.DeleteMenus(ctx, req.Id); err != nil {
+	if err := s.menuUseCase.DeleteMenus(ctx, req.Id, biz.DeleteOptions{Cascade: req.Cascade}); err != nil {
 		return nil, err
 	}
 	return &pb.DeleteMenusReply{}, nil
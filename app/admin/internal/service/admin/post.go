@@ -81,6 +81,6 @@ func (s *PostService) UpdatePost(ctx context.Context, req *pb.UpdatePostRequest)
 }
 func (s *PostService) DeletePost(ctx context.Context, req *pb.DeletePostRequest) (*pb.DeletePostReply, error) {
 	ids := util.Split2Int64Slice(req.Id)
-	err := s.pc.DeletePost(ctx, ids)
+	err := s.pc.DeletePost(ctx, ids, biz.DeleteOptions{ReassignTo: req.ReassignPostId})
 	return &pb.DeletePostReply{}, err
 }
@@ -0,0 +1,73 @@
+package admin
+
+import (
+	"context"
+
+	"github.com/go-kratos/kratos/v2/log"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
+	"github.com/swordkee/kratos-vue-admin/pkg/util"
+)
+
+type RecycleService struct {
+	pb.UnimplementedRecycleServer
+	uc  *biz.SysRecycleUseCase
+	log *log.Helper
+}
+
+func NewRecycleService(uc *biz.SysRecycleUseCase, logger log.Logger) *RecycleService {
+	return &RecycleService{
+		uc:  uc,
+		log: log.NewHelper(log.With(logger, "module", "service/recycle")),
+	}
+}
+
+func (s *RecycleService) ListRecycle(ctx context.Context, req *pb.ListRecycleRequest) (*pb.ListRecycleReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	list, total, err := s.uc.ListPage(ctx, req.Entity, req.PageNum, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*pb.RecycleData, len(list))
+	for i, item := range list {
+		data[i] = &pb.RecycleData{
+			Id:        item.ID,
+			Name:      item.Name,
+			Key:       item.Key,
+			ParentId:  item.ParentID,
+			DeleteBy:  item.DeleteBy,
+			DeletedAt: item.DeletedAt.Format("2006-01-02 15:04:05"),
+		}
+	}
+	return &pb.ListRecycleReply{
+		Total:    int32(total),
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+		Data:     data,
+	}, nil
+}
+
+func (s *RecycleService) RestoreRecycle(ctx context.Context, req *pb.RestoreRecycleRequest) (*pb.RestoreRecycleReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	count, err := s.uc.Restore(ctx, req.Entity, req.Ids)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.RestoreRecycleReply{Count: int32(count)}, nil
+}
+
+func (s *RecycleService) PurgeRecycle(ctx context.Context, req *pb.PurgeRecycleRequest) (*pb.PurgeRecycleReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	count, err := s.uc.Purge(ctx, req.Entity, util.Split2Int64Slice(req.Ids))
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PurgeRecycleReply{Count: int32(count)}, nil
+}
@@ -2,28 +2,35 @@ package admin
 
 import (
 	"context"
+	"encoding/json"
+	"strings"
 
 	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
 	"github.com/swordkee/kratos-vue-admin/pkg/util"
 
+	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
+	"gopkg.in/yaml.v3"
 )
 
 type RolesService struct {
 	pb.UnimplementedRolesServer
-	rc     *biz.SysRoleUseCase
-	casbin *admin.CasbinRuleUseCase
-	log    *log.Helper
+	rc        *biz.SysRoleUseCase
+	casbin    *admin.CasbinRuleUseCase
+	tempGrant *biz.SysTempGrantUseCase
+	log       *log.Helper
 }
 
-func NewRolesService(rc *biz.SysRoleUseCase, logger log.Logger, casbin *admin.CasbinRuleUseCase) *RolesService {
+func NewRolesService(rc *biz.SysRoleUseCase, logger log.Logger, casbin *admin.CasbinRuleUseCase, tempGrant *biz.SysTempGrantUseCase) *RolesService {
 	return &RolesService{
-		rc:     rc,
-		casbin: casbin,
-		log:    log.NewHelper(log.With(logger, "module", "service/roles")),
+		rc:        rc,
+		casbin:    casbin,
+		tempGrant: tempGrant,
+		log:       log.NewHelper(log.With(logger, "module", "service/roles")),
 	}
 }
 
@@ -133,6 +140,100 @@ func (r *RolesService) DataScope(ctx context.Context, req *pb.DataScopeRequest)
 
 func (r *RolesService) DeleteRoles(ctx context.Context, req *pb.DeleteRolesRequest) (*pb.DeleteRolesReply, error) {
 	ids := util.Split2Int64Slice(req.Id)
-	err := r.rc.DeleteRole(ctx, ids)
+	err := r.rc.DeleteRole(ctx, ids, biz.DeleteOptions{ReassignTo: req.ReassignRoleId})
 	return &pb.DeleteRolesReply{}, err
 }
+
+// ExportRoles 导出角色（含菜单、api权限、按钮）
+func (r *RolesService) ExportRoles(ctx context.Context, req *pb.ExportRolesRequest) (*pb.ExportRolesReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	var roleKeys []string
+	for _, key := range strings.Split(req.RoleKeys, ",") {
+		if key = strings.TrimSpace(key); key != "" {
+			roleKeys = append(roleKeys, key)
+		}
+	}
+
+	file, err := r.rc.ExportRoles(ctx, roleKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	format := roleTransferFormat(req.Format)
+	var content []byte
+	if format == "json" {
+		content, err = json.MarshalIndent(file, "", "  ")
+	} else {
+		content, err = yaml.Marshal(file)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ExportRolesReply{
+		Format:  format,
+		Content: string(content),
+	}, nil
+}
+
+// ImportRoles 导入角色
+func (r *RolesService) ImportRoles(ctx context.Context, req *pb.ImportRolesRequest) (*pb.ImportRolesReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	file := &admin.RoleExportFile{}
+	var err error
+	if roleTransferFormat(req.Format) == "json" {
+		err = json.Unmarshal([]byte(req.Content), file)
+	} else {
+		err = yaml.Unmarshal([]byte(req.Content), file)
+	}
+	if err != nil {
+		return nil, i18n.WithID(errors.BadRequest("ROLE_IMPORT_INVALID", "导入内容格式错误: "+err.Error()).WithCause(err), "import.contentInvalid")
+	}
+
+	diffs, warnings, err := r.rc.ImportRoles(ctx, file, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	reply := &pb.ImportRolesReply{
+		Roles:    make([]*pb.RoleImportDiff, len(diffs)),
+		Warnings: warnings,
+	}
+	for i, d := range diffs {
+		reply.Roles[i] = &pb.RoleImportDiff{
+			RoleKey:       d.RoleKey,
+			Action:        d.Action,
+			ChangedFields: d.ChangedFields,
+			AddedMenus:    d.AddedMenus,
+			RemovedMenus:  d.RemovedMenus,
+			AddedApis:     convertRoleExportApis(d.AddedApis),
+			RemovedApis:   convertRoleExportApis(d.RemovedApis),
+			AddedBtns:     d.AddedBtns,
+			RemovedBtns:   d.RemovedBtns,
+		}
+	}
+	return reply, nil
+}
+
+// roleTransferFormat 导入导出格式，默认 yaml
+func roleTransferFormat(format string) string {
+	if format == "json" {
+		return format
+	}
+	return "yaml"
+}
+
+func convertRoleExportApis(list []*admin.RoleExportApi) []*pb.ApiBase {
+	data := make([]*pb.ApiBase, len(list))
+	for i, v := range list {
+		data[i] = &pb.ApiBase{
+			Path:   v.Path,
+			Method: v.Method,
+		}
+	}
+	return data
+}
@@ -4,22 +4,33 @@ import (
 	"context"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/go-kratos/kratos/v2/errors"
 	"github.com/go-kratos/kratos/v2/log"
 	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
 )
 
+// logsStreamHeartbeat 实时推送的心跳间隔，用于保持连接和发现已断开的客户端
+const logsStreamHeartbeat = 15 * time.Second
+
 type SysLogsService struct {
 	pb.UnimplementedLogsServiceServer
 	opRecordsCase *biz.SysLogsUseCase
+	logsStream    *biz.SysLogsStream
+	userCase      *biz.SysUserUseCase
 	log           *log.Helper
 }
 
-func NewSysLogsService(opRecordsCase *biz.SysLogsUseCase, logger log.Logger) *SysLogsService {
+func NewSysLogsService(opRecordsCase *biz.SysLogsUseCase, logsStream *biz.SysLogsStream, userCase *biz.SysUserUseCase, logger log.Logger) *SysLogsService {
 	return &SysLogsService{
 		opRecordsCase: opRecordsCase,
+		logsStream:    logsStream,
+		userCase:      userCase,
 		log:           log.NewHelper(log.With(logger, "module", "service/operation_records")),
 	}
 }
@@ -33,7 +44,7 @@ func (s *SysLogsService) FindLogs(ctx context.Context, req *pb.FindLogsRequest)
 	record, err := s.opRecordsCase.FindOperationRecordById(ctx, req.Id)
 	if err != nil {
 		s.log.Error(err)
-		return nil, errors.InternalServer("OPERATION_RECORD_GET_FAILED", "failed to get operation record")
+		return nil, i18n.WithID(errors.InternalServer("OPERATION_RECORD_GET_FAILED", "failed to get operation record"), "logs.getFailed")
 	}
 
 	return &pb.FindLogsReply{
@@ -44,7 +55,9 @@ func (s *SysLogsService) FindLogs(ctx context.Context, req *pb.FindLogsRequest)
 			UserId:    record.UserID,
 			Ip:        record.IP,
 			Method:    record.Method,
+			Operation: record.Operation,
 			Status:    int32(record.Status),
+			Changes:   convertLogFieldChanges(record.Changes),
 		},
 	}, nil
 }
@@ -72,8 +85,11 @@ func (s *SysLogsService) DeleteLogsByIds(ctx context.Context, req *pb.DeleteLogs
 
 	err := s.opRecordsCase.DeleteByIds(ctx, ids)
 	if err != nil {
+		if errors.FromError(err).Code != errors.UnknownCode {
+			return nil, err
+		}
 		s.log.Error(err)
-		return nil, errors.InternalServer("OPERATION_RECORD_DELETE_FAILED", "failed to delete operation records")
+		return nil, i18n.WithID(errors.InternalServer("OPERATION_RECORD_DELETE_FAILED", "failed to delete operation records"), "logs.deleteFailed")
 	}
 
 	return &pb.DeleteLogsByIdsReply{}, nil
@@ -85,28 +101,20 @@ func (s *SysLogsService) ListLogs(ctx context.Context, req *pb.ListLogsRequest)
 		return nil, err
 	}
 
-	result, count, err := s.opRecordsCase.ListPage(ctx, req.PageNum, req.PageSize)
+	cond, err := logsCondition(req)
+	if err != nil {
+		return nil, err
+	}
+
+	result, count, err := s.opRecordsCase.ListPage(ctx, cond, req.PageNum, req.PageSize)
 	if err != nil {
 		s.log.Error(err)
-		return nil, errors.InternalServer("OPERATION_RECORD_LIST_FAILED", "failed to list operation records")
+		return nil, i18n.WithID(errors.InternalServer("OPERATION_RECORD_LIST_FAILED", "failed to list operation records"), "logs.listFailed")
 	}
 
 	replyList := make([]*pb.SysLogsDetail, len(result))
 	for i, d := range result {
-		replyList[i] = &pb.SysLogsDetail{
-			Id:           d.ID,
-			CreatedAt:    d.CreatedAt.Format("2006-01-02 15:04:05"),
-			UpdatedAt:    d.UpdatedAt.Format("2006-01-02 15:04:05"),
-			Ip:           d.IP,
-			Method:       d.Method,
-			Path:         d.Path,
-			Status:       int32(d.Status),
-			Agent:        d.Agent,
-			UserId:       d.UserID,
-			ErrorMessage: d.ErrorMessage,
-			Body:         d.Body,
-			Resp:         d.Resp,
-		}
+		replyList[i] = convertLogDetail(&d.SysLogs, d.Username, d.NickName)
 	}
 
 	return &pb.ListLogsReply{
@@ -115,6 +123,61 @@ func (s *SysLogsService) ListLogs(ctx context.Context, req *pb.ListLogsRequest)
 	}, nil
 }
 
+func convertLogDetail(d *model.SysLogs, username, nickname string) *pb.SysLogsDetail {
+	return &pb.SysLogsDetail{
+		Id:           d.ID,
+		CreatedAt:    d.CreatedAt.Format("2006-01-02 15:04:05"),
+		UpdatedAt:    d.UpdatedAt.Format("2006-01-02 15:04:05"),
+		Ip:           d.IP,
+		Method:       d.Method,
+		Path:         d.Path,
+		Status:       int32(d.Status),
+		Agent:        d.Agent,
+		UserId:       d.UserID,
+		ErrorMessage: d.ErrorMessage,
+		Body:         d.Body,
+		Resp:         d.Resp,
+		Username:     username,
+		Nickname:     nickname,
+		Operation:    d.Operation,
+		Reason:       d.Reason,
+		ErrorCode:    d.ErrorCode,
+		TraceId:      d.TraceID,
+		Seq:          d.Seq,
+		Hash:         d.Hash,
+	}
+}
+
+// logsCondition 由列表请求构造查询条件，列表和导出共用
+func logsCondition(req *pb.ListLogsRequest) (*admin.SysLogsCondition, error) {
+	cond := &admin.SysLogsCondition{
+		Username:  req.Username,
+		Nickname:  req.Nickname,
+		Operation: req.Operation,
+		Path:      req.Path,
+		Method:    strings.ToUpper(req.Method),
+		IP:        req.Ip,
+		Status:    int64(req.Status),
+		Reason:    req.Reason,
+		TraceID:   req.TraceId,
+		Failed:    req.Failed,
+		SortField: req.SortField,
+		SortAsc:   strings.EqualFold(req.SortOrder, "asc"),
+	}
+	var err error
+	if req.StartTime != "" {
+		if cond.StartTime, err = time.ParseInLocation(time.DateTime, req.StartTime, time.Local); err != nil {
+			return nil, i18n.WithID(errors.BadRequest("OPERATION_RECORD_TIME_INVALID", "start_time 格式应为 2006-01-02 15:04:05"), "time.fieldFormatInvalid", "start_time")
+		}
+	}
+	if req.EndTime != "" {
+		if cond.EndTime, err = time.ParseInLocation(time.DateTime, req.EndTime, time.Local); err != nil {
+			return nil, i18n.WithID(errors.BadRequest("OPERATION_RECORD_TIME_INVALID", "end_time 格式应为 2006-01-02 15:04:05"), "time.fieldFormatInvalid", "end_time")
+		}
+	}
+	return cond, nil
+}
+
 // CleanSysOperationRecords 清理指定时间范围内的操作记录
 func (s *SysLogsService) CleanLogs(ctx context.Context, req *pb.CleanLogsRequest) (*pb.CleanLogsReply, error) {
 	if err := req.Validate(); err != nil {
@@ -123,11 +186,214 @@ func (s *SysLogsService) CleanLogs(ctx context.Context, req *pb.CleanLogsRequest
 
 	err := s.opRecordsCase.DeleteByTimeRange(ctx, req.StartTime, req.EndTime)
 	if err != nil {
+		if errors.FromError(err).Code != errors.UnknownCode {
+			return nil, err
+		}
 		s.log.Error(err)
-		return nil, errors.InternalServer("OPERATION_RECORD_CLEAN_FAILED", "failed to clean operation records")
+		return nil, i18n.WithID(errors.InternalServer("OPERATION_RECORD_CLEAN_FAILED", "failed to clean operation records"), "logs.cleanFailed")
 	}
 
 	return &pb.CleanLogsReply{
 		Deleted: 0, // Return actual count if available
 	}, nil
 }
+
+// VerifyLogs 校验审计哈希链
+func (s *SysLogsService) VerifyLogs(ctx context.Context, req *pb.VerifyLogsRequest) (*pb.VerifyLogsReply, error) {
+	result, err := s.opRecordsCase.VerifyChain(ctx)
+	if err != nil {
+		return nil, err
+	}
+	problems := make([]*pb.LogChainProblem, 0, len(result.Problems))
+	for _, p := range result.Problems {
+		problems = append(problems, &pb.LogChainProblem{
+			Seq:     p.Seq,
+			Id:      p.ID,
+			Kind:    p.Kind,
+			Message: p.Message,
+		})
+	}
+	return &pb.VerifyLogsReply{
+		Valid:       result.Valid,
+		Checked:     result.Checked,
+		LastSeq:     result.LastSeq,
+		Checkpoints: int32(result.Checkpoints),
+		Problems:    problems,
+	}, nil
+}
+
+// GetLogsWriterStats 操作记录异步写入的统计
+func (s *SysLogsService) GetLogsWriterStats(ctx context.Context, req *pb.GetLogsWriterStatsRequest) (*pb.GetLogsWriterStatsReply, error) {
+	stats := s.opRecordsCase.WriterStats()
+	return &pb.GetLogsWriterStatsReply{
+		Queued:          stats.Queued,
+		Capacity:        stats.Capacity,
+		Written:         stats.Written,
+		Failed:          stats.Failed,
+		Dropped:         stats.Dropped,
+		FlushFailures:   stats.FlushFailures,
+		PublishFailures: stats.PublishFailures,
+	}, nil
+}
+
+// ArchiveLogs 归档截止时间之前的审计记录
+func (s *SysLogsService) ArchiveLogs(ctx context.Context, req *pb.ArchiveLogsRequest) (*pb.ArchiveLogsReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	endTime, err := time.ParseInLocation(time.DateTime, req.EndTime, time.Local)
+	if err != nil {
+		return nil, i18n.WithID(errors.BadRequest("OPERATION_RECORD_TIME_INVALID", "end_time 格式应为 2006-01-02 15:04:05"), "time.fieldFormatInvalid", "end_time")
+	}
+	cp, err := s.opRecordsCase.Archive(ctx, endTime)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ArchiveLogsReply{Checkpoint: convertLogCheckpoint(cp)}, nil
+}
+
+// ListLogCheckpoints 归档检查点列表
+func (s *SysLogsService) ListLogCheckpoints(ctx context.Context, req *pb.ListLogCheckpointsRequest) (*pb.ListLogCheckpointsReply, error) {
+	list, err := s.opRecordsCase.ListCheckpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ListLogCheckpointsReply{List: make([]*pb.LogCheckpoint, 0, len(list))}
+	for _, cp := range list {
+		reply.List = append(reply.List, convertLogCheckpoint(cp))
+	}
+	return reply, nil
+}
+
+func convertLogCheckpoint(cp *model.SysLogCheckpoints) *pb.LogCheckpoint {
+	return &pb.LogCheckpoint{
+		Id:        cp.ID,
+		StartSeq:  cp.StartSeq,
+		EndSeq:    cp.EndSeq,
+		PrevHash:  cp.PrevHash,
+		EndHash:   cp.EndHash,
+		Count:     cp.Count,
+		EndTime:   cp.EndTime.Format(time.DateTime),
+		Signature: cp.Signature,
+		CreateBy:  cp.CreateBy,
+		CreatedAt: cp.CreatedAt.Format(time.DateTime),
+	}
+}
+
+// ListEntityHistory 实体的字段变更历史
+func (s *SysLogsService) ListEntityHistory(ctx context.Context, req *pb.ListEntityHistoryRequest) (*pb.ListEntityHistoryReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	list, total, err := s.opRecordsCase.ListChanges(ctx, req.Entity, req.EntityId, req.PageNum, req.PageSize)
+	if err != nil {
+		s.log.Error(err)
+		return nil, i18n.WithID(errors.InternalServer("OPERATION_RECORD_LIST_FAILED", "failed to list entity history"), "logs.historyListFailed")
+	}
+	reply := &pb.ListEntityHistoryReply{
+		Total: int32(total),
+		List:  make([]*pb.LogFieldChange, len(list)),
+	}
+	for i, d := range list {
+		change := convertLogFieldChange(&d.SysLogChanges)
+		change.Username = d.Username
+		change.Nickname = d.NickName
+		reply.List[i] = change
+	}
+	return reply, nil
+}
+
+func convertLogFieldChanges(list []model.SysLogChanges) []*pb.LogFieldChange {
+	changes := make([]*pb.LogFieldChange, len(list))
+	for i := range list {
+		changes[i] = convertLogFieldChange(&list[i])
+	}
+	return changes
+}
+
+func convertLogFieldChange(c *model.SysLogChanges) *pb.LogFieldChange {
+	return &pb.LogFieldChange{
+		Id:        c.ID,
+		LogId:     c.LogID,
+		Entity:    c.Entity,
+		EntityId:  c.EntityID,
+		Field:     c.Field,
+		OldValue:  c.OldValue,
+		NewValue:  c.NewValue,
+		UserId:    c.UserID,
+		Operation: c.Operation,
+		CreatedAt: c.CreatedAt.Format("2006-01-02 15:04:05"),
+	}
+}
+
+// LogsStreamSender 实时推送的发送端，由 HTTP 层按 SSE 格式实现
+type LogsStreamSender interface {
+	// Open 订阅成功后写出响应头，之后的错误无法再返回给客户端
+	Open() error
+	Send(*pb.SysLogsDetail) error
+	// Heartbeat 保持连接，dropped 为因消费过慢累计丢弃的条数
+	Heartbeat(dropped int64) error
+}
+
+// StreamLogs 按过滤条件持续推送新写入的操作记录，直到 ctx 结束、发送失败或服务停止；
+// 每次心跳前调用 revalidate 重新校验登录状态和权限，校验失败时结束推送
+func (s *SysLogsService) StreamLogs(ctx context.Context, req *pb.StreamLogsRequest, sender LogsStreamSender, revalidate func(context.Context) error) error {
+	filter := admin.SysLogsStreamFilter{
+		UserID:     req.UserId,
+		PathPrefix: req.Path,
+		Failed:     req.Failed,
+	}
+	if req.Username != "" {
+		user, err := s.userCase.FindSysUserByUsername(ctx, req.Username)
+		if err != nil {
+			return i18n.WithID(errors.BadRequest("OPERATION_RECORD_USER_NOT_FOUND", "用户不存在"), "user.notFound")
+		}
+		if filter.UserID > 0 && filter.UserID != user.ID {
+			return i18n.WithID(errors.BadRequest("OPERATION_RECORD_USER_CONFLICT", "user_id 与 username 不一致"), "logs.userConflict")
+		}
+		filter.UserID = user.ID
+	}
+
+	sub, err := s.logsStream.Subscribe(filter)
+	if err != nil {
+		return err
+	}
+	defer sub.Close()
+	if err = sender.Open(); err != nil {
+		return err
+	}
+
+	// 连接期间缓存用户名，避免每条记录都查询用户
+	users := make(map[int64]*model.SysUsers)
+	ticker := time.NewTicker(logsStreamHeartbeat)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+			if err := revalidate(ctx); err != nil {
+				return err
+			}
+			if err := sender.Heartbeat(sub.Dropped()); err != nil {
+				return err
+			}
+		case record, ok := <-sub.C():
+			if !ok {
+				return nil
+			}
+			user, cached := users[record.UserID]
+			if !cached && record.UserID > 0 {
+				user, _ = s.userCase.FindSysUserById(ctx, record.UserID)
+				users[record.UserID] = user
+			}
+			var username, nickname string
+			if user != nil {
+				username, nickname = user.Username, user.NickName
+			}
+			if err := sender.Send(convertLogDetail(record, username, nickname)); err != nil {
+				return err
+			}
+		}
+	}
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/conf"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
 	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/authz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
 	"github.com/swordkee/kratos-vue-admin/pkg/util"
 )
 
@@ -26,10 +27,12 @@ type SysUserService struct {
 	roleMenuCase *biz.SysRoleMenuUseCase
 	postCase     *biz.SysPostUseCase
 	deptCase     *biz.SysDeptUseCase
+	loginLogCase *biz.SysLoginLogUseCase
+	importCase   *biz.SysUserImportUseCase
 	log          *log.Helper
 }
 
-func NewSysUserService(serverConf *conf.Server, userCase *admin.SysUserUseCase, authCase *admin.AuthUseCase, roleCase *admin.SysRoleUseCase, roleMenuCase *admin.SysRoleMenuUseCase, postCase *admin.SysPostUseCase, deptCase *admin.SysDeptUseCase, logger log.Logger) *SysUserService {
+func NewSysUserService(serverConf *conf.Server, userCase *admin.SysUserUseCase, authCase *admin.AuthUseCase, roleCase *admin.SysRoleUseCase, roleMenuCase *admin.SysRoleMenuUseCase, postCase *admin.SysPostUseCase, deptCase *admin.SysDeptUseCase, loginLogCase *admin.SysLoginLogUseCase, importCase *admin.SysUserImportUseCase, logger log.Logger) *SysUserService {
 	return &SysUserService{
 		serverConf:   serverConf,
 		userCase:     userCase,
@@ -38,6 +41,8 @@ func NewSysUserService(serverConf *conf.Server, userCase *admin.SysUserUseCase,
 		roleMenuCase: roleMenuCase,
 		postCase:     postCase,
 		deptCase:     deptCase,
+		loginLogCase: loginLogCase,
+		importCase:   importCase,
 		log:          log.NewHelper(log.With(logger, "module", "service/SysUser")),
 	}
 }
@@ -230,6 +235,15 @@ func (s *SysUserService) ListSysUser(ctx context.Context, req *pb.ListSysUserReq
 		return d, err
 	})
 
+	userIDs := make([]int64, len(users))
+	for i, user := range users {
+		userIDs[i] = user.ID
+	}
+	lastLogins, err := s.loginLogCase.LastLogins(ctx, userIDs)
+	if err != nil {
+		return nil, err
+	}
+
 	gAuth := util.NewGoogleAuth()
 	replyData := make([]*pb.UserData, len(users))
 	for i, user := range users {
@@ -259,6 +273,10 @@ func (s *SysUserService) ListSysUser(ctx context.Context, req *pb.ListSysUserReq
 			Secret:     user.Secret,
 			Qrcode:     gAuth.GetQrcode(user.Secret),
 		}
+		if last, ok := lastLogins[user.ID]; ok {
+			replyData[i].LastLoginTime = util.NewTimestamp(last.CreatedAt)
+			replyData[i].LastLoginIp = last.IP
+		}
 	}
 
 	return &pb.ListSysUserReply{
@@ -286,14 +304,15 @@ func (s *SysUserService) Login(ctx context.Context, req *pb.LoginRequest) (*pb.L
 		return nil, err
 	}
 
-	token, expireAt, err := s.authCase.Login(ctx, req)
+	token, expireAt, mustChangePassword, err := s.authCase.Login(ctx, req)
 	if err != nil {
 		return nil, err
 	}
 
 	return &pb.LoginReply{
-		Token:  token,
-		Expire: expireAt,
+		Token:              token,
+		Expire:             expireAt,
+		MustChangePassword: mustChangePassword,
 	}, nil
 }
 
@@ -371,6 +390,7 @@ func (s *SysUserService) Auth(ctx context.Context, req *pb.AuthRequest) (*pb.Aut
 		UpdatedAt: util.NewTimestamp(user.UpdatedAt),
 		Username:  user.Username,
 		RoleName:  role.RoleName,
+		Locale:    user.Locale,
 	}
 
 	pbRole := &pb.AuthReply_Role{
@@ -390,11 +410,30 @@ func (s *SysUserService) Auth(ctx context.Context, req *pb.AuthRequest) (*pb.Aut
 		UpdatedAt: util.NewTimestamp(user.UpdatedAt),
 	}
 
+	// 模拟登录时返回真实操作人，用于前端展示提示横幅
+	var pbImpersonator *pb.AuthReply_Impersonator
+	if claims, err := authz.FromContext(ctx); err == nil && claims.IsImpersonated() {
+		impersonator, err := s.userCase.FindSysUserById(ctx, claims.ImpersonatorID)
+		if err != nil {
+			return nil, err
+		}
+		pbImpersonator = &pb.AuthReply_Impersonator{
+			UserId:   impersonator.ID,
+			Username: impersonator.Username,
+			NickName: impersonator.NickName,
+		}
+		if claims.ExpiresAt != nil {
+			pbImpersonator.Expire = claims.ExpiresAt.Unix()
+		}
+	}
+
 	return &pb.AuthReply{
-		User:        pbUser,
-		Role:        pbRole,
-		Permissions: permits,
-		Menus:       Build(menus),
+		User:         pbUser,
+		Role:         pbRole,
+		Permissions:  permits,
+		Menus:        Build(menus),
+		Impersonator: pbImpersonator,
+		Locale:       i18n.FromContext(ctx),
 	}, nil
 }
 
@@ -539,6 +578,61 @@ func (s *SysUserService) GetUserGoogleSecret(ctx context.Context, req *pb.FindUs
 	return rep, nil
 }
 
+// ImpersonateSysUser 模拟登录指定用户
+func (s *SysUserService) ImpersonateSysUser(ctx context.Context, req *pb.ImpersonateSysUserRequest) (*pb.ImpersonateSysUserReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	token, expireAt, err := s.authCase.Impersonate(ctx, req.UserId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ImpersonateSysUserReply{
+		Token:  token,
+		Expire: expireAt,
+	}, nil
+}
+
+// ResetSysUserPassword 管理员重置用户密码
+func (s *SysUserService) ResetSysUserPassword(ctx context.Context, req *pb.ResetSysUserPasswordRequest) (*pb.ResetSysUserPasswordReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	password, err := s.authCase.ResetPassword(ctx, req.UserId, req.Password)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.ResetSysUserPasswordReply{Password: password}, nil
+}
+
+// RevokeSysUserSessions 撤销用户的全部登录
+func (s *SysUserService) RevokeSysUserSessions(ctx context.Context, req *pb.RevokeSysUserSessionsRequest) (*pb.RevokeSysUserSessionsReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.authCase.RevokeSessions(ctx, req.UserId); err != nil {
+		return nil, err
+	}
+	return &pb.RevokeSysUserSessionsReply{}, nil
+}
+
+// UpdateLocale 修改当前用户的语言偏好
+func (s *SysUserService) UpdateLocale(ctx context.Context, req *pb.UpdateLocaleRequest) (*pb.UpdateLocaleReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	token, expireAt, err := s.authCase.UpdateLocale(ctx, req.Locale)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.UpdateLocaleReply{
+		Token:  token,
+		Expire: expireAt,
+	}, nil
+}
+
 func (s *SysUserService) UploadFile(ctx context.Context) (string, error) {
 	return s.userCase.UploadFile(ctx)
 }
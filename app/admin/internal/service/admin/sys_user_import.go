@@ -0,0 +1,70 @@
+package admin
+
+import (
+	"context"
+	"encoding/base64"
+
+	"github.com/go-kratos/kratos/v2/errors"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/export"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// ImportPasswords 取出导入用户时随机生成的初始密码，只能取出一次
+func (s *SysUserService) ImportPasswords(ctx context.Context, token string) ([][]string, error) {
+	return s.importCase.TakePasswords(ctx, token)
+}
+
+// ImportSysUsers 批量导入用户
+func (s *SysUserService) ImportSysUsers(ctx context.Context, req *pb.ImportSysUsersRequest) (*pb.ImportSysUsersReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+
+	var table [][]string
+	var err error
+	if req.Format == export.FormatCSV {
+		table, err = export.ReadCSV([]byte(req.Content))
+	} else {
+		var data []byte
+		if data, err = base64.StdEncoding.DecodeString(req.Content); err == nil {
+			table, err = export.ReadXLSX(data)
+		}
+	}
+	if err != nil {
+		return nil, i18n.WithID(errors.BadRequest("USER_IMPORT_INVALID", "导入内容格式错误: "+err.Error()).WithCause(err), "import.contentInvalid")
+	}
+	rows, err := admin.ParseUserImportTable(table)
+	if err != nil {
+		return nil, err
+	}
+
+	results, passwordToken, err := s.importCase.ImportUsers(ctx, rows, req.DryRun)
+	if err != nil {
+		return nil, err
+	}
+	reply := &pb.ImportSysUsersReply{
+		Total:         int32(len(results)),
+		Rows:          make([]*pb.UserImportResult, len(results)),
+		PasswordToken: passwordToken,
+	}
+	for i, r := range results {
+		if len(r.Errors) > 0 {
+			reply.Invalid++
+		} else {
+			reply.Valid++
+		}
+		if r.Created {
+			reply.Created++
+		}
+		reply.Rows[i] = &pb.UserImportResult{
+			Line:     int32(r.Line),
+			Username: r.Username,
+			Errors:   r.Errors,
+			Created:  r.Created,
+		}
+	}
+	return reply, nil
+}
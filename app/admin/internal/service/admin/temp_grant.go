@@ -0,0 +1,79 @@
+package admin
+
+import (
+	"context"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/pkg/util"
+)
+
+// CreateTempGrant 创建临时授权
+func (r *RolesService) CreateTempGrant(ctx context.Context, req *pb.CreateTempGrantRequest) (*pb.CreateTempGrantReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	grant := &model.SysTempGrants{
+		UserID:    req.UserId,
+		GrantType: req.GrantType,
+		RoleKey:   req.RoleKey,
+		Reason:    req.Reason,
+	}
+	if req.StartTime != nil {
+		grant.StartTime = util.TimestampToTime(req.StartTime)
+	}
+	if req.EndTime != nil {
+		grant.EndTime = util.TimestampToTime(req.EndTime)
+	}
+	grant, err := r.tempGrant.Grant(ctx, grant, req.Apis)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.CreateTempGrantReply{Grant: convertTempGrant(grant)}, nil
+}
+
+// RevokeTempGrant 撤销临时授权
+func (r *RolesService) RevokeTempGrant(ctx context.Context, req *pb.RevokeTempGrantRequest) (*pb.RevokeTempGrantReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	err := r.tempGrant.Revoke(ctx, req.Id)
+	return &pb.RevokeTempGrantReply{}, err
+}
+
+// ListTempGrants 临时授权列表
+func (r *RolesService) ListTempGrants(ctx context.Context, req *pb.ListTempGrantsRequest) (*pb.ListTempGrantsReply, error) {
+	list, total, err := r.tempGrant.ListPage(ctx, req.UserId, req.History, req.PageNum, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*pb.TempGrant, len(list))
+	for i, d := range list {
+		data[i] = convertTempGrant(d)
+	}
+	return &pb.ListTempGrantsReply{
+		Total:    total,
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+		Data:     data,
+	}, nil
+}
+
+func convertTempGrant(grant *model.SysTempGrants) *pb.TempGrant {
+	return &pb.TempGrant{
+		Id:         grant.ID,
+		UserId:     grant.UserID,
+		GrantType:  grant.GrantType,
+		RoleKey:    grant.RoleKey,
+		Apis:       admin.DecodeTempGrantApis(grant),
+		StartTime:  util.NewTimestamp(grant.StartTime),
+		EndTime:    util.NewTimestamp(grant.EndTime),
+		Status:     grant.Status,
+		Reason:     grant.Reason,
+		CreateBy:   grant.CreateBy,
+		RevokeBy:   grant.RevokeBy,
+		CreateTime: util.NewTimestamp(grant.CreatedAt),
+		UpdateTime: util.NewTimestamp(grant.UpdatedAt),
+	}
+}
@@ -0,0 +1,116 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/go-kratos/kratos/v2/errors"
+	"github.com/go-kratos/kratos/v2/log"
+
+	pb "github.com/swordkee/kratos-vue-admin/api/admin/v1"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/biz/admin"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/data/gen/model"
+	"github.com/swordkee/kratos-vue-admin/app/admin/internal/pkg/i18n"
+)
+
+// saveTranslationsLimit 一次最多保存的翻译条数
+const saveTranslationsLimit = 500
+
+type TranslationService struct {
+	pb.UnimplementedTranslationServer
+	uc  *biz.SysTranslationUseCase
+	log *log.Helper
+}
+
+func NewTranslationService(uc *biz.SysTranslationUseCase, logger log.Logger) *TranslationService {
+	return &TranslationService{
+		uc:  uc,
+		log: log.NewHelper(log.With(logger, "module", "service/translation")),
+	}
+}
+
+func (s *TranslationService) ListLocales(ctx context.Context, _ *pb.ListLocalesRequest) (*pb.ListLocalesReply, error) {
+	return &pb.ListLocalesReply{
+		DefaultLocale: i18n.DefaultLocale,
+		Locales:       i18n.Locales,
+		Current:       i18n.FromContext(ctx),
+	}, nil
+}
+
+func (s *TranslationService) ListTranslations(ctx context.Context, req *pb.ListTranslationsRequest) (*pb.ListTranslationsReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	cond := &admin.SysTranslationCondition{
+		Module:    req.Module,
+		Locale:    req.Locale,
+		KeyPrefix: req.TransKey,
+	}
+	if req.Locale != "" {
+		if locale, ok := i18n.Normalize(req.Locale); ok {
+			cond.Locale = locale
+		}
+	}
+	list, total, err := s.uc.ListPage(ctx, cond, req.PageNum, req.PageSize)
+	if err != nil {
+		return nil, err
+	}
+	data := make([]*pb.TranslationData, len(list))
+	for i, d := range list {
+		data[i] = &pb.TranslationData{
+			Id:        d.ID,
+			Module:    d.Module,
+			TransKey:  d.TransKey,
+			Locale:    d.Locale,
+			Value:     d.Value,
+			CreateBy:  d.CreateBy,
+			UpdateBy:  d.UpdateBy,
+			CreatedAt: d.CreatedAt.Format("2006-01-02 15:04:05"),
+			UpdatedAt: d.UpdatedAt.Format("2006-01-02 15:04:05"),
+		}
+	}
+	return &pb.ListTranslationsReply{
+		Total:    int32(total),
+		PageNum:  req.PageNum,
+		PageSize: req.PageSize,
+		Data:     data,
+	}, nil
+}
+
+func (s *TranslationService) SaveTranslations(ctx context.Context, req *pb.SaveTranslationsRequest) (*pb.SaveTranslationsReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if len(req.Items) == 0 {
+		return nil, i18n.WithID(errors.BadRequest("TRANSLATION_INVALID", "翻译内容不能为空"), "translation.empty")
+	}
+	if len(req.Items) > saveTranslationsLimit {
+		return nil, i18n.WithID(errors.BadRequest("TRANSLATION_INVALID", fmt.Sprintf("一次最多保存 %d 条翻译", saveTranslationsLimit)), "translation.tooMany", strconv.Itoa(saveTranslationsLimit))
+	}
+	items := make([]*model.SysTranslations, len(req.Items))
+	for i, item := range req.Items {
+		items[i] = &model.SysTranslations{
+			Module:   item.Module,
+			TransKey: item.TransKey,
+			Locale:   item.Locale,
+			Value:    item.Value,
+		}
+	}
+	count, err := s.uc.Save(ctx, items)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SaveTranslationsReply{Count: int32(count)}, nil
+}
+
+func (s *TranslationService) DeleteTranslation(ctx context.Context, req *pb.DeleteTranslationRequest) (*pb.DeleteTranslationReply, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if err := s.uc.Delete(ctx, req.Id); err != nil {
+		return nil, err
+	}
+	return &pb.DeleteTranslationReply{}, nil
+}
@@ -17,4 +17,9 @@ var ProviderSet = wire.NewSet(
 	admin.NewPostService,
 	admin.NewDictDataService,
 	admin.NewDictTypeService,
+	admin.NewChangeRequestService,
+	admin.NewExportService,
+	admin.NewLoginLogService,
+	admin.NewTranslationService,
+	admin.NewRecycleService,
 )
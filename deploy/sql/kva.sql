@@ -35,12 +35,14 @@ CREATE TABLE `casbin_rule`  (
   `v5` varchar(100) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NULL DEFAULT NULL,
   PRIMARY KEY (`id`) USING BTREE,
   UNIQUE INDEX `idx_casbin_rule`(`ptype`, `v0`, `v1`, `v2`, `v3`, `v4`, `v5`) USING BTREE
-) ENGINE = InnoDB AUTO_INCREMENT = 167 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC;
+) ENGINE = InnoDB AUTO_INCREMENT = 210 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC;
 
 -- ----------------------------
 -- Records of casbin_rule
 -- ----------------------------
 INSERT INTO `casbin_rule` VALUES (58, 'p', 'admin', '/api.admin.v1.Api/AllApi', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (168, 'p', 'admin', '/api.admin.v1.Api/CheckPermission', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (169, 'p', 'admin', '/api.admin.v1.Api/SyncApi', 'POST', '', '', '');
 INSERT INTO `casbin_rule` VALUES (61, 'p', 'admin', '/api.admin.v1.Api/CreateApi', 'POST', '', '', '');
 INSERT INTO `casbin_rule` VALUES (63, 'p', 'admin', '/api.admin.v1.Api/DeleteApi', 'DELETE', '', '', '');
 INSERT INTO `casbin_rule` VALUES (60, 'p', 'admin', '/api.admin.v1.Api/FindApi', 'GET', '', '', '');
@@ -140,6 +142,46 @@ INSERT INTO `casbin_rule` VALUES (53, 'p', 'admin', '/api.admin.v1.Roles/DeleteR
 INSERT INTO `casbin_rule` VALUES (50, 'p', 'admin', '/api.admin.v1.Roles/FindRoles', 'GET', '', '', '');
 INSERT INTO `casbin_rule` VALUES (49, 'p', 'admin', '/api.admin.v1.Roles/ListRoles', 'GET', '', '', '');
 INSERT INTO `casbin_rule` VALUES (52, 'p', 'admin', '/api.admin.v1.Roles/UpdateRoles', 'PUT', '', '', '');
+INSERT INTO `casbin_rule` VALUES (170, 'p', 'admin', '/api.admin.v1.Roles/ExportRoles', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (171, 'p', 'admin', '/api.admin.v1.Roles/ImportRoles', 'POST', '', '', '');
+INSERT INTO `casbin_rule` VALUES (172, 'p', 'admin', '/api.admin.v1.Roles/CreateTempGrant', 'POST', '', '', '');
+INSERT INTO `casbin_rule` VALUES (173, 'p', 'admin', '/api.admin.v1.Roles/RevokeTempGrant', 'PUT', '', '', '');
+INSERT INTO `casbin_rule` VALUES (174, 'p', 'admin', '/api.admin.v1.Roles/ListTempGrants', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (175, 'p', 'admin', '/api.admin.v1.ChangeRequest/ListChangeRequests', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (176, 'p', 'admin', '/api.admin.v1.ChangeRequest/GetChangeRequest', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (177, 'p', 'admin', '/api.admin.v1.ChangeRequest/ApproveChangeRequest', 'PUT', '', '', '');
+INSERT INTO `casbin_rule` VALUES (178, 'p', 'admin', '/api.admin.v1.ChangeRequest/RejectChangeRequest', 'PUT', '', '', '');
+INSERT INTO `casbin_rule` VALUES (179, 'p', 'admin', '/api.admin.v1.LogsService/VerifyLogs', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (180, 'p', 'admin', '/api.admin.v1.LogsService/ArchiveLogs', 'POST', '', '', '');
+INSERT INTO `casbin_rule` VALUES (181, 'p', 'admin', '/api.admin.v1.LogsService/ListLogCheckpoints', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (182, 'p', 'admin', '/api.admin.v1.LogsService/ExportLogs', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (183, 'p', 'admin', '/api.admin.v1.SysUser/ExportSysUser', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (184, 'p', 'admin', '/api.admin.v1.Roles/ExportRoleList', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (185, 'p', 'admin', '/api.admin.v1.Export/ListExportTasks', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (186, 'p', 'admin', '/api.admin.v1.Export/GetExportTask', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (187, 'p', 'admin', '/api.admin.v1.Export/DownloadExport', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (188, 'p', 'admin', '/api.admin.v1.LogsService/ListEntityHistory', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (189, 'p', 'admin', '/api.admin.v1.LoginLog/ListLoginLogs', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (190, 'p', 'admin', '/api.admin.v1.LoginLog/CleanLoginLogs', 'DELETE', '', '', '');
+INSERT INTO `casbin_rule` VALUES (191, 'p', 'admin', '/api.admin.v1.LoginLog/ExportLoginLogs', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (192, 'p', 'admin', '/api.admin.v1.LogsService/StreamLogs', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (193, 'p', 'admin', '/api.admin.v1.DictData/BatchDictData', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (194, 'p', 'admin', '/api.admin.v1.DictType/ExportDicts', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (195, 'p', 'admin', '/api.admin.v1.DictType/ImportDicts', 'POST', '', '', '');
+INSERT INTO `casbin_rule` VALUES (196, 'p', 'admin', '/api.admin.v1.SysUser/UpdateLocale', 'PUT', '', '', '');
+INSERT INTO `casbin_rule` VALUES (197, 'p', 'admin', '/api.admin.v1.Translation/ListLocales', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (198, 'p', 'admin', '/api.admin.v1.Translation/ListTranslations', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (199, 'p', 'admin', '/api.admin.v1.Translation/SaveTranslations', 'POST', '', '', '');
+INSERT INTO `casbin_rule` VALUES (200, 'p', 'admin', '/api.admin.v1.Translation/DeleteTranslation', 'DELETE', '', '', '');
+INSERT INTO `casbin_rule` VALUES (201, 'p', 'admin', '/api.admin.v1.Dept/MoveDept', 'PUT', '', '', '');
+INSERT INTO `casbin_rule` VALUES (202, 'p', 'admin', '/api.admin.v1.Recycle/ListRecycle', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (203, 'p', 'admin', '/api.admin.v1.Recycle/RestoreRecycle', 'PUT', '', '', '');
+INSERT INTO `casbin_rule` VALUES (204, 'p', 'admin', '/api.admin.v1.Recycle/PurgeRecycle', 'DELETE', '', '', '');
+INSERT INTO `casbin_rule` VALUES (205, 'p', 'admin', '/api.admin.v1.SysUser/ImportSysUsers', 'POST', '', '', '');
+INSERT INTO `casbin_rule` VALUES (206, 'p', 'admin', '/api.admin.v1.SysUser/ImportSysUsersTemplate', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (207, 'p', 'admin', '/api.admin.v1.SysUser/ResetSysUserPassword', 'PUT', '', '', '');
+INSERT INTO `casbin_rule` VALUES (208, 'p', 'admin', '/api.admin.v1.SysUser/RevokeSysUserSessions', 'POST', '', '', '');
+INSERT INTO `casbin_rule` VALUES (209, 'p', 'admin', '/api.admin.v1.LogsService/GetLogsWriterStats', 'GET', '', '', '');
 INSERT INTO `casbin_rule` VALUES (140, 'p', 'admin', '/api.admin.v1.Sensitive/BatchDeleteSensitive', 'POST', '', '', '');
 INSERT INTO `casbin_rule` VALUES (141, 'p', 'admin', '/api.admin.v1.Sensitive/CreateSensitive', 'POST', '', '', '');
 INSERT INTO `casbin_rule` VALUES (142, 'p', 'admin', '/api.admin.v1.Sensitive/DeleteSensitive', 'POST', '', '', '');
@@ -161,6 +203,7 @@ INSERT INTO `casbin_rule` VALUES (6, 'p', 'admin', '/api.admin.v1.Sysuser/FindPo
 INSERT INTO `casbin_rule` VALUES (5, 'p', 'admin', '/api.admin.v1.Sysuser/FindSysuser', 'GET', '', '', '');
 INSERT INTO `casbin_rule` VALUES (11, 'p', 'admin', '/api.admin.v1.Sysuser/FindUserGoogleSecret', 'GET', '', '', '');
 INSERT INTO `casbin_rule` VALUES (7, 'p', 'admin', '/api.admin.v1.Sysuser/FindUserRolePost', 'GET', '', '', '');
+INSERT INTO `casbin_rule` VALUES (167, 'p', 'admin', '/api.admin.v1.SysUser/ImpersonateSysUser', 'POST', '', '', '');
 INSERT INTO `casbin_rule` VALUES (15, 'p', 'admin', '/api.admin.v1.Sysuser/ListSysuser', 'GET', '', '', '');
 INSERT INTO `casbin_rule` VALUES (14, 'p', 'admin', '/api.admin.v1.Sysuser/Logout', 'POST', '', '', '');
 INSERT INTO `casbin_rule` VALUES (3, 'p', 'admin', '/api.admin.v1.Sysuser/UpdateAvatar', 'POST', '', '', '');
@@ -322,6 +365,72 @@ INSERT INTO `sys_apis` VALUES (121, '/api.admin.v1.Sysuser/ChangeStatus', '用
 INSERT INTO `sys_apis` VALUES (122, '/api.admin.v1.Sysuser/Logout', '用户退出', 'user', 'POST', '2023-09-07 16:33:04', '2023-09-07 16:33:20', NULL);
 INSERT INTO `sys_apis` VALUES (123, '/api.admin.v1.Sysuser/ListSysuser', '获取用户列表', 'user', 'GET', '2023-09-07 16:33:04', '2023-09-07 16:33:20', NULL);
 INSERT INTO `sys_apis` VALUES (124, '/api.admin.v1.Sysuser/DeleteSysuser', '删除用户', 'user', 'DELETE', '2023-09-07 16:33:04', '2023-09-07 16:33:20', NULL);
+INSERT INTO `sys_apis` VALUES (125, '/api.admin.v1.SysUser/ImpersonateSysUser', '模拟登录指定用户', 'user', 'POST', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (126, '/api.admin.v1.Api/CheckPermission', '权限校验及命中策略', 'api', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (127, '/api.admin.v1.Api/SyncApi', '同步已注册的api', 'api', 'POST', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (128, '/api.admin.v1.Roles/ExportRoles', '导出角色', 'role', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (129, '/api.admin.v1.Roles/ImportRoles', '导入角色', 'role', 'POST', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (130, '/api.admin.v1.Roles/CreateTempGrant', '创建临时授权', 'role', 'POST', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (131, '/api.admin.v1.Roles/RevokeTempGrant', '撤销临时授权', 'role', 'PUT', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (132, '/api.admin.v1.Roles/ListTempGrants', '临时授权列表', 'role', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (133, '/api.admin.v1.ChangeRequest/ListChangeRequests', '变更审批列表', 'changeRequest', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (134, '/api.admin.v1.ChangeRequest/GetChangeRequest', '变更审批详情', 'changeRequest', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (135, '/api.admin.v1.ChangeRequest/ApproveChangeRequest', '通过变更审批', 'changeRequest', 'PUT', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (136, '/api.admin.v1.ChangeRequest/RejectChangeRequest', '拒绝变更审批', 'changeRequest', 'PUT', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (137, '/api.admin.v1.LogsService/VerifyLogs', '校验操作日志审计链', 'logs', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (138, '/api.admin.v1.LogsService/ArchiveLogs', '归档操作日志', 'logs', 'POST', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (139, '/api.admin.v1.LogsService/ListLogCheckpoints', '操作日志归档检查点', 'logs', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (140, '/api.admin.v1.LogsService/ExportLogs', '导出操作日志', 'logs', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (141, '/api.admin.v1.SysUser/ExportSysUser', '导出用户列表', 'user', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (142, '/api.admin.v1.Roles/ExportRoleList', '导出角色列表', 'role', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (143, '/api.admin.v1.Export/ListExportTasks', '导出任务列表', 'export', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (144, '/api.admin.v1.Export/GetExportTask', '导出任务详情', 'export', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (145, '/api.admin.v1.Export/DownloadExport', '下载导出文件', 'export', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (146, '/api.admin.v1.LogsService/ListEntityHistory', '实体字段变更历史', 'logs', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (147, '/api.admin.v1.LoginLog/ListLoginLogs', '登录日志列表', 'loginLog', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (148, '/api.admin.v1.LoginLog/CleanLoginLogs', '清理登录日志', 'loginLog', 'DELETE', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (149, '/api.admin.v1.LoginLog/ExportLoginLogs', '导出登录日志', 'loginLog', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (150, '/api.admin.v1.LogsService/StreamLogs', '实时操作日志', 'logs', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (151, '/api.admin.v1.DictData/BatchDictData', '批量获取字典数据', 'dict', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (152, '/api.admin.v1.DictType/ExportDicts', '导出字典', 'dict', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (153, '/api.admin.v1.DictType/ImportDicts', '导入字典', 'dict', 'POST', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (154, '/api.admin.v1.SysUser/UpdateLocale', '修改语言偏好', 'user', 'PUT', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (155, '/api.admin.v1.Translation/ListLocales', '支持的语言', 'translation', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (156, '/api.admin.v1.Translation/ListTranslations', '翻译列表', 'translation', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (157, '/api.admin.v1.Translation/SaveTranslations', '保存翻译', 'translation', 'POST', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (158, '/api.admin.v1.Translation/DeleteTranslation', '删除翻译', 'translation', 'DELETE', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (159, '/api.admin.v1.Dept/MoveDept', '移动部门', 'dept', 'PUT', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (160, '/api.admin.v1.Recycle/ListRecycle', '回收站列表', 'recycle', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (161, '/api.admin.v1.Recycle/RestoreRecycle', '恢复已删除记录', 'recycle', 'PUT', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (162, '/api.admin.v1.Recycle/PurgeRecycle', '永久删除记录', 'recycle', 'DELETE', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (163, '/api.admin.v1.SysUser/ImportSysUsers', '批量导入用户', 'user', 'POST', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (164, '/api.admin.v1.SysUser/ImportSysUsersTemplate', '下载用户导入模板', 'user', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (165, '/api.admin.v1.SysUser/ResetSysUserPassword', '重置用户密码', 'user', 'PUT', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (166, '/api.admin.v1.SysUser/RevokeSysUserSessions', '撤销用户登录', 'user', 'POST', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+INSERT INTO `sys_apis` VALUES (167, '/api.admin.v1.LogsService/GetLogsWriterStats', '操作日志写入统计', 'logs', 'GET', '2026-10-19 10:00:00', '2026-10-19 10:00:00', NULL);
+
+-- ----------------------------
+-- Table structure for sys_change_requests
+-- ----------------------------
+DROP TABLE IF EXISTS `sys_change_requests`;
+CREATE TABLE `sys_change_requests`  (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '主键id',
+  `operation` varchar(191) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '操作',
+  `payload` text CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL COMMENT '请求参数(JSON)',
+  `status` tinyint(2) NOT NULL DEFAULT 1 COMMENT '1=待审批 2=已执行 3=已拒绝 4=已过期 5=执行失败',
+  `create_user_id` bigint(20) NOT NULL DEFAULT 0 COMMENT '提交人id',
+  `create_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '提交人',
+  `review_user_id` bigint(20) NOT NULL DEFAULT 0 COMMENT '审批人id',
+  `review_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '审批人',
+  `review_comment` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '审批意见',
+  `reviewed_at` datetime NULL DEFAULT NULL COMMENT '审批时间',
+  `error_message` text CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL COMMENT '执行错误信息',
+  `expire_at` datetime NOT NULL COMMENT '过期时间',
+  `created_at` datetime NULL DEFAULT NULL COMMENT '创建时间',
+  `updated_at` datetime NULL DEFAULT NULL COMMENT '更新时间',
+  PRIMARY KEY (`id`) USING BTREE,
+  INDEX `idx_status_expire`(`status`, `expire_at`) USING BTREE
+) ENGINE = InnoDB AUTO_INCREMENT = 1 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC;
 
 -- ----------------------------
 -- Table structure for sys_depts
@@ -481,6 +590,31 @@ CREATE TABLE `sys_discovery`  (
 INSERT INTO `sys_discovery` VALUES (8, '啊实', 'http://oss.nfdx.xyz/files/82cf620a-b726-3405-bbe0-dad3e0d3755b.jpg', 1, '发', 1);
 INSERT INTO `sys_discovery` VALUES (9, '发广告', 'http://oss.nfdx.xyz/files/74028856-4d7a-3922-9ea5-6c4430e94f72.jpg', 1, '1231321', 1);
 
+-- ----------------------------
+-- Table structure for sys_export_tasks
+-- ----------------------------
+DROP TABLE IF EXISTS `sys_export_tasks`;
+CREATE TABLE `sys_export_tasks`  (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '主键id',
+  `name` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '导出名称',
+  `format` varchar(8) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '文件格式 csv/xlsx',
+  `status` tinyint(2) NOT NULL DEFAULT 1 COMMENT '1=导出中 2=已完成 3=失败',
+  `rows` bigint(20) NOT NULL DEFAULT 0 COMMENT '导出行数',
+  `file_name` varchar(191) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '下载文件名',
+  `file_path` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '文件路径',
+  `node` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '执行导出的节点',
+  `error_message` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '错误信息',
+  `create_user_id` bigint(20) NOT NULL DEFAULT 0 COMMENT '导出人id',
+  `create_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '导出人',
+  `expire_at` datetime NOT NULL COMMENT '文件过期时间',
+  `created_at` datetime NULL DEFAULT NULL COMMENT '创建时间',
+  `updated_at` datetime NULL DEFAULT NULL COMMENT '更新时间',
+  PRIMARY KEY (`id`) USING BTREE,
+  INDEX `idx_create_user`(`create_user_id`, `created_at`) USING BTREE,
+  INDEX `idx_expire_at`(`expire_at`) USING BTREE,
+  INDEX `idx_status`(`status`, `created_at`) USING BTREE
+) ENGINE = InnoDB AUTO_INCREMENT = 1 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC COMMENT = '导出任务';
+
 -- ----------------------------
 -- Table structure for sys_jobs
 -- ----------------------------
@@ -511,6 +645,86 @@ CREATE TABLE `sys_jobs`  (
 -- ----------------------------
 INSERT INTO `sys_jobs` VALUES (1, 'testcron', 'SYSTEM', 2, ' 0/10 * * * * ?', 'cronHandle', 'aaa', 0, 2, 1, 1, 'panda', '', '2021-12-24 16:06:09', '2022-01-22 08:11:24', NULL);
 
+-- ----------------------------
+-- Table structure for sys_log_chains
+-- ----------------------------
+DROP TABLE IF EXISTS `sys_log_chains`;
+CREATE TABLE `sys_log_chains`  (
+  `id` bigint(20) NOT NULL COMMENT '主键id',
+  `seq` bigint(20) NOT NULL DEFAULT 0 COMMENT '最后一条记录的序号',
+  `hash` char(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '最后一条记录的哈希',
+  `updated_at` datetime NULL DEFAULT NULL COMMENT '更新时间',
+  PRIMARY KEY (`id`) USING BTREE
+) ENGINE = InnoDB CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC COMMENT = '操作日志审计链头';
+
+-- ----------------------------
+-- Records of sys_log_chains
+-- ----------------------------
+INSERT INTO `sys_log_chains` VALUES (1, 0, '', '2026-10-19 10:00:00');
+
+-- ----------------------------
+-- Table structure for sys_log_changes
+-- ----------------------------
+DROP TABLE IF EXISTS `sys_log_changes`;
+CREATE TABLE `sys_log_changes`  (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '主键id',
+  `log_id` bigint(20) NOT NULL DEFAULT 0 COMMENT '操作记录id',
+  `entity` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '实体类型',
+  `entity_id` bigint(20) NOT NULL DEFAULT 0 COMMENT '实体id',
+  `field` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '字段',
+  `old_value` text CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NULL COMMENT '修改前的值',
+  `new_value` text CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NULL COMMENT '修改后的值',
+  `user_id` bigint(20) NOT NULL DEFAULT 0 COMMENT '操作用户id',
+  `operation` varchar(191) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '操作',
+  `created_at` datetime(3) NULL DEFAULT NULL COMMENT '创建时间',
+  PRIMARY KEY (`id`) USING BTREE,
+  INDEX `idx_log_id`(`log_id`) USING BTREE,
+  INDEX `idx_entity`(`entity`, `entity_id`) USING BTREE
+) ENGINE = InnoDB AUTO_INCREMENT = 1 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC COMMENT = '实体字段变更记录';
+
+-- ----------------------------
+-- Table structure for sys_log_checkpoints
+-- ----------------------------
+DROP TABLE IF EXISTS `sys_log_checkpoints`;
+CREATE TABLE `sys_log_checkpoints`  (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '主键id',
+  `start_seq` bigint(20) NOT NULL DEFAULT 0 COMMENT '归档起始序号',
+  `end_seq` bigint(20) NOT NULL DEFAULT 0 COMMENT '归档结束序号',
+  `prev_hash` char(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '起始记录的上一条哈希',
+  `end_hash` char(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '结束记录的哈希',
+  `count` bigint(20) NOT NULL DEFAULT 0 COMMENT '归档条数',
+  `end_time` datetime NOT NULL COMMENT '归档截止时间',
+  `signature` char(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '检查点签名',
+  `create_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '归档人',
+  `created_at` datetime NULL DEFAULT NULL COMMENT '创建时间',
+  PRIMARY KEY (`id`) USING BTREE,
+  UNIQUE INDEX `uk_end_seq`(`end_seq`) USING BTREE
+) ENGINE = InnoDB AUTO_INCREMENT = 1 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC COMMENT = '操作日志归档检查点';
+
+-- ----------------------------
+-- Table structure for sys_login_logs
+-- ----------------------------
+DROP TABLE IF EXISTS `sys_login_logs`;
+CREATE TABLE `sys_login_logs`  (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '主键id',
+  `user_id` bigint(20) NOT NULL DEFAULT 0 COMMENT '用户id，用户不存在时为0',
+  `username` varchar(191) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '登录用户名',
+  `status` tinyint(4) NOT NULL DEFAULT 0 COMMENT '1=成功 2=失败',
+  `reason` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '失败原因',
+  `message` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '失败信息',
+  `ip` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '登录ip',
+  `agent` varchar(512) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT 'User-Agent',
+  `browser` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '浏览器',
+  `os` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '操作系统',
+  `mfa_method` varchar(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '二次验证方式',
+  `created_at` datetime(3) NULL DEFAULT NULL COMMENT '登录时间',
+  PRIMARY KEY (`id`) USING BTREE,
+  INDEX `idx_username_created_at`(`username`, `created_at`) USING BTREE,
+  INDEX `idx_user_id_status`(`user_id`, `status`) USING BTREE,
+  INDEX `idx_ip_created_at`(`ip`, `created_at`) USING BTREE,
+  INDEX `idx_created_at`(`created_at`) USING BTREE
+) ENGINE = InnoDB AUTO_INCREMENT = 1 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC COMMENT = '登录日志';
+
 -- ----------------------------
 -- Table structure for sys_logs
 -- ----------------------------
@@ -530,8 +744,25 @@ CREATE TABLE `sys_logs` (
   `body` text CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci COMMENT '请求Body',
   `resp` text CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci COMMENT '响应Body',
   `user_id` bigint unsigned DEFAULT NULL COMMENT '用户id',
+  `impersonator_id` bigint unsigned DEFAULT NULL COMMENT '模拟登录操作人id',
+  `operation` varchar(191) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci DEFAULT NULL COMMENT '操作',
+  `reason` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci DEFAULT NULL COMMENT '错误原因',
+  `error_code` int DEFAULT NULL COMMENT '错误码',
+  `trace_id` varchar(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci DEFAULT NULL COMMENT '链路id',
+  `seq` bigint unsigned NOT NULL DEFAULT 0 COMMENT '审计链序号，0 表示未开启审计时写入',
+  `prev_hash` char(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '上一条记录的哈希',
+  `hash` char(64) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '本条记录的哈希',
   PRIMARY KEY (`id`) USING BTREE,
-  KEY `idx_sys_operation_records_deleted_at` (`deleted_at`) USING BTREE
+  KEY `idx_sys_operation_records_deleted_at` (`deleted_at`) USING BTREE,
+  KEY `idx_sys_logs_created_at` (`created_at`) USING BTREE,
+  KEY `idx_sys_logs_user_created` (`user_id`, `created_at`) USING BTREE,
+  KEY `idx_sys_logs_path_created` (`path`, `created_at`) USING BTREE,
+  KEY `idx_sys_logs_ip_created` (`ip`, `created_at`) USING BTREE,
+  KEY `idx_sys_logs_status_created` (`status`, `created_at`) USING BTREE,
+  KEY `idx_sys_logs_operation_created` (`operation`, `created_at`) USING BTREE,
+  KEY `idx_sys_logs_reason_created` (`reason`, `created_at`) USING BTREE,
+  KEY `idx_sys_logs_trace_id` (`trace_id`) USING BTREE,
+  KEY `idx_sys_logs_seq` (`seq`) USING BTREE
 ) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COLLATE=utf8mb4_general_ci;
 -- ----------------------------
 -- Records of sys_logs
@@ -827,6 +1058,78 @@ CREATE TABLE `sys_roles`  (
 INSERT INTO `sys_roles` VALUES (1, 0, '超管理员', 1, 'admin', 1, 0, '', '', '', 'admin', '2021-12-02 16:03:26', '2023-09-07 11:01:23', NULL);
 INSERT INTO `sys_roles` VALUES (2, 0, '管理员', 0, 'manage', 1, 0, '', '', '', 'admin', '2021-12-19 16:06:20', '2023-09-05 11:10:50', NULL);
 
+-- ----------------------------
+-- Table structure for sys_temp_grants
+-- ----------------------------
+DROP TABLE IF EXISTS `sys_temp_grants`;
+CREATE TABLE `sys_temp_grants`  (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '主键id',
+  `user_id` bigint(20) NOT NULL DEFAULT 0 COMMENT '被授权用户id',
+  `grant_type` varchar(16) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '授权类型 role=角色 api=接口',
+  `role_key` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '授予的角色代码',
+  `apis` text CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL COMMENT '授予的接口(JSON)',
+  `start_time` datetime NOT NULL COMMENT '生效时间',
+  `end_time` datetime NOT NULL COMMENT '失效时间',
+  `status` tinyint(2) NOT NULL DEFAULT 1 COMMENT '1=待生效 2=生效中 3=已过期 4=已撤销',
+  `reason` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '授权原因',
+  `create_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '授权人',
+  `revoke_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '撤销人',
+  `created_at` datetime NULL DEFAULT NULL COMMENT '创建时间',
+  `updated_at` datetime NULL DEFAULT NULL COMMENT '更新时间',
+  PRIMARY KEY (`id`) USING BTREE,
+  INDEX `idx_user_id`(`user_id`) USING BTREE,
+  INDEX `idx_status_time`(`status`, `start_time`, `end_time`) USING BTREE
+) ENGINE = InnoDB AUTO_INCREMENT = 1 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC;
+
+-- ----------------------------
+-- Table structure for sys_translations
+-- ----------------------------
+DROP TABLE IF EXISTS `sys_translations`;
+CREATE TABLE `sys_translations`  (
+  `id` bigint(20) NOT NULL AUTO_INCREMENT COMMENT '主键id',
+  `module` varchar(32) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '模块 dict=字典标签 menu=菜单标题',
+  `trans_key` varchar(191) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '翻译键，字典为 字典类型:字典值，菜单为权限标识或 path:路由地址',
+  `locale` varchar(16) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '语言',
+  `value` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '译文',
+  `create_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '创建人',
+  `update_by` varchar(128) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '更新人',
+  `created_at` datetime NULL DEFAULT NULL COMMENT '创建时间',
+  `updated_at` datetime NULL DEFAULT NULL COMMENT '更新时间',
+  PRIMARY KEY (`id`) USING BTREE,
+  UNIQUE INDEX `uk_module_key_locale`(`module`, `trans_key`, `locale`) USING BTREE,
+  INDEX `idx_module_locale`(`module`, `locale`) USING BTREE
+) ENGINE = InnoDB AUTO_INCREMENT = 27 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC COMMENT = '字典标签和菜单标题的翻译';
+
+-- ----------------------------
+-- Records of sys_translations
+-- ----------------------------
+INSERT INTO `sys_translations` VALUES (1, 'dict', 'sys_user_sex:0', 'en-US', 'Male', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (2, 'dict', 'sys_user_sex:1', 'en-US', 'Female', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (3, 'dict', 'sys_user_sex:2', 'en-US', 'Unknown', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (4, 'dict', 'sys_normal_disable:1', 'en-US', 'Normal', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (5, 'dict', 'sys_normal_disable:2', 'en-US', 'Disabled', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (6, 'dict', 'sys_menu_type:M', 'en-US', 'Directory', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (7, 'dict', 'sys_menu_type:C', 'en-US', 'Menu', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (8, 'dict', 'sys_menu_type:F', 'en-US', 'Button', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (9, 'dict', 'sys_show_hide:1', 'en-US', 'Show', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (10, 'dict', 'sys_show_hide:2', 'en-US', 'Hide', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (11, 'dict', 'sys_num_yes_no:1', 'en-US', 'Yes', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (12, 'dict', 'sys_num_yes_no:2', 'en-US', 'No', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (13, 'dict', 'sys_yes_no:0', 'en-US', 'Yes', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (14, 'dict', 'sys_yes_no:1', 'en-US', 'No', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (15, 'dict', 'sys_common_status:0', 'en-US', 'Success', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (16, 'dict', 'sys_common_status:1', 'en-US', 'Failed', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (17, 'menu', 'path:/system', 'en-US', 'System', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (18, 'menu', 'system:user:list', 'en-US', 'Users', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (19, 'menu', 'system:role:list', 'en-US', 'Roles', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (20, 'menu', 'system:menu:list', 'en-US', 'Menus', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (21, 'menu', 'system:dept:list', 'en-US', 'Departments', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (22, 'menu', 'system:post:list', 'en-US', 'Posts', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (23, 'menu', 'system:dict:list', 'en-US', 'Dictionaries', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (24, 'menu', 'system:config:list', 'en-US', 'Settings', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (25, 'menu', 'system:api:list', 'en-US', 'APIs', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+INSERT INTO `sys_translations` VALUES (26, 'menu', 'path:/personal', 'en-US', 'Profile', 'admin', '', '2026-10-19 10:00:00', '2026-10-19 10:00:00');
+
 -- ----------------------------
 -- Table structure for sys_users
 -- ----------------------------
@@ -855,6 +1158,9 @@ CREATE TABLE `sys_users`  (
   `updated_at` datetime NULL DEFAULT NULL COMMENT '更新时间',
   `deleted_at` datetime NULL DEFAULT NULL COMMENT '删除时间',
   `secret` varchar(255) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL COMMENT 'google密钥',
+  `locale` varchar(16) CHARACTER SET utf8mb4 COLLATE utf8mb4_general_ci NOT NULL DEFAULT '' COMMENT '语言偏好，为空时按浏览器语言',
+  `must_change_password` tinyint(1) NOT NULL DEFAULT 0 COMMENT '下次登录必须修改密码',
+  `token_revoked_at` bigint(20) NOT NULL DEFAULT 0 COMMENT '该时间（unix 秒）及之前签发的token失效',
   PRIMARY KEY (`id`) USING BTREE,
   INDEX `idx_deleted_at`(`deleted_at`) USING BTREE
 ) ENGINE = InnoDB AUTO_INCREMENT = 4 CHARACTER SET = utf8mb4 COLLATE = utf8mb4_general_ci ROW_FORMAT = DYNAMIC;
@@ -862,9 +1168,9 @@ CREATE TABLE `sys_users`  (
 -- ----------------------------
 -- Records of sys_users
 -- ----------------------------
-INSERT INTO `sys_users` VALUES (1, '1-1-1-1', 'admin', 'admin', '$2a$10$cKFFTCzGOvaIHHJY2K45Zuwt8TD6oPzYi4s5MzYIBAWCLL6ZhouP2', '18888888888', 1, '', '', 0, 'example@email.com', 3, 1, 'remark', 1, '1', '1', 'admin', 'admin', '2021-12-03 09:46:55', '2023-09-04 10:40:54', NULL, '45RNXQTW2EMJ2EOAQ26UYML2D2K2IPYT');
-INSERT INTO `sys_users` VALUES (2, 'd26733e4-ee09-4d98-b462-93bae039209c', 'test2', 'test2', '$2a$10$yiQ9u0lh7wsGjchqMGVOE.lp3KO99R5nw0Kc1DWQC6THI6d.JzNP.', '13312312311', 1, '', '', 1, 'email@email.com', 2, 1, 'this is a remark2', 1, '1', '1', 'admin', 'admin', '2023-08-23 11:38:47', '2023-09-07 10:02:50', NULL, 'K6SSMXVIX6WRDBEIPX2ZDHLR6XSCEAKN');
-INSERT INTO `sys_users` VALUES (3, 'b3614db9-80a8-4892-9f65-0a6e70a00a2d', 'dahe', 'dahe', '$2a$10$iCr0rC6esWA91xCiImLZ5uMxjnW45VVhFzR2e9IPVg4QKY/XhvqEu', '13777788880', 1, '', '', 0, 'dahe@gmail.com', 3, 1, 'ewtwet', 1, '1', '1', 'admin', 'admin', '2023-08-24 08:54:34', '2023-09-04 11:16:19', NULL, '5KPR5XMSMTZTE6WQBHLFXYNKA64EOBUH');
+INSERT INTO `sys_users` VALUES (1, '1-1-1-1', 'admin', 'admin', '$2a$10$cKFFTCzGOvaIHHJY2K45Zuwt8TD6oPzYi4s5MzYIBAWCLL6ZhouP2', '18888888888', 1, '', '', 0, 'example@email.com', 3, 1, 'remark', 1, '1', '1', 'admin', 'admin', '2021-12-03 09:46:55', '2023-09-04 10:40:54', NULL, '45RNXQTW2EMJ2EOAQ26UYML2D2K2IPYT', '', 0, 0);
+INSERT INTO `sys_users` VALUES (2, 'd26733e4-ee09-4d98-b462-93bae039209c', 'test2', 'test2', '$2a$10$yiQ9u0lh7wsGjchqMGVOE.lp3KO99R5nw0Kc1DWQC6THI6d.JzNP.', '13312312311', 1, '', '', 1, 'email@email.com', 2, 1, 'this is a remark2', 1, '1', '1', 'admin', 'admin', '2023-08-23 11:38:47', '2023-09-07 10:02:50', NULL, 'K6SSMXVIX6WRDBEIPX2ZDHLR6XSCEAKN', '', 0, 0);
+INSERT INTO `sys_users` VALUES (3, 'b3614db9-80a8-4892-9f65-0a6e70a00a2d', 'dahe', 'dahe', '$2a$10$iCr0rC6esWA91xCiImLZ5uMxjnW45VVhFzR2e9IPVg4QKY/XhvqEu', '13777788880', 1, '', '', 0, 'dahe@gmail.com', 3, 1, 'ewtwet', 1, '1', '1', 'admin', 'admin', '2023-08-24 08:54:34', '2023-09-04 11:16:19', NULL, '5KPR5XMSMTZTE6WQBHLFXYNKA64EOBUH', '', 0, 0);
 
 SET FOREIGN_KEY_CHECKS = 1;
 
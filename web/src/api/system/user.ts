@@ -126,3 +126,12 @@ export function userSecret() {
 		method: 'get'
 	})
 }
+
+// 模拟登录指定用户，返回短期token
+export function impersonateUser(userId: number) {
+	return request({
+		url: '/system/user/impersonate',
+		method: 'post',
+		data: { userId }
+	})
+}
@@ -105,6 +105,9 @@ export default {
 		logOutCancel: 'cancel',
 		logOutExit: 'Exiting',
 		logOutSuccess: 'Exit successfully!',
+		impersonating: 'Impersonating {nickname} (operator ID {impersonatorId}), all actions are recorded in the audit log',
+		impersonateExpire: 'Expires at {time}',
+		impersonateExit: 'Exit impersonation',
 	},
 	tagsView: {
 		refresh: 'refresh',
@@ -105,6 +105,9 @@ export default {
 		logOutCancel: '取消',
 		logOutExit: '退出中',
 		logOutSuccess: '安全退出成功！',
+		impersonating: '正在模拟用户 {nickname} 登录（操作人ID {impersonatorId}），所有操作都会记录到审计日志',
+		impersonateExpire: '将于 {time} 失效',
+		impersonateExit: '退出模拟',
 	},
 	tagsView: {
 		refresh: '刷新',
@@ -0,0 +1,43 @@
+import { Session } from '@/utils/storage';
+
+// 模拟登录前的原token，退出模拟时恢复
+const originTokenKey = 'impersonatorToken';
+
+/**
+ * 模拟登录信息，当前token不是模拟登录时返回 null
+ * @param token 当前token
+ */
+export function getImpersonation(token: string | undefined = Session.get('token')) {
+	if (!token) return null;
+	try {
+		const payload = token.split('.')[1].replace(/-/g, '+').replace(/_/g, '/');
+		const claims = JSON.parse(decodeURIComponent(escape(window.atob(payload))));
+		if (!claims.impersonator_id) return null;
+		return {
+			userId: claims.user_id,
+			nickname: claims.nickname,
+			impersonatorId: claims.impersonator_id,
+			expireAt: claims.exp ? new Date(claims.exp * 1000) : null,
+		};
+	} catch (e) {
+		return null;
+	}
+}
+
+// 切换为模拟登录token，保存原token
+export function startImpersonate(token: string) {
+	Session.set(originTokenKey, Session.get('token'));
+	Session.set('token', token);
+	Session.remove('userInfo');
+	window.location.href = '/';
+}
+
+// 退出模拟登录，恢复原token
+export function stopImpersonate() {
+	const origin = Session.get(originTokenKey);
+	Session.remove(originTokenKey);
+	Session.remove('userInfo');
+	if (origin) Session.set('token', origin);
+	else Session.remove('token');
+	window.location.href = '/';
+}